@@ -2,6 +2,8 @@ package controller
 
 import (
 	"bufio"
+	"encoding/json"
+	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel"
 	"github.com/openziti/channel/protobufs"
@@ -18,9 +20,11 @@ import (
 const (
 	AgentAppId byte = 1
 
-	AgentIdHeader      = 10
-	AgentAddrHeader    = 11
-	AgentIsVoterHeader = 12
+	AgentIdHeader              = 10
+	AgentAddrHeader            = 11
+	AgentIsVoterHeader         = 12
+	AgentTopologyFormatHeader  = 13
+	AgentIncludeCircuitsHeader = 14
 )
 
 func (self *Controller) RegisterAgentOpHandler(opId byte, f func(c *bufio.ReadWriter) error) {
@@ -36,6 +40,7 @@ func (self *Controller) bindAgentChannel(binding channel.Binding) error {
 	binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RaftListMembersRequestType), self.agentOpRaftList)
 	binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RaftJoinRequestType), self.agentOpRaftJoin)
 	binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_RaftRemoveRequestType), self.agentOpRaftRemove)
+	binding.AddReceiveHandlerF(int32(mgmt_pb.ContentType_TopologyRequestType), self.agentOpTopology)
 
 	for _, bh := range self.agentBindHandlers {
 		if err := binding.Bind(bh); err != nil {
@@ -175,3 +180,27 @@ func (self *Controller) agentOpRaftRemove(m *channel.Message, ch channel.Channel
 	// _, err := c.WriteString("success\n")
 	handler_common.SendOpResult(m, ch, "raft.remove", "no yet implemented", false)
 }
+
+func (self *Controller) agentOpTopology(m *channel.Message, ch channel.Channel) {
+	format, found := m.GetStringHeader(AgentTopologyFormatHeader)
+	if !found || format == "" {
+		format = "json"
+	}
+	includeCircuits, _ := m.GetBoolHeader(AgentIncludeCircuitsHeader)
+
+	topology := self.network.GetTopology(includeCircuits)
+
+	switch format {
+	case "dot":
+		handler_common.SendOpResult(m, ch, "topology", topology.ToDot(), true)
+	case "json":
+		output, err := json.MarshalIndent(topology, "", "    ")
+		if err != nil {
+			handler_common.SendOpResult(m, ch, "topology", err.Error(), false)
+			return
+		}
+		handler_common.SendOpResult(m, ch, "topology", string(output), true)
+	default:
+		handler_common.SendOpResult(m, ch, "topology", fmt.Sprintf("unsupported topology format '%v', expected 'json' or 'dot'", format), false)
+	}
+}
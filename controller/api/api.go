@@ -31,6 +31,7 @@ type Responder interface {
 	RespondWithApiError(err *errorz.ApiError)
 	SetProducer(producer runtime.Producer)
 	GetProducer() runtime.Producer
+	GetResponseStatus() int
 	RespondWithCouldNotReadBody(err error)
 	RespondWithCouldNotParseBody(err error)
 	RespondWithValidationErrors(errors *apierror.ValidationErrors)
@@ -54,4 +55,6 @@ type RequestContext interface {
 	SetEntitySubId(id string)
 	GetEntityId() (string, error)
 	GetEntitySubId() (string, error)
+	SetChangedFields(fields []string)
+	GetChangedFields() []string
 }
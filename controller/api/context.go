@@ -30,6 +30,7 @@ type RequestContextImpl struct {
 	Request        *http.Request
 	entityId       string
 	entitySubId    string
+	changedFields  []string
 	Body           []byte
 }
 
@@ -77,6 +78,14 @@ func (rc *RequestContextImpl) GetEntitySubId() (string, error) {
 	return rc.entitySubId, nil
 }
 
+func (rc *RequestContextImpl) SetChangedFields(fields []string) {
+	rc.changedFields = fields
+}
+
+func (rc *RequestContextImpl) GetChangedFields() []string {
+	return rc.changedFields
+}
+
 // ContextKey is used a custom type to avoid accidental context key collisions
 type ContextKey string
 
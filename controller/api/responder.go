@@ -40,6 +40,7 @@ type ResponderImpl struct {
 	rc       RequestContext
 	mapper   ResponseMapper
 	producer runtime.Producer
+	status   int
 }
 
 func (responder *ResponderImpl) SetProducer(producer runtime.Producer) {
@@ -50,6 +51,11 @@ func (responder *ResponderImpl) GetProducer() runtime.Producer {
 	return responder.producer
 }
 
+// GetResponseStatus returns the http status of the response sent, or zero if no response has been sent yet
+func (responder *ResponderImpl) GetResponseStatus() int {
+	return responder.status
+}
+
 func (responder *ResponderImpl) RespondWithCouldNotReadBody(err error) {
 	responder.RespondWithApiError(apierror.NewCouldNotReadBody(err))
 }
@@ -97,6 +103,7 @@ func (responder *ResponderImpl) RespondWithProducer(producer runtime.Producer, d
 			Error("could not respond, producer errored")
 
 		w.Header().Set("Content-Type", "text/plain")
+		responder.status = http.StatusInternalServerError
 		w.WriteHeader(http.StatusInternalServerError)
 		_, err := w.Write([]byte(fmt.Errorf("could not respond, producer errored: %v", err).Error()))
 
@@ -112,6 +119,7 @@ func (responder *ResponderImpl) RespondWithProducer(producer runtime.Producer, d
 	}
 
 	w.Header().Set("Content-Length", strconv.Itoa(buff.Len()))
+	responder.status = httpStatus
 	w.WriteHeader(httpStatus)
 
 	_, err = w.Write(buff.Bytes())
@@ -147,6 +155,7 @@ func (responder *ResponderImpl) RespondWithApiError(apiError *errorz.ApiError) {
 		w.Header().Set("content-type", "application/json")
 	}
 
+	responder.status = apiError.Status
 	w.WriteHeader(apiError.Status)
 	err := producer.Produce(w, data)
 
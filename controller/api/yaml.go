@@ -0,0 +1,67 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+	"gopkg.in/yaml.v2"
+)
+
+// YAMLProducer renders values as YAML. Unlike the yamlpc producer, values are converted to JSON first, so the
+// field names match the json tags on the generated rest models
+func YAMLProducer() runtime.Producer {
+	return runtime.ProducerFunc(func(w io.Writer, v interface{}) error {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		var doc yaml.MapSlice
+		if err = yaml.Unmarshal(b, &doc); err != nil {
+			return err
+		}
+		b, err = yaml.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return err
+	})
+}
+
+// YAMLConsumer reads YAML documents into values. Unlike the yamlpc consumer, the document is converted to JSON
+// first, so the field names match the json tags on the generated rest models
+func YAMLConsumer() runtime.Consumer {
+	return runtime.ConsumerFunc(func(r io.Reader, v interface{}) error {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		doc, err := swag.BytesToYAMLDoc(b)
+		if err != nil {
+			return err
+		}
+		b, err = swag.YAMLToJSON(doc)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(b, v)
+	})
+}
@@ -0,0 +1,60 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/event"
+	nfpem "github.com/openziti/foundation/v2/pem"
+)
+
+// audit emits an audit event for a mutating request, once the response has been sent
+func (self *FabricRequestWrapper) audit(rc api.RequestContext, request *http.Request) {
+	entityId, _ := rc.GetEntityId()
+	status := rc.GetResponseStatus()
+
+	result := event.AuditResultSuccess
+	if status == http.StatusForbidden {
+		result = event.AuditResultForbidden
+	} else if status == 0 || status >= http.StatusBadRequest {
+		result = event.AuditResultFailure
+	}
+
+	evt := &event.AuditEvent{
+		Namespace:     event.AuditEventsNs,
+		EventType:     event.AuditRestRequest,
+		Timestamp:     time.Now(),
+		Fingerprint:   getClientFingerprint(request),
+		Operation:     getOperationId(request),
+		EntityId:      entityId,
+		ChangedFields: rc.GetChangedFields(),
+		Result:        result,
+		StatusCode:    status,
+	}
+
+	self.network.GetEventDispatcher().AcceptAuditEvent(evt)
+}
+
+func getClientFingerprint(request *http.Request) string {
+	if request.TLS == nil || len(request.TLS.PeerCertificates) == 0 {
+		return ""
+	}
+	return nfpem.FingerprintFromCertificate(request.TLS.PeerCertificates[0])
+}
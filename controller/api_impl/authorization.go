@@ -0,0 +1,82 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+
+	openApiMiddleware "github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/fabric/controller/rbac"
+)
+
+// operationRoles holds the operations which require more than the default role for their http method. Changes to
+// routers, the database as a whole and raft cluster membership are restricted to admins, as are bulk circuit
+// termination and exporting, since exports include terminator instance secrets
+var operationRoles = map[string]rbac.Role{
+	"createRouter":           rbac.RoleAdmin,
+	"updateRouter":           rbac.RoleAdmin,
+	"patchRouter":            rbac.RoleAdmin,
+	"deleteRouter":           rbac.RoleAdmin,
+	"createDatabaseSnapshot": rbac.RoleAdmin,
+	"checkDataIntegrity":     rbac.RoleAdmin,
+	"fixDataIntegrity":       rbac.RoleAdmin,
+	"exportDatabase":         rbac.RoleAdmin,
+	"importDatabase":         rbac.RoleAdmin,
+	"reconcileDatabase":      rbac.RoleAdmin,
+	"raftAddMember":          rbac.RoleAdmin,
+	"raftRemoveMember":       rbac.RoleAdmin,
+	"raftTransferLeadership": rbac.RoleAdmin,
+	"terminateCircuits":      rbac.RoleAdmin,
+}
+
+// GetRequiredRole returns the role needed to execute the operation matched for the given request. Reads need the
+// read-only role and everything else needs the operator role, unless overridden in operationRoles
+func GetRequiredRole(request *http.Request) rbac.Role {
+	if role, found := operationRoles[getOperationId(request)]; found {
+		return role
+	}
+
+	if isReadOnlyRequest(request) {
+		return rbac.RoleReadOnly
+	}
+	return rbac.RoleOperator
+}
+
+func isReadOnlyRequest(request *http.Request) bool {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// getOperationId returns the id of the open api operation matched for the request, or the method and path if no
+// operation was matched
+func getOperationId(request *http.Request) string {
+	if route := openApiMiddleware.MatchedRouteFrom(request); route != nil && route.Operation != nil {
+		return route.Operation.ID
+	}
+	return request.Method + " " + request.URL.Path
+}
+
+func getClientRole(rbacConfig *rbac.Config, request *http.Request) rbac.Role {
+	if request.TLS == nil {
+		return rbac.RoleNone
+	}
+	return rbacConfig.GetRole(request.TLS.PeerCertificates)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/openziti/storage/boltz"
 	"net/http"
 	"reflect"
+	"sort"
 )
 
 const (
@@ -57,7 +58,26 @@ func modelToApi[T models.Entity](network *network.Network, rc api.RequestContext
 }
 
 func ListWithHandler[T models.Entity](n *network.Network, rc api.RequestContext, lister models.EntityRetriever[T], mapper ModelToApiMapper[T]) {
-	ListWithQueryF(n, rc, lister, mapper, lister.BasePreparedList)
+	ListWithEnvelopeFactory(rc, defaultToListEnvelope, func(rc api.RequestContext, queryOptions *PublicQueryOptions) (*QueryResult, error) {
+		query, err := queryOptions.getQuery(lister.GetStore())
+		if err != nil {
+			return nil, err
+		}
+
+		// a page read with a cursor seeks to the cursor position and stops once full, so it doesn't scan or count
+		// every matching entity
+		var result *models.EntityListResult[T]
+		if queryOptions.Cursor != nil {
+			result, err = lister.BasePreparedListAfter(queryOptions.Cursor.After, query)
+		} else {
+			result, err = lister.BasePreparedList(query)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return newQueryResult(n, rc, mapper, query, result)
+	})
 }
 
 func ListWithQueryF[T models.Entity](n *network.Network, rc api.RequestContext, lister models.EntityRetriever[T], mapper ModelToApiMapper[T], qf func(query ast.Query) (*models.EntityListResult[T], error)) {
@@ -88,15 +108,21 @@ func ListWithQueryFAndCollector[T models.Entity](n *network.Network, rc api.Requ
 			return nil, err
 		}
 
-		apiEntities, err := modelToApi(n, rc, mapper, result.GetEntities())
-		if err != nil {
-			return nil, err
-		}
-
-		return NewQueryResult(apiEntities, result.GetMetaData()), nil
+		return newQueryResult(n, rc, mapper, query, result)
 	})
 }
 
+func newQueryResult[T models.Entity](n *network.Network, rc api.RequestContext, mapper ModelToApiMapper[T], query ast.Query, result *models.EntityListResult[T]) (*QueryResult, error) {
+	apiEntities, err := modelToApi(n, rc, mapper, result.GetEntities())
+	if err != nil {
+		return nil, err
+	}
+
+	queryResult := NewQueryResult(apiEntities, result.GetMetaData())
+	queryResult.NextCursor = nextListCursor(query, result.GetEntities(), result.GetMetaData())
+	return queryResult, nil
+}
+
 type modelListF func(rc api.RequestContext, queryOptions *PublicQueryOptions) (*QueryResult, error)
 
 func ListWithEnvelopeFactory(rc api.RequestContext, toEnvelope ApiListEnvelopeFactory, f modelListF) {
@@ -122,15 +148,13 @@ func ListWithEnvelopeFactory(rc api.RequestContext, toEnvelope ApiListEnvelopeFa
 		result.Result = []interface{}{}
 	}
 
-	meta := &rest_model.Meta{
-		Pagination: &rest_model.Pagination{
-			Limit:      &result.Limit,
-			Offset:     &result.Offset,
-			TotalCount: &result.Count,
-		},
-		FilterableFields: result.FilterableFields,
+	if err = projectFields(result, qo.Fields); err != nil {
+		rc.RespondWithError(err)
+		return
 	}
 
+	meta := newListMeta(result)
+
 	switch reflect.TypeOf(result.Result).Kind() {
 	case reflect.Slice:
 		slice := reflect.ValueOf(result.Result)
@@ -153,7 +177,13 @@ func ListWithEnvelopeFactory(rc api.RequestContext, toEnvelope ApiListEnvelopeFa
 type ModelCreateF func() (string, error)
 
 func Create(rc api.RequestContext, linkFactory CreateLinkFactory, creator ModelCreateF) {
-	CreateWithResponder(rc, linkFactory, creator)
+	CreateWithResponder(rc, linkFactory, func() (string, error) {
+		id, err := creator()
+		if err == nil {
+			rc.SetEntityId(id)
+		}
+		return id, err
+	})
 }
 
 func CreateWithResponder(rsp api.Responder, linkFactory CreateLinkFactory, creator ModelCreateF) {
@@ -187,6 +217,7 @@ func DetailWithHandler[T models.Entity](network *network.Network, rc api.Request
 		if err != nil {
 			return nil, err
 		}
+		SetETag(rc, entity)
 		return mapper.ToApi(network, rc, entity)
 	})
 }
@@ -276,6 +307,14 @@ func UpdateAllowEmptyBody(rc api.RequestContext, updateF ModelUpdateF) {
 		return
 	}
 
+	// a put replaces the whole entity, so every field given in the body is recorded as changed. Fields left out of
+	// the body are reset to their defaults, but aren't recorded
+	if body := rc.GetBody(); len(body) > 0 {
+		if updatedFields, err := api.GetFields(body); err == nil {
+			setChangedFields(rc, updatedFields)
+		}
+	}
+
 	if err = updateF(id); err != nil {
 		if boltz.IsErrNotFoundErr(err) {
 			rc.RespondWithNotFoundWithCause(err)
@@ -299,6 +338,13 @@ func UpdateAllowEmptyBody(rc api.RequestContext, updateF ModelUpdateF) {
 	rc.RespondWithEmptyOk()
 }
 
+// setChangedFields records the updated fields on the request context, sorted, so they can be included in audit events
+func setChangedFields(rc api.RequestContext, updatedFields fields.UpdatedFields) {
+	changedFields := updatedFields.ToSlice()
+	sort.Strings(changedFields)
+	rc.SetChangedFields(changedFields)
+}
+
 type ModelPatchF func(id string, fields fields.UpdatedFields) error
 
 func Patch(rc api.RequestContext, patchF ModelPatchF) {
@@ -314,8 +360,11 @@ func Patch(rc api.RequestContext, patchF ModelPatchF) {
 	updatedFields, err := api.GetFields(rc.GetBody())
 	if err != nil {
 		rc.RespondWithCouldNotParseBody(err)
+		return
 	}
 
+	setChangedFields(rc, updatedFields)
+
 	err = patchF(id, updatedFields)
 	if err != nil {
 		if boltz.IsErrNotFoundErr(err) {
@@ -347,7 +396,7 @@ func ListAssociationWithHandler[T models.Entity, A models.Entity](n *network.Net
 	ListAssociations(rc, func(rc api.RequestContext, id string, queryOptions *PublicQueryOptions) (*QueryResult, error) {
 		// validate that the submitted query is only using public symbols. The query options may contain a final
 		// query which has been modified with additional filters
-		query, err := queryOptions.getFullQuery(sourceR.GetStore())
+		query, err := queryOptions.getQuery(sourceR.GetStore())
 		if err != nil {
 			return nil, err
 		}
@@ -355,17 +404,17 @@ func ListAssociationWithHandler[T models.Entity, A models.Entity](n *network.Net
 		result := models.EntityListResult[A]{
 			Loader: associatedR,
 		}
-		err = sourceR.PreparedListAssociatedWithHandler(id, associatedR.GetStore().GetEntityType(), query, result.Collect)
-		if err != nil {
-			return nil, err
+		association := associatedR.GetStore().GetEntityType()
+		if queryOptions.Cursor != nil {
+			err = sourceR.PreparedListAssociatedAfterWithHandler(id, association, queryOptions.Cursor.After, query, result.Collect)
+		} else {
+			err = sourceR.PreparedListAssociatedWithHandler(id, association, query, result.Collect)
 		}
-
-		apiEntities, err := modelToApi(n, rc, mapper, result.GetEntities())
 		if err != nil {
 			return nil, err
 		}
 
-		return NewQueryResult(apiEntities, result.GetMetaData()), nil
+		return newQueryResult(n, rc, mapper, query, &result)
 	})
 }
 
@@ -384,6 +433,7 @@ func ListAssociations(rc api.RequestContext, listF listAssocF) {
 
 	if err != nil {
 		rc.RespondWithError(err)
+		return
 	}
 
 	result, err := listF(rc, id, queryOptions)
@@ -404,14 +454,22 @@ func ListAssociations(rc api.RequestContext, listF listAssocF) {
 		result.Result = []interface{}{}
 	}
 
-	meta := &rest_model.Meta{
+	if err = projectFields(result, queryOptions.Fields); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	RespondWithOk(rc, result.Result, newListMeta(result))
+}
+
+func newListMeta(result *QueryResult) *rest_model.Meta {
+	return &rest_model.Meta{
 		Pagination: &rest_model.Pagination{
 			Limit:      &result.Limit,
 			Offset:     &result.Offset,
 			TotalCount: &result.Count,
+			NextCursor: result.NextCursor,
 		},
 		FilterableFields: result.FilterableFields,
 	}
-
-	RespondWithOk(rc, result.Result, meta)
 }
@@ -0,0 +1,55 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateAndPatchRecordChangedFields(t *testing.T) {
+	req := require.New(t)
+
+	body := `{"name": "svc", "terminatorStrategy": "smartrouting", "maxCircuits": 10}`
+
+	recorder := httptest.NewRecorder()
+	rc := NewRequestContext(recorder, httptest.NewRequest(http.MethodPut, "/services/svc1", strings.NewReader(body)))
+	rc.SetEntityId("svc1")
+
+	Update(rc, func(id string) error {
+		req.Equal("svc1", id)
+		return nil
+	})
+	req.Equal(http.StatusOK, recorder.Code)
+	req.Equal([]string{"maxCircuits", "name", "terminatorStrategy"}, rc.GetChangedFields())
+
+	recorder = httptest.NewRecorder()
+	rc = NewRequestContext(recorder, httptest.NewRequest(http.MethodPatch, "/services/svc1", strings.NewReader(`{"maxCircuits": 5}`)))
+	rc.SetEntityId("svc1")
+
+	Patch(rc, func(id string, updatedFields fields.UpdatedFields) error {
+		req.True(updatedFields.IsUpdated("maxCircuits"))
+		return nil
+	})
+	req.Equal(http.StatusOK, recorder.Code)
+	req.Equal([]string{"maxCircuits"}, rc.GetChangedFields())
+}
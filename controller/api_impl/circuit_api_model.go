@@ -20,6 +20,10 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/openziti/fabric/controller/api"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/inspect"
+	"github.com/openziti/foundation/v2/errorz"
+	"sort"
+	"time"
 
 	"github.com/openziti/fabric/rest_model"
 )
@@ -66,3 +70,84 @@ func MapCircuitToRestModel(_ *network.Network, _ api.RequestContext, circuit *ne
 
 	return ret, nil
 }
+
+func MapCircuitRouterStatsToRestModel(inspection *network.CircuitRouterInspection) *rest_model.CircuitRouterStats {
+	ret := &rest_model.CircuitRouterStats{
+		Router: ToEntityRef(inspection.Router.Name, inspection.Router, RouterLinkFactory),
+		Error:  inspection.Error,
+		Xgress: []*rest_model.CircuitXgressStats{},
+	}
+
+	if inspection.Detail == nil {
+		return ret
+	}
+
+	ret.TimeSinceLastActivity = inspection.Detail.TimeSinceLastActivity
+
+	var addresses []string
+	for address := range inspection.Detail.XgressDetails {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		ret.Xgress = append(ret.Xgress, mapXgressDetailToRestModel(inspection.Detail.XgressDetails[address]))
+	}
+
+	return ret
+}
+
+func mapXgressDetailToRestModel(detail *inspect.XgressDetail) *rest_model.CircuitXgressStats {
+	ret := &rest_model.CircuitXgressStats{
+		Address:             detail.Address,
+		Originator:          detail.Originator,
+		BytesReceived:       detail.BytesReceived,
+		BytesSent:           detail.BytesSent,
+		TimeSinceLastLinkRx: detail.TimeSinceLastLinkRx,
+	}
+
+	if sendBuffer := detail.SendBufferDetail; sendBuffer != nil {
+		ret.SendBufferSize = sendBuffer.LinkSendBufferSize
+		ret.SendWindowSize = sendBuffer.WindowSize
+		ret.Retransmits = sendBuffer.Retransmits
+		ret.DuplicateAcks = sendBuffer.DuplicateAcks
+		ret.BlockedByLocalWindow = sendBuffer.BlockedByLocalWindow
+		ret.BlockedByRemoteWindow = sendBuffer.BlockedByRemoteWindow
+	}
+
+	if recvBuffer := detail.RecvBufferDetail; recvBuffer != nil {
+		ret.ReceiveBufferSize = recvBuffer.Size
+		ret.ReceiveBufferPayloads = recvBuffer.PayloadCount
+	}
+
+	return ret
+}
+
+// MapRestModelToCircuitFilter converts a terminate request filter. A filter without any criteria is rejected, so that
+// all circuits can't be terminated by accident
+func MapRestModelToCircuitFilter(filter *rest_model.CircuitTerminateFilter) (*network.CircuitFilter, *errorz.FieldError) {
+	if filter == nil {
+		return nil, errorz.NewFieldError("filter is required", "filter", nil)
+	}
+
+	result := &network.CircuitFilter{
+		ServiceId:    filter.ServiceID,
+		ClientId:     filter.ClientID,
+		RouterId:     filter.RouterID,
+		TerminatorId: filter.TerminatorID,
+	}
+
+	if filter.MinAge != "" {
+		minAge, err := time.ParseDuration(filter.MinAge)
+		if err != nil || minAge < 0 {
+			return nil, errorz.NewFieldError("minAge must be a non-negative duration, such as 10m", "minAge", filter.MinAge)
+		}
+		result.MinAge = minAge
+	}
+
+	if result.IsEmpty() {
+		return nil, errorz.NewFieldError("at least one of serviceId, clientId, routerId, terminatorId or minAge is required", "filter", nil)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,97 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/inspect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapCircuitRouterStatsToRestModel(t *testing.T) {
+	req := require.New(t)
+
+	router := &network.Router{BaseEntity: models.BaseEntity{Id: "r1"}, Name: "router-one"}
+
+	// routers which couldn't be inspected should still be listed, with the reason and an empty xgress list
+	result := MapCircuitRouterStatsToRestModel(&network.CircuitRouterInspection{
+		Router: router,
+		Error:  "router not connected",
+	})
+	req.Equal("router not connected", result.Error)
+	req.Equal("r1", result.Router.ID)
+	req.Equal("router-one", result.Router.Name)
+	req.NotNil(result.Xgress)
+	req.Len(result.Xgress, 0)
+
+	result = MapCircuitRouterStatsToRestModel(&network.CircuitRouterInspection{
+		Router: router,
+		Detail: &inspect.CircuitInspectDetail{
+			CircuitId:             "c1",
+			TimeSinceLastActivity: "1s",
+			XgressDetails: map[string]*inspect.XgressDetail{
+				"b": {
+					Address:       "b",
+					Originator:    "Terminator",
+					BytesReceived: 20,
+					BytesSent:     10,
+				},
+				"a": {
+					Address:       "a",
+					Originator:    "Initiator",
+					BytesReceived: 10,
+					BytesSent:     20,
+					SendBufferDetail: &inspect.XgressSendBufferDetail{
+						WindowSize:         64,
+						LinkSendBufferSize: 32,
+						Retransmits:        2,
+						DuplicateAcks:      1,
+					},
+					RecvBufferDetail: &inspect.XgressRecvBufferDetail{
+						Size:         16,
+						PayloadCount: 3,
+					},
+				},
+			},
+		},
+	})
+
+	req.Equal("", result.Error)
+	req.Equal("1s", result.TimeSinceLastActivity)
+	req.Len(result.Xgress, 2)
+
+	// xgress stats are ordered by address, so output is stable
+	a := result.Xgress[0]
+	req.Equal("a", a.Address)
+	req.Equal("Initiator", a.Originator)
+	req.Equal(uint64(10), a.BytesReceived)
+	req.Equal(uint64(20), a.BytesSent)
+	req.Equal(uint32(64), a.SendWindowSize)
+	req.Equal(uint32(32), a.SendBufferSize)
+	req.Equal(uint32(2), a.Retransmits)
+	req.Equal(uint32(1), a.DuplicateAcks)
+	req.Equal(uint32(16), a.ReceiveBufferSize)
+	req.Equal(uint32(3), a.ReceiveBufferPayloads)
+
+	b := result.Xgress[1]
+	req.Equal("b", b.Address)
+	req.Equal(uint32(0), b.SendWindowSize)
+	req.Equal(uint32(0), b.ReceiveBufferSize)
+}
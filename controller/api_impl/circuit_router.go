@@ -24,9 +24,14 @@ import (
 	"github.com/openziti/fabric/rest_server/operations"
 	"github.com/openziti/fabric/rest_server/operations/circuit"
 	"github.com/openziti/storage/boltz"
+	"net/http"
 	"sort"
+	"time"
 )
 
+// circuitStatsTimeout is how long to wait for routers on a circuit's path to report their statistics for the circuit
+const circuitStatsTimeout = 5 * time.Second
+
 func init() {
 	r := NewCircuitRouter()
 	AddRouter(r)
@@ -44,7 +49,7 @@ func NewCircuitRouter() *CircuitRouter {
 
 func (r *CircuitRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
 	fabricApi.CircuitDetailCircuitHandler = circuit.DetailCircuitHandlerFunc(func(params circuit.DetailCircuitParams) middleware.Responder {
-		return wrapper.WrapRequest(r.Detail, params.HTTPRequest, params.ID, "")
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Detail(n, rc, params) }, params.HTTPRequest, params.ID, "")
 	})
 
 	fabricApi.CircuitListCircuitsHandler = circuit.ListCircuitsHandlerFunc(func(params circuit.ListCircuitsParams) middleware.Responder {
@@ -54,6 +59,10 @@ func (r *CircuitRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper Re
 	fabricApi.CircuitDeleteCircuitHandler = circuit.DeleteCircuitHandlerFunc(func(params circuit.DeleteCircuitParams) middleware.Responder {
 		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Delete(n, rc, params) }, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.CircuitTerminateCircuitsHandler = circuit.TerminateCircuitsHandlerFunc(func(params circuit.TerminateCircuitsParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.TerminateCircuits(n, rc, params) }, params.HTTPRequest, "", "")
+	})
 }
 
 func (r *CircuitRouter) ListCircuits(n *network.Network, rc api.RequestContext) {
@@ -81,7 +90,7 @@ func (r *CircuitRouter) ListCircuits(n *network.Network, rc api.RequestContext)
 	})
 }
 
-func (r *CircuitRouter) Detail(n *network.Network, rc api.RequestContext) {
+func (r *CircuitRouter) Detail(n *network.Network, rc api.RequestContext, params circuit.DetailCircuitParams) {
 	Detail(rc, func(rc api.RequestContext, id string) (interface{}, error) {
 		l, found := n.GetCircuit(id)
 		if !found {
@@ -91,6 +100,11 @@ func (r *CircuitRouter) Detail(n *network.Network, rc api.RequestContext) {
 		if err != nil {
 			return nil, err
 		}
+		if params.IncludeRouterStats != nil && *params.IncludeRouterStats {
+			for _, inspection := range n.Managers.Inspections.InspectCircuit(l, circuitStatsTimeout) {
+				apiCircuit.RouterStats = append(apiCircuit.RouterStats, MapCircuitRouterStatsToRestModel(inspection))
+			}
+		}
 		return apiCircuit, nil
 	})
 }
@@ -100,3 +114,26 @@ func (r *CircuitRouter) Delete(network *network.Network, rc api.RequestContext,
 		return network.RemoveCircuit(id, p.Options.Immediate)
 	}))
 }
+
+func (r *CircuitRouter) TerminateCircuits(n *network.Network, rc api.RequestContext, params circuit.TerminateCircuitsParams) {
+	filter, err := MapRestModelToCircuitFilter(params.Filter)
+	if err != nil {
+		rc.RespondWithFieldError(err)
+		return
+	}
+
+	dryRun := BoolOrDefault(params.DryRun)
+	circuitIds := n.RemoveCircuits(filter, params.Filter.Immediate, dryRun)
+	if circuitIds == nil {
+		circuitIds = []string{}
+	}
+
+	rc.Respond(&rest_model.CircuitTerminateEnvelope{
+		Data: &rest_model.CircuitTerminateResult{
+			DryRun:     &dryRun,
+			Count:      int64(len(circuitIds)),
+			CircuitIds: circuitIds,
+		},
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
@@ -0,0 +1,200 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/fabric/rest_model"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/foundation/v2/stringz"
+)
+
+func MapEntitySetToRestModel(entities *network.EntitySet) *rest_model.DatabaseEntities {
+	result := &rest_model.DatabaseEntities{
+		Services:    []*rest_model.ServiceExport{},
+		Routers:     []*rest_model.RouterExport{},
+		Terminators: []*rest_model.TerminatorExport{},
+	}
+
+	for _, service := range entities.Services {
+		maxCircuits := rest_model.ServiceLimit(service.MaxCircuits)
+		maxCircuitsPerClient := rest_model.ServiceLimit(service.MaxCircuitsPerClient)
+		maxDialRate := rest_model.ServiceLimit(service.MaxDialRate)
+		maxRouterBandwidth := rest_model.RouterBandwidthLimit(service.MaxRouterBandwidth)
+		maxCircuitBandwidth := rest_model.BandwidthLimit(service.MaxCircuitBandwidth)
+		result.Services = append(result.Services, &rest_model.ServiceExport{
+			ID:                   service.Id,
+			Name:                 &service.Name,
+			TerminatorStrategy:   service.TerminatorStrategy,
+			MaxCircuits:          &maxCircuits,
+			MaxCircuitsPerClient: &maxCircuitsPerClient,
+			MaxDialRate:          &maxDialRate,
+			Priority:             rest_model.ServicePriority(service.GetPriority()),
+			MaxRouterBandwidth:   &maxRouterBandwidth,
+			MaxCircuitBandwidth:  &maxCircuitBandwidth,
+			Tags:                 &rest_model.Tags{SubTags: service.Tags},
+		})
+	}
+
+	for _, router := range entities.Routers {
+		cost := int64(router.Cost)
+		result.Routers = append(result.Routers, &rest_model.RouterExport{
+			ID:          router.Id,
+			Name:        &router.Name,
+			Fingerprint: router.Fingerprint,
+			Cost:        &cost,
+			NoTraversal: router.NoTraversal,
+			Tags:        &rest_model.Tags{SubTags: router.Tags},
+		})
+	}
+
+	for _, terminator := range entities.Terminators {
+		cost := rest_model.TerminatorCost(int64(terminator.Cost))
+		precedence := rest_model.TerminatorPrecedenceDefault
+		if terminator.Precedence.IsRequired() {
+			precedence = rest_model.TerminatorPrecedenceRequired
+		} else if terminator.Precedence.IsFailed() {
+			precedence = rest_model.TerminatorPrecedenceFailed
+		}
+
+		var peerData map[string]strfmt.Base64
+		if len(terminator.PeerData) > 0 {
+			peerData = map[string]strfmt.Base64{}
+			for k, v := range terminator.PeerData {
+				peerData[strconv.FormatUint(uint64(k), 10)] = v
+			}
+		}
+
+		result.Terminators = append(result.Terminators, &rest_model.TerminatorExport{
+			ID:             &terminator.Id,
+			Service:        &terminator.Service,
+			Router:         &terminator.Router,
+			Binding:        terminator.Binding,
+			Address:        &terminator.Address,
+			InstanceID:     terminator.InstanceId,
+			InstanceSecret: terminator.InstanceSecret,
+			Cost:           &cost,
+			Precedence:     precedence,
+			Weight:         rest_model.TerminatorWeight(int64(terminator.Weight)),
+			PeerData:       peerData,
+			HostID:         terminator.HostId,
+			Tags:           &rest_model.Tags{SubTags: terminator.Tags},
+		})
+	}
+
+	return result
+}
+
+func MapRestModelToEntitySet(entities *rest_model.DatabaseEntities) (*network.EntitySet, error) {
+	result := &network.EntitySet{}
+
+	for _, service := range entities.Services {
+		result.Services = append(result.Services, &network.Service{
+			BaseEntity: models.BaseEntity{
+				Id:   service.ID,
+				Tags: TagsOrDefault(service.Tags),
+			},
+			Name:                 stringz.OrEmpty(service.Name),
+			TerminatorStrategy:   service.TerminatorStrategy,
+			MaxCircuits:          ServiceLimitOrDefault(service.MaxCircuits),
+			MaxCircuitsPerClient: ServiceLimitOrDefault(service.MaxCircuitsPerClient),
+			MaxDialRate:          ServiceLimitOrDefault(service.MaxDialRate),
+			Priority:             string(service.Priority),
+			MaxRouterBandwidth:   RouterBandwidthLimitOrDefault(service.MaxRouterBandwidth),
+			MaxCircuitBandwidth:  BandwidthLimitOrDefault(service.MaxCircuitBandwidth),
+		})
+	}
+
+	for _, router := range entities.Routers {
+		result.Routers = append(result.Routers, &network.Router{
+			BaseEntity: models.BaseEntity{
+				Id:   router.ID,
+				Tags: TagsOrDefault(router.Tags),
+			},
+			Name:        stringz.OrEmpty(router.Name),
+			Fingerprint: router.Fingerprint,
+			Cost:        uint16(Int64OrDefault(router.Cost)),
+			NoTraversal: router.NoTraversal,
+		})
+	}
+
+	for _, terminator := range entities.Terminators {
+		var peerData map[uint32][]byte
+		if len(terminator.PeerData) > 0 {
+			peerData = map[uint32][]byte{}
+			for k, v := range terminator.PeerData {
+				key, err := strconv.ParseUint(k, 10, 32)
+				if err != nil {
+					return nil, errorz.NewFieldError("peer data keys must be unsigned integers", "peerData", k)
+				}
+				peerData[uint32(key)] = v
+			}
+		}
+
+		t := &network.Terminator{
+			BaseEntity: models.BaseEntity{
+				Id:   stringz.OrEmpty(terminator.ID),
+				Tags: TagsOrDefault(terminator.Tags),
+			},
+			Service:        stringz.OrEmpty(terminator.Service),
+			Router:         stringz.OrEmpty(terminator.Router),
+			Binding:        terminator.Binding,
+			Address:        stringz.OrEmpty(terminator.Address),
+			InstanceId:     terminator.InstanceID,
+			InstanceSecret: terminator.InstanceSecret,
+			Precedence:     xt.GetPrecedenceForName(string(terminator.Precedence)),
+			Weight:         uint16(terminator.Weight),
+			PeerData:       peerData,
+			HostId:         terminator.HostID,
+		}
+
+		if terminator.Cost != nil {
+			t.Cost = uint16(*terminator.Cost)
+		}
+
+		result.Terminators = append(result.Terminators, t)
+	}
+
+	return result, nil
+}
+
+func MapImportChangesToRestModel(changes []*network.ImportChange, dryRun bool) *rest_model.DatabaseImportResult {
+	result := &rest_model.DatabaseImportResult{
+		DryRun:  &dryRun,
+		Changes: []*rest_model.DatabaseImportChange{},
+	}
+
+	for _, change := range changes {
+		entityType := change.EntityType
+		id := change.Id
+		action := string(change.Action)
+		result.Changes = append(result.Changes, &rest_model.DatabaseImportChange{
+			EntityType:    &entityType,
+			ID:            &id,
+			Name:          change.Name,
+			Action:        &action,
+			ChangedFields: change.ChangedFields,
+		})
+	}
+
+	return result
+}
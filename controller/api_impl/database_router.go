@@ -29,6 +29,8 @@ import (
 
 	"github.com/openziti/fabric/controller/network"
 	"github.com/openziti/foundation/v2/concurrenz"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
 	"net/http"
 	"sync"
 	"time"
@@ -74,6 +76,22 @@ func (r *DatabaseRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper R
 	fabricApi.DatabaseDataIntegrityResultsHandler = database.DataIntegrityResultsHandlerFunc(func(params database.DataIntegrityResultsParams, _ interface{}) middleware.Responder {
 		return wrapper.WrapRequest(r.GetCheckProgress, params.HTTPRequest, "", "")
 	})
+
+	fabricApi.DatabaseExportDatabaseHandler = database.ExportDatabaseHandlerFunc(func(params database.ExportDatabaseParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(r.Export, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.DatabaseImportDatabaseHandler = database.ImportDatabaseHandlerFunc(func(params database.ImportDatabaseParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) {
+			r.applyEntities(rc, params.Entities, params.DryRun, n.Managers.Import)
+		}, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.DatabaseReconcileDatabaseHandler = database.ReconcileDatabaseHandlerFunc(func(params database.ReconcileDatabaseParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) {
+			r.applyEntities(rc, params.Entities, params.DryRun, n.Managers.Reconcile)
+		}, params.HTTPRequest, "", "")
+	})
 }
 
 func (r *DatabaseRouter) CreateSnapshot(n *network.Network, rc api.RequestContext) {
@@ -88,6 +106,48 @@ func (r *DatabaseRouter) CreateSnapshot(n *network.Network, rc api.RequestContex
 	rc.RespondWithEmptyOk()
 }
 
+func (r *DatabaseRouter) Export(n *network.Network, rc api.RequestContext) {
+	entities, err := n.Managers.Export()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+	rc.Respond(MapEntitySetToRestModel(entities), http.StatusOK)
+}
+
+func (r *DatabaseRouter) applyEntities(rc api.RequestContext, restEntities *rest_model.DatabaseEntities, dryRunParam *bool,
+	apply func(entities *network.EntitySet, dryRun bool) ([]*network.ImportChange, error)) {
+	entities, err := MapRestModelToEntitySet(restEntities)
+	if err != nil {
+		if fe, ok := err.(*errorz.FieldError); ok {
+			rc.RespondWithFieldError(fe)
+			return
+		}
+		rc.RespondWithError(err)
+		return
+	}
+
+	dryRun := BoolOrDefault(dryRunParam)
+	changes, err := apply(entities, dryRun)
+	if err != nil {
+		if boltz.IsErrNotFoundErr(err) {
+			rc.RespondWithNotFoundWithCause(err)
+			return
+		}
+		if fe, ok := err.(*errorz.FieldError); ok {
+			rc.RespondWithFieldError(fe)
+			return
+		}
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.DatabaseImportEnvelope{
+		Data: MapImportChangesToRestModel(changes, dryRun),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
 func (r *DatabaseRouter) CheckDatastoreIntegrity(n *network.Network, rc api.RequestContext, fixErrors bool) {
 	if r.integrityCheck.running.CompareAndSwap(false, true) {
 		r.integrityCheck.fixingErrors = fixErrors
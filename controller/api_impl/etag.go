@@ -0,0 +1,54 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/models"
+)
+
+const ETagHeader = "ETag"
+
+// SetETag sets the ETag response header from the entity version, if the entity is versioned
+func SetETag(rc api.RequestContext, entity interface{}) {
+	if versioned, ok := entity.(models.VersionedEntity); ok {
+		rc.GetResponseWriter().Header().Set(ETagHeader, strconv.Quote(strconv.FormatUint(versioned.GetVersion(), 10)))
+	}
+}
+
+// IfMatchVersion returns the entity version required by an If-Match header, suitable for passing to UpdateIfVersion.
+// An empty string is returned if no header was given or if it matches any version. Values which aren't ETags we
+// issued are passed through as-is, so they fail the version check with a 412, rather than being ignored
+func IfMatchVersion(ifMatch *string) string {
+	if ifMatch == nil {
+		return ""
+	}
+
+	val := strings.TrimSpace(*ifMatch)
+	if val == "*" {
+		return ""
+	}
+
+	val = strings.TrimPrefix(val, "W/")
+	if unquoted, err := strconv.Unquote(val); err == nil {
+		return unquoted
+	}
+	return val
+}
@@ -0,0 +1,106 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/ast"
+	"github.com/openziti/storage/boltz"
+)
+
+// ListCursor marks a position in a list of entities ordered by id. It's handed to clients in an encoded form, which
+// they should treat as opaque. Since the position is an id rather than an offset, entities being added or removed
+// between pages won't cause results to be skipped or repeated
+type ListCursor struct {
+	After string `json:"after"`
+}
+
+func (cursor *ListCursor) Encode() string {
+	val, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(val)
+}
+
+func DecodeListCursor(val string) (*ListCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return nil, errorz.NewInvalidPagination(errorz.NewFieldError("could not parse cursor, value is not valid", "cursor", val))
+	}
+
+	cursor := &ListCursor{}
+	if err = json.Unmarshal(decoded, cursor); err != nil || cursor.After == "" {
+		return nil, errorz.NewInvalidPagination(errorz.NewFieldError("could not parse cursor, value is not valid", "cursor", val))
+	}
+
+	return cursor, nil
+}
+
+// validate checks that the query can be read from the cursor position. Cursors can only be used with the default id
+// ordering and can't be combined with an offset
+func (cursor *ListCursor) validate(query ast.Query) error {
+	if !isIdOrdered(query) {
+		return errorz.NewInvalidSort(errorz.NewFieldError("lists read with a cursor are ordered by id and can't use another sort", "sort", query.GetSortFields()[0].Symbol()))
+	}
+
+	if skip := query.GetSkip(); skip != nil && *skip > 0 {
+		return errorz.NewInvalidPagination(errorz.NewFieldError("cursor and offset can't be used together", "offset", *skip))
+	}
+
+	return nil
+}
+
+// apply restricts the query to entities after the cursor position. It's used where the list can't seek to the cursor
+// position, such as lists with a custom query function, so every entity before the cursor position is still scanned
+func (cursor *ListCursor) apply(store boltz.ListStore, query ast.Query) error {
+	if err := cursor.validate(query); err != nil {
+		return err
+	}
+
+	cursorQuery, err := ast.Parse(store, `id > "`+escapeQueryString(cursor.After)+`"`)
+	if err != nil {
+		return errorz.NewInvalidPagination(errorz.NewFieldError("could not apply cursor", "cursor", cursor.Encode()))
+	}
+
+	query.SetPredicate(ast.NewAndExprNode(query.GetPredicate(), cursorQuery.GetPredicate()))
+	query.SetSkip(0)
+	return nil
+}
+
+// isIdOrdered returns true if the query results will be in ascending id order, which is required for a cursor to
+// be generated
+func isIdOrdered(query ast.Query) bool {
+	sortFields := query.GetSortFields()
+	return len(sortFields) == 0 || (len(sortFields) == 1 && sortFields[0].Symbol() == "id" && sortFields[0].IsAscending())
+}
+
+// nextListCursor returns the encoded cursor for the page following the given entities, or an empty string if there
+// are no further results or the results aren't in id order
+func nextListCursor[T models.Entity](query ast.Query, entities []T, metadata *models.QueryMetaData) string {
+	if len(entities) == 0 || !isIdOrdered(query) || metadata.Offset+int64(len(entities)) >= metadata.Count {
+		return ""
+	}
+	cursor := &ListCursor{After: entities[len(entities)-1].GetId()}
+	return cursor.Encode()
+}
+
+func escapeQueryString(val string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(val)
+}
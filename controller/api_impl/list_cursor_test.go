@@ -0,0 +1,276 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+func TestListCursor(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	for i := 0; i < 5; i++ {
+		id := fmt.Sprintf("svc-%v", i)
+		ctx.RequireCreate(&db.Service{
+			BaseExtEntity:      boltz.BaseExtEntity{Id: id},
+			Name:               id,
+			TerminatorStrategy: "smartrouting",
+		})
+	}
+
+	store := ctx.GetStoreForEntity(&db.Service{})
+
+	list := func(qo *PublicQueryOptions) ([]string, int64, error) {
+		var ids []string
+		var count int64
+		err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+			query, err := qo.getFullQuery(store)
+			if err != nil {
+				return err
+			}
+			ids, count, err = store.QueryIdsC(tx, query)
+			return err
+		})
+		return ids, count, err
+	}
+
+	var cursor *ListCursor
+	var pages [][]string
+	for {
+		ids, count, err := list(&PublicQueryOptions{Paging: &Paging{Limit: 2}, Cursor: cursor})
+		req.NoError(err)
+		pages = append(pages, ids)
+		if int64(len(ids)) >= count {
+			break
+		}
+		cursor, err = DecodeListCursor((&ListCursor{After: ids[len(ids)-1]}).Encode())
+		req.NoError(err)
+	}
+
+	req.Equal([][]string{{"svc-0", "svc-1"}, {"svc-2", "svc-3"}, {"svc-4"}}, pages)
+
+	// entities removed before the cursor position shouldn't shift later pages
+	ctx.RequireDelete(&db.Service{BaseExtEntity: boltz.BaseExtEntity{Id: "svc-0"}})
+	ids, _, err := list(&PublicQueryOptions{Predicate: `name != "svc-3"`, Paging: &Paging{Limit: 2}, Cursor: &ListCursor{After: "svc-1"}})
+	req.NoError(err)
+	req.Equal([]string{"svc-2", "svc-4"}, ids)
+
+	_, _, err = list(&PublicQueryOptions{Sort: "name", Cursor: &ListCursor{After: "svc-1"}})
+	req.Error(err)
+	req.Equal(errorz.InvalidSortCode, err.(*errorz.ApiError).Code)
+
+	_, _, err = list(&PublicQueryOptions{Paging: &Paging{Offset: 1}, Cursor: &ListCursor{After: "svc-1"}})
+	req.Error(err)
+	req.Equal(errorz.InvalidPaginationCode, err.(*errorz.ApiError).Code)
+
+	_, err = DecodeListCursor("not a cursor")
+	req.Error(err)
+
+	// quotes in ids must not break out of the cursor predicate
+	ids, _, err = list(&PublicQueryOptions{Cursor: &ListCursor{After: `svc-2" or true or id = "`}})
+	req.NoError(err)
+	req.Equal([]string{"svc-3", "svc-4"}, ids)
+}
+
+func TestListAfter(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	for i := 0; i < 5; i++ {
+		id := fmt.Sprintf("svc-%v", i)
+		ctx.RequireCreate(&db.Service{
+			BaseExtEntity:      boltz.BaseExtEntity{Id: id},
+			Name:               id,
+			TerminatorStrategy: "smartrouting",
+		})
+	}
+
+	store := ctx.GetStoreForEntity(&db.Service{})
+	manager := &models.BaseEntityManager{Store: store}
+
+	list := func(qo *PublicQueryOptions) ([]string, *models.QueryMetaData, error) {
+		var ids []string
+		var qmd *models.QueryMetaData
+		err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+			query, err := qo.getQuery(store)
+			if err != nil {
+				return err
+			}
+			return manager.PreparedListAfterWithTx(tx, qo.Cursor.After, query, func(tx *bbolt.Tx, result []string, metaData *models.QueryMetaData) error {
+				ids, qmd = result, metaData
+				return nil
+			})
+		})
+		return ids, qmd, err
+	}
+
+	ids, qmd, err := list(&PublicQueryOptions{Paging: &Paging{Limit: 2}, Cursor: &ListCursor{After: "svc-1"}})
+	req.NoError(err)
+	req.Equal([]string{"svc-2", "svc-3"}, ids)
+	req.Equal(int64(3), qmd.Count)
+
+	ids, qmd, err = list(&PublicQueryOptions{Predicate: `name != "svc-3"`, Paging: &Paging{Limit: 2}, Cursor: &ListCursor{After: "svc-1"}})
+	req.NoError(err)
+	req.Equal([]string{"svc-2", "svc-4"}, ids)
+	req.Equal(int64(2), qmd.Count)
+
+	// the cursor entity may have been deleted since the previous page was read
+	ids, _, err = list(&PublicQueryOptions{Paging: &Paging{Limit: 2}, Cursor: &ListCursor{After: "svc-10"}})
+	req.NoError(err)
+	req.Equal([]string{"svc-2", "svc-3"}, ids)
+
+	_, _, err = list(&PublicQueryOptions{Sort: "name", Cursor: &ListCursor{After: "svc-1"}})
+	req.Error(err)
+	req.Equal(errorz.InvalidSortCode, err.(*errorz.ApiError).Code)
+
+	ctx.RequireCreate(&db.Router{BaseExtEntity: boltz.BaseExtEntity{Id: "r1"}, Name: "r1"})
+	for i := 0; i < 5; i++ {
+		ctx.RequireCreate(&db.Terminator{
+			BaseExtEntity: boltz.BaseExtEntity{Id: fmt.Sprintf("t-%v", i)},
+			Service:       "svc-4",
+			Router:        "r1",
+			Binding:       "transport",
+			Address:       "tcp:localhost:1234",
+		})
+	}
+
+	listTerminators := func(after string, limit int64, predicate string) ([]string, *models.QueryMetaData) {
+		var ids []string
+		var qmd *models.QueryMetaData
+		err := ctx.GetDb().View(func(tx *bbolt.Tx) error {
+			query, err := (&PublicQueryOptions{Predicate: predicate, Paging: &Paging{Limit: limit}, Cursor: &ListCursor{After: after}}).getQuery(store)
+			if err != nil {
+				return err
+			}
+			return manager.PreparedListAssociatedAfterWithTx(tx, "svc-4", db.EntityTypeTerminators, after, query, func(tx *bbolt.Tx, result []string, metaData *models.QueryMetaData) error {
+				ids, qmd = result, metaData
+				return nil
+			})
+		})
+		req.NoError(err)
+		return ids, qmd
+	}
+
+	ids, qmd = listTerminators("t-0", 2, "")
+	req.Equal([]string{"t-1", "t-2"}, ids)
+	req.Equal(int64(3), qmd.Count)
+
+	ids, qmd = listTerminators("t-2", 2, "")
+	req.Equal([]string{"t-3", "t-4"}, ids)
+	req.Equal(int64(2), qmd.Count)
+
+	// a batch with no matches moves on to the next
+	ids, qmd = listTerminators("t-0", 1, `id != "t-1" and id != "t-2"`)
+	req.Equal([]string{"t-3"}, ids)
+	req.Equal(int64(2), qmd.Count)
+}
+
+type projectionTestBase struct {
+	Id   string            `json:"id"`
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+type projectionTestElement struct {
+	projectionTestBase
+	Name *string `json:"name"`
+	Cost int     `json:"cost"`
+}
+
+func TestProjectFields(t *testing.T) {
+	req := require.New(t)
+
+	name := "one"
+	result := &QueryResult{Result: []interface{}{
+		&projectionTestElement{projectionTestBase: projectionTestBase{Id: "a"}, Name: &name, Cost: 1},
+	}}
+	req.NoError(projectFields(result, []string{"id", "cost", "tags", "missing"}))
+
+	projected := result.Result.([]interface{})
+	req.Len(projected, 1)
+
+	encoded, err := json.Marshal(projected[0])
+	req.NoError(err)
+	req.JSONEq(`{"id": "a", "cost": 1}`, string(encoded))
+
+	result = &QueryResult{Result: []map[string]interface{}{{"id": "b", "name": "two"}}}
+	req.NoError(projectFields(result, []string{"name"}))
+	encoded, err = json.Marshal(result.Result)
+	req.NoError(err)
+	req.JSONEq(`[{"name": "two"}]`, string(encoded))
+}
+
+func BenchmarkProjectFields(b *testing.B) {
+	name := "one"
+	elements := make([]interface{}, 500)
+	for i := range elements {
+		elements[i] = &projectionTestElement{
+			projectionTestBase: projectionTestBase{Id: fmt.Sprintf("id-%v", i), Tags: map[string]string{"key": "value"}},
+			Name:               &name,
+			Cost:               i,
+		}
+	}
+
+	b.Run("projected", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := &QueryResult{Result: elements}
+			if err := projectFields(result, []string{"id", "cost"}); err != nil {
+				b.Fatal(err)
+			}
+			if _, err := json.Marshal(result.Result); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("json round trip", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			projected := make([]interface{}, 0, len(elements))
+			for _, element := range elements {
+				p, err := projectJson(element, []string{"id", "cost"})
+				if err != nil {
+					b.Fatal(err)
+				}
+				projected = append(projected, p)
+			}
+			if _, err := json.Marshal(projected); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("full", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(elements); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
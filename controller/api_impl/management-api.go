@@ -20,12 +20,15 @@ import (
 	"crypto/x509"
 	"fmt"
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
 	"github.com/gorilla/websocket"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel"
 	"github.com/openziti/channel/websockets"
+	"github.com/openziti/fabric/controller/api"
 	"github.com/openziti/fabric/controller/handler_mgmt"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/rbac"
 	"github.com/openziti/fabric/controller/xmgmt"
 	"github.com/openziti/fabric/rest_client"
 	"github.com/openziti/fabric/rest_server"
@@ -43,21 +46,26 @@ const (
 var _ xweb.ApiHandlerFactory = &ManagementApiFactory{}
 
 type ManagementApiFactory struct {
-	InitFunc func(managementApi *ManagementApiHandler) error
-	network  *network.Network
-	nodeId   identity.Identity
-	xmgmts   []xmgmt.Xmgmt
+	InitFunc   func(managementApi *ManagementApiHandler) error
+	network    *network.Network
+	nodeId     identity.Identity
+	xmgmts     []xmgmt.Xmgmt
+	rbacConfig *rbac.Config
 }
 
 func (factory *ManagementApiFactory) Validate(_ *xweb.InstanceConfig) error {
 	return nil
 }
 
-func NewManagementApiFactory(nodeId identity.Identity, network *network.Network, xmgmts []xmgmt.Xmgmt) *ManagementApiFactory {
+func NewManagementApiFactory(nodeId identity.Identity, network *network.Network, xmgmts []xmgmt.Xmgmt, rbacConfig *rbac.Config) *ManagementApiFactory {
+	if rbacConfig == nil {
+		rbacConfig = rbac.DefaultConfig()
+	}
 	return &ManagementApiFactory{
-		network: network,
-		nodeId:  nodeId,
-		xmgmts:  xmgmts,
+		network:    network,
+		nodeId:     nodeId,
+		xmgmts:     xmgmts,
+		rbacConfig: rbacConfig,
 	}
 }
 
@@ -73,11 +81,15 @@ func (factory *ManagementApiFactory) New(_ *xweb.ServerConfig, options map[inter
 
 	fabricAPI := operations.NewZitiFabricAPI(managementSpec)
 	fabricAPI.ServeError = ServeError
+	fabricAPI.YamlConsumer = api.YAMLConsumer()
+	fabricAPI.YamlProducer = api.YAMLProducer()
+	fabricAPI.TextVndGraphvizProducer = runtime.TextProducer()
 
 	if requestWrapper == nil {
 		requestWrapper = &FabricRequestWrapper{
-			nodeId:  factory.nodeId,
-			network: factory.network,
+			nodeId:     factory.nodeId,
+			network:    factory.network,
+			rbacConfig: factory.rbacConfig,
 		}
 	}
 
@@ -91,7 +103,7 @@ func (factory *ManagementApiFactory) New(_ *xweb.ServerConfig, options map[inter
 		return nil, err
 	}
 
-	managementApiHandler.bindHandler = handler_mgmt.NewBindHandler(factory.network, factory.xmgmts)
+	managementApiHandler.bindHandler = handler_mgmt.NewBindHandler(factory.network, factory.xmgmts, factory.rbacConfig)
 
 	if factory.InitFunc != nil {
 		if err := factory.InitFunc(managementApiHandler); err != nil {
@@ -0,0 +1,173 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// projectFields replaces each element of the result with only the requested top level fields of its json form. If no
+// fields are requested, the result is left as is. Fields which the elements don't have are ignored
+func projectFields(result *QueryResult, fields []string) error {
+	if len(fields) == 0 || reflect.TypeOf(result.Result).Kind() != reflect.Slice {
+		return nil
+	}
+
+	slice := reflect.ValueOf(result.Result)
+	projected := make([]interface{}, 0, slice.Len())
+
+	for i := 0; i < slice.Len(); i++ {
+		element, err := projectElement(slice.Index(i).Interface(), fields)
+		if err != nil {
+			return err
+		}
+		projected = append(projected, element)
+	}
+
+	result.Result = projected
+	return nil
+}
+
+// projectElement picks the requested fields out of a rest model by their json names, so only the selected values are
+// marshalled when the response is written. Anything other than a struct goes through a json round trip instead
+func projectElement(element interface{}, fields []string) (map[string]interface{}, error) {
+	val := reflect.ValueOf(element)
+	for val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return map[string]interface{}{}, nil
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return projectJson(element, fields)
+	}
+
+	jsonFields := getJsonFields(val.Type())
+	result := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if f, found := jsonFields[field]; found {
+			if fieldVal, ok := fieldByIndex(val, f.index); ok && !(f.omitEmpty && isEmptyValue(fieldVal)) {
+				result[field] = fieldVal.Interface()
+			}
+		}
+	}
+	return result, nil
+}
+
+func projectJson(element interface{}, fields []string) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(element)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal list element for field projection")
+	}
+
+	all := map[string]json.RawMessage{}
+	if err = json.Unmarshal(encoded, &all); err != nil {
+		return nil, errors.Wrap(err, "unable to project fields of non-object list element")
+	}
+
+	result := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if val, found := all[field]; found {
+			result[field] = val
+		}
+	}
+	return result, nil
+}
+
+type jsonField struct {
+	index     []int
+	omitEmpty bool
+}
+
+var jsonFieldCache sync.Map
+
+// getJsonFields maps the json names of a struct's fields to their locations, including the fields of embedded
+// structs, which the rest models flatten into their json form
+func getJsonFields(t reflect.Type) map[string]*jsonField {
+	if cached, found := jsonFieldCache.Load(t); found {
+		return cached.(map[string]*jsonField)
+	}
+
+	result := map[string]*jsonField{}
+	addJsonFields(t, nil, result)
+	jsonFieldCache.Store(t, result)
+	return result
+}
+
+func addJsonFields(t reflect.Type, parentIndex []int, result map[string]*jsonField) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		index := append(append([]int{}, parentIndex...), i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addJsonFields(embedded, index, result)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		// fields closer to the top level win, as with encoding/json
+		if existing, found := result[name]; !found || len(existing.index) > len(index) {
+			result[name] = &jsonField{index: index, omitEmpty: strings.Contains(opts, "omitempty")}
+		}
+	}
+}
+
+func fieldByIndex(val reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if val.Kind() == reflect.Pointer {
+			if val.IsNil() {
+				return reflect.Value{}, false
+			}
+			val = val.Elem()
+		}
+		val = val.Field(i)
+	}
+	return val, true
+}
+
+func isEmptyValue(val reflect.Value) bool {
+	switch val.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return val.Len() == 0
+	}
+	return val.IsZero()
+}
@@ -34,16 +34,36 @@ type PublicQueryOptions struct {
 	Predicate string
 	Sort      string
 	Paging    *Paging
+	Cursor    *ListCursor
+	Fields    []string
 }
 
 func (qo *PublicQueryOptions) String() string {
 	if qo == nil {
 		return "nil"
 	}
-	return fmt.Sprintf("[QueryOption Predicate: '%v', Sort: '%v', Paging: '%v']", qo.Predicate, qo.Sort, qo.Paging)
+	return fmt.Sprintf("[QueryOption Predicate: '%v', Sort: '%v', Paging: '%v', Cursor: '%v', Fields: '%v']", qo.Predicate, qo.Sort, qo.Paging, qo.Cursor, qo.Fields)
 }
 
+// getFullQuery returns the query with the cursor position, if any, added to the predicate
 func (qo *PublicQueryOptions) getFullQuery(store boltz.ListStore) (ast.Query, error) {
+	query, err := qo.getQuery(store)
+	if err != nil {
+		return nil, err
+	}
+
+	if qo.Cursor != nil {
+		if err = qo.Cursor.apply(store, query); err != nil {
+			return nil, err
+		}
+	}
+
+	return query, nil
+}
+
+// getQuery returns the query without the cursor position applied. If there's a cursor, it's checked against the
+// query, so the caller can seek to the cursor position instead
+func (qo *PublicQueryOptions) getQuery(store boltz.ListStore) (ast.Query, error) {
 	if qo.Predicate == "" {
 		qo.Predicate = "true"
 	}
@@ -92,6 +112,12 @@ func (qo *PublicQueryOptions) getFullQuery(store boltz.ListStore) (ast.Query, er
 		}
 	}
 
+	if qo.Cursor != nil {
+		if err = qo.Cursor.validate(query); err != nil {
+			return nil, err
+		}
+	}
+
 	return query, nil
 }
 
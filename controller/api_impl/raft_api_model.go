@@ -0,0 +1,74 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/openziti/fabric/controller/raft"
+	"github.com/openziti/fabric/rest_model"
+)
+
+func MapRaftMembersToRestModel(members []*raft.Member) rest_model.RaftMemberList {
+	result := rest_model.RaftMemberList{}
+	for _, member := range members {
+		result = append(result, MapRaftMemberToRestModel(member))
+	}
+	return result
+}
+
+func MapRaftMemberToRestModel(member *raft.Member) *rest_model.RaftMember {
+	result := &rest_model.RaftMember{
+		ID:        &member.Id,
+		Address:   &member.Addr,
+		Voter:     &member.Voter,
+		Leader:    &member.Leader,
+		Connected: &member.Connected,
+	}
+
+	if !member.LastContact.IsZero() {
+		lastContact := strfmt.DateTime(member.LastContact)
+		result.LastContact = &lastContact
+	}
+
+	return result
+}
+
+func MapRaftClusterStatusToRestModel(status *raft.ClusterStatus) *rest_model.RaftClusterStatus {
+	term := int64(status.Term)
+	commitIndex := int64(status.CommitIndex)
+	appliedIndex := int64(status.AppliedIndex)
+	lastLogIndex := int64(status.LastLogIndex)
+	snapshotIndex := int64(status.Snapshot.LastIndex)
+	snapshotTerm := int64(status.Snapshot.LastTerm)
+
+	return &rest_model.RaftClusterStatus{
+		ID:            &status.Id,
+		Address:       &status.Addr,
+		State:         &status.State,
+		Term:          &term,
+		LeaderID:      status.LeaderId,
+		LeaderAddress: status.LeaderAddr,
+		CommitIndex:   &commitIndex,
+		AppliedIndex:  &appliedIndex,
+		LastLogIndex:  &lastLogIndex,
+		Snapshot: &rest_model.RaftSnapshotStatus{
+			LastIndex: &snapshotIndex,
+			LastTerm:  &snapshotTerm,
+		},
+		Members: MapRaftMembersToRestModel(status.Members),
+	}
+}
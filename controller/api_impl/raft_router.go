@@ -0,0 +1,177 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/apierror"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/raft"
+	"github.com/openziti/fabric/rest_model"
+	"github.com/openziti/fabric/rest_server/operations"
+	raftOps "github.com/openziti/fabric/rest_server/operations/raft"
+	"github.com/openziti/foundation/v2/stringz"
+	"net/http"
+)
+
+func init() {
+	r := NewRaftRouter()
+	AddRouter(r)
+}
+
+type RaftRouter struct {
+	BasePath string
+}
+
+func NewRaftRouter() *RaftRouter {
+	return &RaftRouter{
+		BasePath: "/raft",
+	}
+}
+
+func (r *RaftRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.RaftRaftClusterStatusHandler = raftOps.RaftClusterStatusHandlerFunc(func(params raftOps.RaftClusterStatusParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(r.ClusterStatus, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RaftRaftListMembersHandler = raftOps.RaftListMembersHandlerFunc(func(params raftOps.RaftListMembersParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(r.ListMembers, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RaftRaftAddMemberHandler = raftOps.RaftAddMemberHandlerFunc(func(params raftOps.RaftAddMemberParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.AddMember(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+
+	fabricApi.RaftRaftRemoveMemberHandler = raftOps.RaftRemoveMemberHandlerFunc(func(params raftOps.RaftRemoveMemberParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(r.RemoveMember, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.RaftRaftTransferLeadershipHandler = raftOps.RaftTransferLeadershipHandlerFunc(func(params raftOps.RaftTransferLeadershipParams, _ interface{}) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.TransferLeadership(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+}
+
+// getRaftController returns the raft controller, responding with an error and returning nil if the controller
+// isn't clustered
+func (r *RaftRouter) getRaftController(n *network.Network, rc api.RequestContext) *raft.Controller {
+	raftController := n.GetRaftController()
+	if raftController == nil {
+		rc.RespondWithApiError(apierror.NewClusterNotEnabled())
+	}
+	return raftController
+}
+
+func (r *RaftRouter) ClusterStatus(n *network.Network, rc api.RequestContext) {
+	raftController := r.getRaftController(n, rc)
+	if raftController == nil {
+		return
+	}
+
+	status, err := raftController.GetClusterStatus()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.RaftClusterStatusEnvelope{
+		Data: MapRaftClusterStatusToRestModel(status),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *RaftRouter) ListMembers(n *network.Network, rc api.RequestContext) {
+	raftController := r.getRaftController(n, rc)
+	if raftController == nil {
+		return
+	}
+
+	members, err := raftController.ListMembers()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.Respond(&rest_model.RaftMemberListEnvelope{
+		Data: MapRaftMembersToRestModel(members),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
+
+func (r *RaftRouter) AddMember(n *network.Network, rc api.RequestContext, params raftOps.RaftAddMemberParams) {
+	raftController := r.getRaftController(n, rc)
+	if raftController == nil {
+		return
+	}
+
+	isVoter := true
+	if params.Member.IsVoter != nil {
+		isVoter = *params.Member.IsVoter
+	}
+
+	req := &raft.JoinRequest{
+		Id:      stringz.OrEmpty(params.Member.ID),
+		Addr:    stringz.OrEmpty(params.Member.Address),
+		IsVoter: isVoter,
+	}
+
+	if err := raftController.Join(req); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithEmptyOk()
+}
+
+func (r *RaftRouter) RemoveMember(n *network.Network, rc api.RequestContext) {
+	raftController := r.getRaftController(n, rc)
+	if raftController == nil {
+		return
+	}
+
+	id, err := rc.GetEntityId()
+	if err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	if err = raftController.RemoveServer(id); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithEmptyOk()
+}
+
+func (r *RaftRouter) TransferLeadership(n *network.Network, rc api.RequestContext, params raftOps.RaftTransferLeadershipParams) {
+	raftController := r.getRaftController(n, rc)
+	if raftController == nil {
+		return
+	}
+
+	var newLeaderId string
+	if params.Transfer != nil {
+		newLeaderId = params.Transfer.NewLeaderID
+	}
+
+	if err := raftController.TransferLeadership(newLeaderId); err != nil {
+		rc.RespondWithError(err)
+		return
+	}
+
+	rc.RespondWithEmptyOk()
+}
@@ -0,0 +1,140 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/apierror"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/raft"
+	"github.com/openziti/fabric/rest_model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaftEndpointsRequireCluster(t *testing.T) {
+	r := NewRaftRouter()
+	n := &network.Network{}
+
+	handlers := map[string]func(n *network.Network, rc api.RequestContext){
+		"status":  r.ClusterStatus,
+		"members": r.ListMembers,
+		"remove":  r.RemoveMember,
+	}
+
+	for name, handler := range handlers {
+		t.Run(name, func(t *testing.T) {
+			req := require.New(t)
+			rc := newTestRequest(http.MethodGet, "/raft")
+			handler(n, rc.RequestContext)
+
+			req.Equal(apierror.ClusterNotEnabledStatus, rc.recorder.Code)
+
+			envelope := &rest_model.APIErrorEnvelope{}
+			req.NoError(json.Unmarshal(rc.recorder.Body.Bytes(), envelope))
+			req.NotNil(envelope.Error)
+			req.Equal(apierror.ClusterNotEnabledCode, envelope.Error.Code)
+		})
+	}
+}
+
+func TestMapRaftClusterStatusToRestModel(t *testing.T) {
+	req := require.New(t)
+
+	lastContact := time.Now().Add(-time.Second)
+	status := &raft.ClusterStatus{
+		Id:           "ctrl1",
+		Addr:         "tls:ctrl1:6262",
+		State:        "Follower",
+		Term:         3,
+		LeaderId:     "ctrl2",
+		LeaderAddr:   "tls:ctrl2:6262",
+		CommitIndex:  10,
+		AppliedIndex: 9,
+		LastLogIndex: 11,
+		Snapshot:     raft.SnapshotStatus{LastIndex: 8, LastTerm: 2},
+		Members: []*raft.Member{
+			{Id: "ctrl1", Addr: "tls:ctrl1:6262", Voter: true, Connected: true, LastContact: lastContact},
+			{Id: "ctrl2", Addr: "tls:ctrl2:6262", Voter: true, Leader: true},
+			{Id: "ctrl3", Addr: "tls:ctrl3:6262"},
+		},
+	}
+
+	result := MapRaftClusterStatusToRestModel(status)
+	req.Equal("ctrl1", *result.ID)
+	req.Equal("tls:ctrl1:6262", *result.Address)
+	req.Equal("Follower", *result.State)
+	req.Equal(int64(3), *result.Term)
+	req.Equal("ctrl2", result.LeaderID)
+	req.Equal("tls:ctrl2:6262", result.LeaderAddress)
+	req.Equal(int64(10), *result.CommitIndex)
+	req.Equal(int64(9), *result.AppliedIndex)
+	req.Equal(int64(11), *result.LastLogIndex)
+	req.Equal(int64(8), *result.Snapshot.LastIndex)
+	req.Equal(int64(2), *result.Snapshot.LastTerm)
+
+	req.Len(result.Members, 3)
+	req.Equal("ctrl1", *result.Members[0].ID)
+	req.True(*result.Members[0].Connected)
+	req.False(*result.Members[0].Leader)
+	req.NotNil(result.Members[0].LastContact)
+	req.True(time.Time(*result.Members[0].LastContact).Equal(lastContact))
+
+	req.True(*result.Members[1].Leader)
+	req.False(*result.Members[1].Connected)
+
+	// members which haven't been heard from shouldn't report a zero last contact time
+	req.False(*result.Members[2].Voter)
+	req.Nil(result.Members[2].LastContact)
+}
+
+func TestMapRaftMembersToRestModel(t *testing.T) {
+	req := require.New(t)
+
+	// an empty membership should map to an empty list, not null
+	result := MapRaftMembersToRestModel(nil)
+	req.NotNil(result)
+	req.Len(result, 0)
+
+	members := []*raft.Member{
+		{Id: "b", Addr: "tls:b:6262"},
+		{Id: "a", Addr: "tls:a:6262"},
+	}
+	result = MapRaftMembersToRestModel(members)
+	req.Len(result, 2)
+	req.Equal("b", *result[0].ID)
+	req.Equal("a", *result[1].ID)
+	req.Equal("tls:a:6262", *result[1].Address)
+}
+
+type testRequest struct {
+	RequestContext api.RequestContext
+	recorder       *httptest.ResponseRecorder
+}
+
+func newTestRequest(method, url string) *testRequest {
+	recorder := httptest.NewRecorder()
+	return &testRequest{
+		RequestContext: NewRequestContext(recorder, httptest.NewRequest(method, url, nil)),
+		recorder:       recorder,
+	}
+}
@@ -95,13 +95,13 @@ func (r *RouterRouter) Delete(network *network.Network, rc api.RequestContext) {
 
 func (r *RouterRouter) Update(n *network.Network, rc api.RequestContext, params router.UpdateRouterParams) {
 	Update(rc, func(id string) error {
-		return n.Managers.Routers.Update(MapUpdateRouterToModel(params.ID, params.Router), nil)
+		return n.Managers.Routers.UpdateIfVersion(MapUpdateRouterToModel(params.ID, params.Router), nil, IfMatchVersion(params.IfMatch))
 	})
 }
 
 func (r *RouterRouter) Patch(n *network.Network, rc api.RequestContext, params router.PatchRouterParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return n.Managers.Routers.Update(MapPatchRouterToModel(params.ID, params.Router), fields.ConcatNestedNames().FilterMaps("tags"))
+		return n.Managers.Routers.UpdateIfVersion(MapPatchRouterToModel(params.ID, params.Router), fields.ConcatNestedNames().FilterMaps("tags"), IfMatchVersion(params.IfMatch))
 	})
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/openziti/foundation/v2/errorz"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 func GetModelQueryOptionsFromRequest(r *http.Request) (*PublicQueryOptions, error) {
@@ -35,13 +36,33 @@ func GetModelQueryOptionsFromRequest(r *http.Request) (*PublicQueryOptions, erro
 		return nil, err
 	}
 
+	var cursor *ListCursor
+	if c := r.URL.Query().Get("cursor"); c != "" {
+		if cursor, err = DecodeListCursor(c); err != nil {
+			return nil, err
+		}
+	}
+
 	return &PublicQueryOptions{
 		Predicate: filter,
 		Sort:      sort,
 		Paging:    pg,
+		Cursor:    cursor,
+		Fields:    GetRequestFields(r),
 	}, nil
 }
 
+// GetRequestFields returns the fields requested via the fields query parameter, or nil if all fields should be returned
+func GetRequestFields(r *http.Request) []string {
+	var result []string
+	for _, field := range strings.Split(r.URL.Query().Get("fields"), ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			result = append(result, field)
+		}
+	}
+	return result
+}
+
 func GetRequestPaging(r *http.Request) (*Paging, error) {
 	l := r.URL.Query().Get("limit")
 	o := r.URL.Query().Get("offset")
@@ -79,6 +100,7 @@ type QueryResult struct {
 	Limit            int64
 	Offset           int64
 	FilterableFields []string
+	NextCursor       string
 }
 
 func NewQueryResult(result interface{}, metadata *models.QueryMetaData) *QueryResult {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/openziti/fabric/rest_model"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/foundation/v2/stringz"
 )
@@ -52,8 +53,14 @@ func MapCreateServiceToModel(service *rest_model.ServiceCreate) *network.Service
 		BaseEntity: models.BaseEntity{
 			Tags: TagsOrDefault(service.Tags),
 		},
-		Name:               stringz.OrEmpty(service.Name),
-		TerminatorStrategy: service.TerminatorStrategy,
+		Name:                 stringz.OrEmpty(service.Name),
+		TerminatorStrategy:   service.TerminatorStrategy,
+		MaxCircuits:          ServiceLimitOrDefault(service.MaxCircuits),
+		MaxCircuitsPerClient: ServiceLimitOrDefault(service.MaxCircuitsPerClient),
+		MaxDialRate:          ServiceLimitOrDefault(service.MaxDialRate),
+		MaxRouterBandwidth:   RouterBandwidthLimitOrDefault(service.MaxRouterBandwidth),
+		MaxCircuitBandwidth:  BandwidthLimitOrDefault(service.MaxCircuitBandwidth),
+		Priority:             string(service.Priority),
 	}
 
 	if ret.Id == "" {
@@ -73,8 +80,14 @@ func MapUpdateServiceToModel(id string, service *rest_model.ServiceUpdate) *netw
 			Tags: TagsOrDefault(service.Tags),
 			Id:   id,
 		},
-		Name:               stringz.OrEmpty(service.Name),
-		TerminatorStrategy: service.TerminatorStrategy,
+		Name:                 stringz.OrEmpty(service.Name),
+		TerminatorStrategy:   service.TerminatorStrategy,
+		MaxCircuits:          ServiceLimitOrDefault(service.MaxCircuits),
+		MaxCircuitsPerClient: ServiceLimitOrDefault(service.MaxCircuitsPerClient),
+		MaxDialRate:          ServiceLimitOrDefault(service.MaxDialRate),
+		MaxRouterBandwidth:   RouterBandwidthLimitOrDefault(service.MaxRouterBandwidth),
+		MaxCircuitBandwidth:  BandwidthLimitOrDefault(service.MaxCircuitBandwidth),
+		Priority:             string(service.Priority),
 	}
 
 	return ret
@@ -86,19 +99,109 @@ func MapPatchServiceToModel(id string, service *rest_model.ServicePatch) *networ
 			Tags: TagsOrDefault(service.Tags),
 			Id:   id,
 		},
-		Name:               service.Name,
-		TerminatorStrategy: service.TerminatorStrategy,
+		Name:                 service.Name,
+		TerminatorStrategy:   service.TerminatorStrategy,
+		MaxCircuits:          ServiceLimitOrDefault(service.MaxCircuits),
+		MaxCircuitsPerClient: ServiceLimitOrDefault(service.MaxCircuitsPerClient),
+		MaxDialRate:          ServiceLimitOrDefault(service.MaxDialRate),
+		MaxRouterBandwidth:   RouterBandwidthLimitOrDefault(service.MaxRouterBandwidth),
+		MaxCircuitBandwidth:  BandwidthLimitOrDefault(service.MaxCircuitBandwidth),
+		Priority:             string(service.Priority),
 	}
 
 	return ret
 }
 
+func ServiceLimitOrDefault(limit *rest_model.ServiceLimit) uint32 {
+	if limit == nil {
+		return 0
+	}
+	return uint32(*limit)
+}
+
+func BandwidthLimitOrDefault(limit *rest_model.BandwidthLimit) uint64 {
+	if limit == nil {
+		return 0
+	}
+	return uint64(*limit)
+}
+
+func RouterBandwidthLimitOrDefault(limit *rest_model.RouterBandwidthLimit) uint64 {
+	if limit == nil {
+		return 0
+	}
+	return uint64(*limit)
+}
+
 type ServiceModelMapper struct{}
 
 func (ServiceModelMapper) ToApi(_ *network.Network, _ api.RequestContext, service *network.Service) (interface{}, error) {
+	maxCircuits := rest_model.ServiceLimit(service.MaxCircuits)
+	maxCircuitsPerClient := rest_model.ServiceLimit(service.MaxCircuitsPerClient)
+	maxDialRate := rest_model.ServiceLimit(service.MaxDialRate)
+	priority := rest_model.ServicePriority(service.GetPriority())
+	maxRouterBandwidth := rest_model.RouterBandwidthLimit(service.MaxRouterBandwidth)
+	maxCircuitBandwidth := rest_model.BandwidthLimit(service.MaxCircuitBandwidth)
+
 	return &rest_model.ServiceDetail{
-		BaseEntity:         BaseEntityToRestModel(service, ServiceLinkFactory),
-		Name:               &service.Name,
-		TerminatorStrategy: &service.TerminatorStrategy,
+		BaseEntity:           BaseEntityToRestModel(service, ServiceLinkFactory),
+		Name:                 &service.Name,
+		TerminatorStrategy:   &service.TerminatorStrategy,
+		MaxCircuits:          &maxCircuits,
+		MaxCircuitsPerClient: &maxCircuitsPerClient,
+		MaxDialRate:          &maxDialRate,
+		Priority:             &priority,
+		MaxRouterBandwidth:   &maxRouterBandwidth,
+		MaxCircuitBandwidth:  &maxCircuitBandwidth,
 	}, nil
 }
+
+type ServiceStatsModelMapper struct{}
+
+func (ServiceStatsModelMapper) ToApi(n *network.Network, _ api.RequestContext, service *network.Service) (interface{}, error) {
+	return MapServiceStatsToRestModel(n.GetServiceStats(service.Id)[0]), nil
+}
+
+func MapServiceStatsToRestModel(stats *network.ServiceStats) *rest_model.ServiceStats {
+	windowStart := strfmt.DateTime(stats.WindowStart)
+	windowEnd := strfmt.DateTime(stats.WindowEnd)
+	dials := int64(stats.Dials)
+	dialSuccesses := int64(stats.DialSuccesses)
+	dialFailures := int64(stats.DialFailures)
+	activeCircuits := int64(stats.ActiveCircuits)
+	rxBytes := int64(stats.RxBytes)
+	txBytes := int64(stats.TxBytes)
+
+	ret := &rest_model.ServiceStats{
+		ServiceID:       &stats.ServiceId,
+		WindowStart:     &windowStart,
+		WindowEnd:       &windowEnd,
+		Dials:           &dials,
+		DialSuccesses:   &dialSuccesses,
+		DialFailures:    &dialFailures,
+		FailuresByCause: map[string]int64{},
+		ActiveCircuits:  &activeCircuits,
+		RxBytes:         &rxBytes,
+		TxBytes:         &txBytes,
+		Terminators:     []*rest_model.ServiceTerminatorStats{},
+	}
+
+	for cause, count := range stats.FailuresByCause {
+		ret.FailuresByCause[cause] = int64(count)
+	}
+
+	for _, terminatorStats := range stats.Terminators {
+		terminatorId := terminatorStats.TerminatorId
+		terminatorDialSuccesses := int64(terminatorStats.DialSuccesses)
+		terminatorDialFailures := int64(terminatorStats.DialFailures)
+		terminatorActiveCircuits := int64(terminatorStats.ActiveCircuits)
+		ret.Terminators = append(ret.Terminators, &rest_model.ServiceTerminatorStats{
+			TerminatorID:   &terminatorId,
+			DialSuccesses:  &terminatorDialSuccesses,
+			DialFailures:   &terminatorDialFailures,
+			ActiveCircuits: &terminatorActiveCircuits,
+		})
+	}
+
+	return ret
+}
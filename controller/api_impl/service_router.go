@@ -68,6 +68,14 @@ func (r *ServiceRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper Re
 	fabricApi.ServiceListServiceTerminatorsHandler = service.ListServiceTerminatorsHandlerFunc(func(params service.ListServiceTerminatorsParams) middleware.Responder {
 		return wrapper.WrapRequest(r.listManagementTerminators, params.HTTPRequest, params.ID, "")
 	})
+
+	fabricApi.ServiceDetailServiceStatsHandler = service.DetailServiceStatsHandlerFunc(func(params service.DetailServiceStatsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.DetailStats, params.HTTPRequest, params.ID, "")
+	})
+
+	fabricApi.ServiceListServiceStatsHandler = service.ListServiceStatsHandlerFunc(func(params service.ListServiceStatsParams) middleware.Responder {
+		return wrapper.WrapRequest(r.ListStats, params.HTTPRequest, "", "")
+	})
 }
 
 func (r *ServiceRouter) ListServices(n *network.Network, rc api.RequestContext) {
@@ -95,16 +103,31 @@ func (r *ServiceRouter) Delete(network *network.Network, rc api.RequestContext)
 
 func (r *ServiceRouter) Update(n *network.Network, rc api.RequestContext, params service.UpdateServiceParams) {
 	Update(rc, func(id string) error {
-		return n.Managers.Services.Update(MapUpdateServiceToModel(params.ID, params.Service), nil)
+		return n.Managers.Services.UpdateIfVersion(MapUpdateServiceToModel(params.ID, params.Service), nil, IfMatchVersion(params.IfMatch))
 	})
 }
 
 func (r *ServiceRouter) Patch(n *network.Network, rc api.RequestContext, params service.PatchServiceParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return n.Managers.Services.Update(MapPatchServiceToModel(params.ID, params.Service), fields.ConcatNestedNames().FilterMaps("tags"))
+		return n.Managers.Services.UpdateIfVersion(MapPatchServiceToModel(params.ID, params.Service), fields.ConcatNestedNames().FilterMaps("tags"), IfMatchVersion(params.IfMatch))
 	})
 }
 
 func (r *ServiceRouter) listManagementTerminators(n *network.Network, rc api.RequestContext) {
 	ListAssociationWithHandler[*network.Service, *network.Terminator](n, rc, n.Managers.Services, n.Managers.Terminators, TerminatorModelMapper{})
 }
+
+func (r *ServiceRouter) ListStats(n *network.Network, rc api.RequestContext) {
+	ListWithHandler[*network.Service](n, rc, n.Managers.Services, ServiceStatsModelMapper{})
+}
+
+func (r *ServiceRouter) DetailStats(n *network.Network, rc api.RequestContext) {
+	// no ETag here, as the stats change without the service changing
+	Detail(rc, func(rc api.RequestContext, id string) (interface{}, error) {
+		svc, err := n.Managers.Services.BaseLoad(id)
+		if err != nil {
+			return nil, err
+		}
+		return ServiceStatsModelMapper{}.ToApi(n, rc, svc)
+	})
+}
@@ -44,6 +44,7 @@ func MapCreateTerminatorToModel(terminator *rest_model.TerminatorCreate) *networ
 		InstanceSecret: terminator.InstanceSecret,
 		Precedence:     xt.GetPrecedenceForName(string(terminator.Precedence)),
 		HostId:         terminator.HostID,
+		Weight:         uint16(terminator.Weight),
 	}
 
 	if terminator.Cost != nil {
@@ -65,6 +66,7 @@ func MapUpdateTerminatorToModel(id string, terminator *rest_model.TerminatorUpda
 		Address:    stringz.OrEmpty(terminator.Address),
 		Precedence: xt.GetPrecedenceForName(string(terminator.Precedence)),
 		HostId:     terminator.HostID,
+		Weight:     uint16(terminator.Weight),
 	}
 
 	if terminator.Cost != nil {
@@ -86,6 +88,7 @@ func MapPatchTerminatorToModel(id string, terminator *rest_model.TerminatorPatch
 		Address:    terminator.Address,
 		Precedence: xt.GetPrecedenceForName(string(terminator.Precedence)),
 		HostId:     terminator.HostID,
+		Weight:     uint16(terminator.Weight),
 	}
 
 	if terminator.Cost != nil {
@@ -122,6 +125,7 @@ func MapTerminatorToRestModel(n *network.Network, terminator *network.Terminator
 
 	cost := rest_model.TerminatorCost(int64(terminator.Cost))
 	dynamicCost := rest_model.TerminatorCost(xt.GlobalCosts().GetDynamicCost(terminator.Id))
+	weight := rest_model.TerminatorWeight(int64(terminator.Weight))
 
 	ret := &rest_model.TerminatorDetail{
 		BaseEntity:  BaseEntityToRestModel(terminator, TerminatorLinkFactory),
@@ -135,6 +139,7 @@ func MapTerminatorToRestModel(n *network.Network, terminator *network.Terminator
 		Cost:        &cost,
 		DynamicCost: &dynamicCost,
 		HostID:      &terminator.HostId,
+		Weight:      &weight,
 	}
 
 	precedence := terminator.Precedence
@@ -91,12 +91,12 @@ func (r *TerminatorRouter) Delete(n *network.Network, rc api.RequestContext) {
 
 func (r *TerminatorRouter) Update(n *network.Network, rc api.RequestContext, params terminator.UpdateTerminatorParams) {
 	Update(rc, func(id string) error {
-		return n.Managers.Terminators.Update(MapUpdateTerminatorToModel(params.ID, params.Terminator), nil)
+		return n.Managers.Terminators.UpdateIfVersion(MapUpdateTerminatorToModel(params.ID, params.Terminator), nil, IfMatchVersion(params.IfMatch))
 	})
 }
 
 func (r *TerminatorRouter) Patch(n *network.Network, rc api.RequestContext, params terminator.PatchTerminatorParams) {
 	Patch(rc, func(id string, fields fields.UpdatedFields) error {
-		return n.Managers.Terminators.Update(MapPatchTerminatorToModel(params.ID, params.Terminator), fields.FilterMaps("tags"))
+		return n.Managers.Terminators.UpdateIfVersion(MapPatchTerminatorToModel(params.ID, params.Terminator), fields.FilterMaps("tags"), IfMatchVersion(params.IfMatch))
 	})
 }
@@ -0,0 +1,72 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/rest_model"
+)
+
+const (
+	TopologyFormatJson = "json"
+	TopologyFormatDot  = "dot"
+
+	TopologyDotContentType = "text/vnd.graphviz"
+)
+
+func MapTopologyToRestModel(topology *network.Topology) *rest_model.Topology {
+	ret := &rest_model.Topology{
+		Directed: &topology.Directed,
+		Nodes:    []*rest_model.TopologyNode{},
+		Links:    []*rest_model.TopologyLink{},
+	}
+
+	for _, node := range topology.Nodes {
+		cost := int64(node.Cost)
+		ret.Nodes = append(ret.Nodes, &rest_model.TopologyNode{
+			ID:          &node.Id,
+			Name:        &node.Name,
+			Cost:        &cost,
+			NoTraversal: &node.NoTraversal,
+		})
+	}
+
+	for _, link := range topology.Links {
+		staticCost := int64(link.StaticCost)
+		restLink := &rest_model.TopologyLink{
+			ID:            &link.Id,
+			Source:        &link.Source,
+			Target:        &link.Target,
+			Protocol:      &link.Protocol,
+			State:         &link.State,
+			Down:          &link.Down,
+			Cost:          &link.Cost,
+			StaticCost:    &staticCost,
+			SourceLatency: &link.SourceLatency,
+			TargetLatency: &link.TargetLatency,
+		}
+
+		if link.CircuitCount != nil {
+			circuitCount := int64(*link.CircuitCount)
+			restLink.CircuitCount = &circuitCount
+		}
+
+		ret.Links = append(ret.Links, restLink)
+	}
+
+	return ret
+}
@@ -0,0 +1,65 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package api_impl
+
+import (
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openziti/fabric/controller/api"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/rest_model"
+	"github.com/openziti/fabric/rest_server/operations"
+	"github.com/openziti/fabric/rest_server/operations/topology"
+	"github.com/openziti/foundation/v2/stringz"
+	"net/http"
+)
+
+func init() {
+	r := NewTopologyRouter()
+	AddRouter(r)
+}
+
+type TopologyRouter struct {
+	BasePath string
+}
+
+func NewTopologyRouter() *TopologyRouter {
+	return &TopologyRouter{
+		BasePath: "/topology",
+	}
+}
+
+func (r *TopologyRouter) Register(fabricApi *operations.ZitiFabricAPI, wrapper RequestWrapper) {
+	fabricApi.TopologyGetTopologyHandler = topology.GetTopologyHandlerFunc(func(params topology.GetTopologyParams) middleware.Responder {
+		return wrapper.WrapRequest(func(n *network.Network, rc api.RequestContext) { r.Get(n, rc, params) }, params.HTTPRequest, "", "")
+	})
+}
+
+func (r *TopologyRouter) Get(n *network.Network, rc api.RequestContext, params topology.GetTopologyParams) {
+	result := n.GetTopology(BoolOrDefault(params.IncludeCircuits))
+
+	if stringz.OrEmpty(params.Format) == TopologyFormatDot {
+		rc.GetResponseWriter().Header().Set(runtime.HeaderContentType, TopologyDotContentType)
+		rc.RespondWithProducer(runtime.TextProducer(), result.ToDot(), http.StatusOK)
+		return
+	}
+
+	rc.Respond(&rest_model.TopologyEnvelope{
+		Data: MapTopologyToRestModel(result),
+		Meta: &rest_model.Meta{},
+	}, http.StatusOK)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/openziti/fabric/controller/api"
 	"github.com/openziti/fabric/controller/apierror"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/rbac"
 	"github.com/openziti/fabric/rest_server"
 	"github.com/openziti/identity"
 	"github.com/openziti/foundation/v2/errorz"
@@ -35,8 +36,9 @@ type RequestWrapper interface {
 }
 
 type FabricRequestWrapper struct {
-	nodeId  identity.Identity
-	network *network.Network
+	nodeId     identity.Identity
+	network    *network.Network
+	rbacConfig *rbac.Config
 }
 
 func (self *FabricRequestWrapper) WrapRequest(handler RequestHandler, request *http.Request, entityId, entitySubId string) openApiMiddleware.Responder {
@@ -57,6 +59,15 @@ func (self *FabricRequestWrapper) WrapRequest(handler RequestHandler, request *h
 			return
 		}
 
+		if !isReadOnlyRequest(request) {
+			defer self.audit(rc, request)
+		}
+
+		if required := GetRequiredRole(request); !getClientRole(self.rbacConfig, request).Allows(required) {
+			rc.RespondWithError(apierror.NewForbidden(required.String()))
+			return
+		}
+
 		handler(self.network, rc)
 	})
 }
@@ -17,6 +17,8 @@
 package apierror
 
 import (
+	"fmt"
+
 	"github.com/openziti/foundation/v2/errorz"
 )
 
@@ -55,6 +57,22 @@ func NewInvalidAuth() *errorz.ApiError {
 	}
 }
 
+func NewEntityVersionMismatch(expected, actual string) *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:    EntityVersionMismatchCode,
+		Message: fmt.Sprintf("%v: expected version %v, current version is %v", EntityVersionMismatchMessage, expected, actual),
+		Status:  EntityVersionMismatchStatus,
+	}
+}
+
+func NewForbidden(required string) *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:    ForbiddenCode,
+		Message: ForbiddenMessage + ", role required: " + required,
+		Status:  ForbiddenStatus,
+	}
+}
+
 func NewInvalidAuthMethod() *errorz.ApiError {
 	return &errorz.ApiError{
 		Code:    InvalidAuthMethodCode,
@@ -269,3 +287,11 @@ func NewTimeoutError() *errorz.ApiError {
 		Status:  TimeoutStatus,
 	}
 }
+
+func NewClusterNotEnabled() *errorz.ApiError {
+	return &errorz.ApiError{
+		Code:    ClusterNotEnabledCode,
+		Message: ClusterNotEnabledMessage,
+		Status:  ClusterNotEnabledStatus,
+	}
+}
@@ -46,6 +46,14 @@ const (
 	InvalidAuthMessage string = "The authentication request failed"
 	InvalidAuthStatus  int    = http.StatusUnauthorized
 
+	EntityVersionMismatchCode    string = "ENTITY_VERSION_MISMATCH"
+	EntityVersionMismatchMessage string = "The entity has been modified since the version given in the If-Match header"
+	EntityVersionMismatchStatus  int    = http.StatusPreconditionFailed
+
+	ForbiddenCode    string = "FORBIDDEN"
+	ForbiddenMessage string = "The authenticated client is not permitted to perform the requested operation"
+	ForbiddenStatus  int    = http.StatusForbidden
+
 	InvalidAuthMethodCode    string = "INVALID_AUTH_METHOD"
 	InvalidAuthMethodMessage string = "The supplied authentication method is not valid"
 	InvalidAuthMethodStatus  int    = http.StatusBadRequest
@@ -162,4 +170,8 @@ const (
 	MfaNotEnrolledCode    string = "MFA_NOT_ENROLLED"
 	MfaNotEnrolledMessage string = "The current identity is not enrolled in MFA"
 	MfaNotEnrolledStatus  int    = http.StatusConflict
+
+	ClusterNotEnabledCode    string = "CLUSTER_NOT_ENABLED"
+	ClusterNotEnabledMessage string = "The controller is not running as part of a raft cluster"
+	ClusterNotEnabledStatus  int    = http.StatusBadRequest
 )
@@ -0,0 +1,79 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package command
+
+import (
+	"github.com/openziti/fabric/pb/cmd_pb"
+	"github.com/openziti/storage/boltz"
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+)
+
+// BatchCommand applies a set of commands atomically, in a single transaction. Either all the commands are applied,
+// or none of them are. Every command in the batch must implement TxCommand.
+type BatchCommand struct {
+	Db       boltz.Db
+	Commands []Command
+}
+
+func (self *BatchCommand) Validate() error {
+	if len(self.Commands) == 0 {
+		return errors.New("batch command contains no commands")
+	}
+	for _, cmd := range self.Commands {
+		if _, ok := cmd.(TxCommand); !ok {
+			return errors.Errorf("command of type %T can't be included in a batch", cmd)
+		}
+		if validatable, ok := cmd.(Validatable); ok {
+			if err := validatable.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (self *BatchCommand) Apply() error {
+	return self.Db.Update(func(tx *bbolt.Tx) error {
+		return self.ApplyInTx(boltz.NewMutateContext(tx))
+	})
+}
+
+func (self *BatchCommand) ApplyInTx(ctx boltz.MutateContext) error {
+	for _, cmd := range self.Commands {
+		txCmd, ok := cmd.(TxCommand)
+		if !ok {
+			return errors.Errorf("command of type %T can't be included in a batch", cmd)
+		}
+		if err := txCmd.ApplyInTx(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (self *BatchCommand) Encode() ([]byte, error) {
+	msg := &cmd_pb.BatchCommand{}
+	for _, cmd := range self.Commands {
+		encoded, err := cmd.Encode()
+		if err != nil {
+			return nil, err
+		}
+		msg.Commands = append(msg.Commands, encoded)
+	}
+	return cmd_pb.EncodeProtobuf(msg)
+}
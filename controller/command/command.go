@@ -19,6 +19,7 @@ package command
 import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/foundation/v2/debugz"
+	"github.com/openziti/storage/boltz"
 	"github.com/sirupsen/logrus"
 	"reflect"
 )
@@ -33,6 +34,15 @@ type Command interface {
 	Encode() ([]byte, error)
 }
 
+// TxCommand instances can be applied as part of an existing transaction. Only commands which implement TxCommand can
+// be included in a BatchCommand
+type TxCommand interface {
+	Command
+
+	// ApplyInTx runs the command using the given transaction context
+	ApplyInTx(ctx boltz.MutateContext) error
+}
+
 // Validatable instances can be validated. Command instances which implement Validable will be validated
 // before Command.Apply is called
 type Validatable interface {
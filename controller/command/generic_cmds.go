@@ -4,6 +4,7 @@ import (
 	"github.com/openziti/fabric/controller/fields"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/fabric/pb/cmd_pb"
+	"github.com/openziti/storage/boltz"
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
 )
@@ -47,6 +48,26 @@ type EntityDeleter interface {
 	ApplyDelete(cmd *DeleteEntityCommand) error
 }
 
+// EntityTxCreator instances can apply a create entity command as part of an existing transaction
+type EntityTxCreator[T models.Entity] interface {
+	ApplyCreateInTx(ctx boltz.MutateContext, cmd *CreateEntityCommand[T]) error
+}
+
+// EntityTxUpdater instances can apply an update entity command as part of an existing transaction
+type EntityTxUpdater[T models.Entity] interface {
+	ApplyUpdateInTx(ctx boltz.MutateContext, cmd *UpdateEntityCommand[T]) error
+}
+
+// EntityTxDeleter instances can apply a delete entity command as part of an existing transaction
+type EntityTxDeleter interface {
+	ApplyDeleteInTx(ctx boltz.MutateContext, cmd *DeleteEntityCommand) error
+}
+
+// EntityVersionChecker instances can verify that an entity is still at an expected version
+type EntityVersionChecker interface {
+	CheckVersionInTx(tx *bbolt.Tx, id string, version string) error
+}
+
 // EntityManager instances can handle create, update and delete entities of a specific type
 type EntityManager[T models.Entity] interface {
 	EntityCreator[T]
@@ -65,6 +86,13 @@ func (self *CreateEntityCommand[T]) Apply() error {
 	return self.Creator.ApplyCreate(self)
 }
 
+func (self *CreateEntityCommand[T]) ApplyInTx(ctx boltz.MutateContext) error {
+	if creator, ok := self.Creator.(EntityTxCreator[T]); ok {
+		return creator.ApplyCreateInTx(ctx, self)
+	}
+	return errors.Errorf("creates of %v can't be applied in an existing transaction", self.Creator.GetEntityTypeId())
+}
+
 func (self *CreateEntityCommand[T]) Encode() ([]byte, error) {
 	entityType := self.Creator.GetEntityTypeId()
 	encodedEntity, err := self.Creator.Marshall(self.Entity)
@@ -83,12 +111,35 @@ type UpdateEntityCommand[T models.Entity] struct {
 	Entity        T
 	UpdatedFields fields.UpdatedFields
 	Flags         uint32
+	// Version, if set, is the version the entity must be at for the update to be applied
+	Version string
 }
 
 func (self *UpdateEntityCommand[T]) Apply() error {
 	return self.Updater.ApplyUpdate(self)
 }
 
+func (self *UpdateEntityCommand[T]) ApplyInTx(ctx boltz.MutateContext) error {
+	if updater, ok := self.Updater.(EntityTxUpdater[T]); ok {
+		return updater.ApplyUpdateInTx(ctx, self)
+	}
+	return errors.Errorf("updates of %v can't be applied in an existing transaction", self.Updater.GetEntityTypeId())
+}
+
+// CheckVersion verifies that the entity is at the version the command expects, if one was given. Updaters call this
+// from inside the transaction applying the update, so the check is atomic with the update, whether applied locally or
+// via raft
+func (self *UpdateEntityCommand[T]) CheckVersion(tx *bbolt.Tx) error {
+	if self.Version == "" {
+		return nil
+	}
+	checker, ok := self.Updater.(EntityVersionChecker)
+	if !ok {
+		return errors.Errorf("updates of %v don't support version checks", self.Updater.GetEntityTypeId())
+	}
+	return checker.CheckVersionInTx(tx, self.Entity.GetId(), self.Version)
+}
+
 func (self *UpdateEntityCommand[T]) Encode() ([]byte, error) {
 	entityType := self.Updater.GetEntityTypeId()
 	encodedEntity, err := self.Updater.Marshall(self.Entity)
@@ -106,6 +157,7 @@ func (self *UpdateEntityCommand[T]) Encode() ([]byte, error) {
 		EntityData:    encodedEntity,
 		UpdatedFields: updatedFields,
 		Flags:         self.Flags,
+		Version:       self.Version,
 	})
 }
 
@@ -118,6 +170,13 @@ func (self *DeleteEntityCommand) Apply() error {
 	return self.Deleter.ApplyDelete(self)
 }
 
+func (self *DeleteEntityCommand) ApplyInTx(ctx boltz.MutateContext) error {
+	if deleter, ok := self.Deleter.(EntityTxDeleter); ok {
+		return deleter.ApplyDeleteInTx(ctx, self)
+	}
+	return errors.Errorf("deletes of %v can't be applied in an existing transaction", self.Deleter.GetEntityTypeId())
+}
+
 func (self *DeleteEntityCommand) Encode() ([]byte, error) {
 	return cmd_pb.EncodeProtobuf(&cmd_pb.DeleteEntityCommand{
 		EntityId:   self.Id,
@@ -25,6 +25,7 @@ import (
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/network"
 	"github.com/openziti/fabric/controller/raft"
+	"github.com/openziti/fabric/controller/rbac"
 	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/fabric/pb/mgmt_pb"
 	"github.com/openziti/fabric/router/xgress"
@@ -76,6 +77,7 @@ type Config struct {
 			InitialDelay time.Duration
 		}
 	}
+	Rbac         *rbac.Config
 	SyncRaftToDb bool
 	src          map[interface{}]interface{}
 }
@@ -297,6 +299,20 @@ func LoadConfig(path string) (*Config, error) {
 		panic("controllerConfig must provide [ctrl]")
 	}
 
+	if value, found := cfgmap["rbac"]; found {
+		if submap, ok := value.(map[interface{}]interface{}); ok {
+			rbacConfig, err := rbac.LoadConfig(submap)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid [rbac] stanza")
+			}
+			controllerConfig.Rbac = rbacConfig
+		} else {
+			return nil, errors.New("invalid [rbac] stanza, should be map")
+		}
+	} else {
+		controllerConfig.Rbac = rbac.DefaultConfig()
+	}
+
 	controllerConfig.HealthChecks.BoltCheck.Interval = DefaultHealthChecksBoltCheckInterval
 	controllerConfig.HealthChecks.BoltCheck.Timeout = DefaultHealthChecksBoltCheckTimeout
 	controllerConfig.HealthChecks.BoltCheck.InitialDelay = DefaultHealthChecksBoltCheckInitialDelay
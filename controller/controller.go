@@ -34,6 +34,7 @@ import (
 	"github.com/openziti/fabric/controller/xt_random"
 	"github.com/openziti/fabric/controller/xt_smartrouting"
 	"github.com/openziti/fabric/controller/xt_weighted"
+	"github.com/openziti/fabric/controller/xt_wrr"
 	"github.com/openziti/fabric/event"
 	"github.com/openziti/fabric/events"
 	"github.com/openziti/fabric/health"
@@ -92,6 +93,10 @@ func (c *Controller) GetCommandDispatcher() command.Dispatcher {
 	return c.raftController
 }
 
+func (c *Controller) GetRaftController() *raft.Controller {
+	return c.raftController
+}
+
 func (c *Controller) IsRaftEnabled() bool {
 	return c.raftController != nil
 }
@@ -165,6 +170,10 @@ func NewController(cfg *Config, versionProvider versions.VersionProvider) (*Cont
 
 	c.eventDispatcher.InitializeNetworkEvents(c.network)
 
+	if c.raftController != nil {
+		c.network.AddRouterPresenceHandler(newLeadershipNotifier(c.network, c.raftController))
+	}
+
 	if cfg.Ctrl.Options.NewListener != nil {
 		c.network.AddRouterPresenceHandler(&OnConnectSettingsHandler{
 			config: cfg,
@@ -195,7 +204,7 @@ func (c *Controller) initWeb() {
 		logrus.WithError(err).Fatalf("failed to create health checks api factory")
 	}
 
-	if err := c.xweb.GetRegistry().Add(api_impl.NewManagementApiFactory(c.config.Id, c.network, c.xmgmts)); err != nil {
+	if err := c.xweb.GetRegistry().Add(api_impl.NewManagementApiFactory(c.config.Id, c.network, c.xmgmts, c.config.Rbac)); err != nil {
 		logrus.WithError(err).Fatalf("failed to create management api factory")
 	}
 
@@ -342,6 +351,7 @@ func (c *Controller) registerXts() {
 	xt.GlobalRegistry().RegisterFactory(xt_smartrouting.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_random.NewFactory())
 	xt.GlobalRegistry().RegisterFactory(xt_weighted.NewFactory())
+	xt.GlobalRegistry().RegisterFactory(xt_wrr.NewFactory())
 }
 
 func (c *Controller) registerComponents() error {
@@ -31,6 +31,7 @@ const (
 
 type Router struct {
 	boltz.BaseExtEntity
+	VersionedEntity
 	Name        string
 	Fingerprint *string
 	Cost        uint16
@@ -39,6 +40,7 @@ type Router struct {
 
 func (entity *Router) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 	entity.LoadBaseValues(bucket)
+	entity.loadVersion(bucket)
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.Fingerprint = bucket.GetString(FieldRouterFingerprint)
 	entity.Cost = uint16(bucket.GetInt32WithDefault(FieldRouterCost, 0))
@@ -47,6 +49,7 @@ func (entity *Router) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 
 func (entity *Router) SetValues(ctx *boltz.PersistContext) {
 	entity.SetBaseValues(ctx)
+	entity.incrementVersion(ctx)
 	ctx.SetString(FieldName, entity.Name)
 	ctx.SetStringP(FieldRouterFingerprint, entity.Fingerprint)
 	ctx.SetInt32(FieldRouterCost, int32(entity.Cost))
@@ -19,31 +19,76 @@ package db
 import (
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/controller/xt_smartrouting"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/ast"
 	"github.com/openziti/storage/boltz"
 	"go.etcd.io/bbolt"
 )
 
 const (
-	EntityTypeServices             = "services"
-	FieldServiceTerminatorStrategy = "terminatorStrategy"
+	EntityTypeServices               = "services"
+	FieldServiceTerminatorStrategy   = "terminatorStrategy"
+	FieldServiceMaxCircuits          = "maxCircuits"
+	FieldServiceMaxCircuitsPerClient = "maxCircuitsPerClient"
+	FieldServiceMaxDialRate          = "maxDialRate"
+	FieldServicePriority             = "priority"
+	FieldServiceMaxRouterBandwidth   = "maxRouterBandwidth"
+	FieldServiceMaxCircuitBandwidth  = "maxCircuitBandwidth"
+
+	ServicePriorityStandard    = "standard"
+	ServicePriorityInteractive = "interactive"
+	ServicePriorityBulk        = "bulk"
 )
 
+// IsValidServicePriority returns true if the given value is one of the supported service priority classes
+func IsValidServicePriority(priority string) bool {
+	return priority == ServicePriorityStandard || priority == ServicePriorityInteractive || priority == ServicePriorityBulk
+}
+
 type Service struct {
 	boltz.BaseExtEntity
-	Name               string
-	TerminatorStrategy string
+	VersionedEntity
+	Name                 string
+	TerminatorStrategy   string
+	MaxCircuits          uint32
+	MaxCircuitsPerClient uint32
+	MaxDialRate          uint32
+	Priority             string
+	MaxRouterBandwidth   uint64
+	MaxCircuitBandwidth  uint64
 }
 
 func (entity *Service) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 	entity.LoadBaseValues(bucket)
+	entity.loadVersion(bucket)
 	entity.Name = bucket.GetStringOrError(FieldName)
 	entity.TerminatorStrategy = bucket.GetStringWithDefault(FieldServiceTerminatorStrategy, "")
+	entity.MaxCircuits = uint32(bucket.GetInt32WithDefault(FieldServiceMaxCircuits, 0))
+	entity.MaxCircuitsPerClient = uint32(bucket.GetInt32WithDefault(FieldServiceMaxCircuitsPerClient, 0))
+	entity.MaxDialRate = uint32(bucket.GetInt32WithDefault(FieldServiceMaxDialRate, 0))
+	entity.Priority = bucket.GetStringWithDefault(FieldServicePriority, ServicePriorityStandard)
+	entity.MaxRouterBandwidth = uint64(bucket.GetInt64WithDefault(FieldServiceMaxRouterBandwidth, 0))
+	entity.MaxCircuitBandwidth = uint64(bucket.GetInt64WithDefault(FieldServiceMaxCircuitBandwidth, 0))
 }
 
 func (entity *Service) SetValues(ctx *boltz.PersistContext) {
 	entity.SetBaseValues(ctx)
+	entity.incrementVersion(ctx)
 	ctx.SetString(FieldName, entity.Name)
+	ctx.SetInt32(FieldServiceMaxCircuits, int32(entity.MaxCircuits))
+	ctx.SetInt32(FieldServiceMaxCircuitsPerClient, int32(entity.MaxCircuitsPerClient))
+	ctx.SetInt32(FieldServiceMaxDialRate, int32(entity.MaxDialRate))
+	ctx.SetInt64(FieldServiceMaxRouterBandwidth, int64(entity.MaxRouterBandwidth))
+	ctx.SetInt64(FieldServiceMaxCircuitBandwidth, int64(entity.MaxCircuitBandwidth))
+
+	if entity.Priority == "" {
+		entity.Priority = ServicePriorityStandard
+	}
+	if !IsValidServicePriority(entity.Priority) {
+		ctx.Bucket.SetError(errorz.NewFieldError("invalid service priority", FieldServicePriority, entity.Priority))
+		return
+	}
+	ctx.SetString(FieldServicePriority, entity.Priority)
 
 	if entity.TerminatorStrategy == "" {
 		entity.TerminatorStrategy = xt_smartrouting.Name
@@ -106,6 +151,7 @@ func (store *serviceStoreImpl) initializeLocal() {
 	store.indexName = store.AddUniqueIndex(symbolName)
 
 	store.AddSymbol(FieldServiceTerminatorStrategy, ast.NodeTypeString)
+	store.AddSymbol(FieldServicePriority, ast.NodeTypeString)
 	store.terminatorsSymbol = store.AddFkSetSymbol(EntityTypeTerminators, store.stores.terminator)
 }
 
@@ -36,12 +36,14 @@ const (
 	FieldTerminatorInstanceSecret = "instanceSecret"
 	FieldTerminatorCost           = "cost"
 	FieldTerminatorPrecedence     = "precedence"
+	FieldTerminatorWeight         = "weight"
 	FieldServerPeerData           = "peerData"
 	FieldTerminatorHostId         = "hostId"
 )
 
 type Terminator struct {
 	boltz.BaseExtEntity
+	VersionedEntity
 	Service        string
 	Router         string
 	Binding        string
@@ -52,12 +54,17 @@ type Terminator struct {
 	Precedence     string
 	PeerData       xt.PeerData
 	HostId         string
+	Weight         uint16
 }
 
 func (entity *Terminator) GetCost() uint16 {
 	return entity.Cost
 }
 
+func (entity *Terminator) GetWeight() uint16 {
+	return entity.Weight
+}
+
 func (entity *Terminator) GetPrecedence() xt.Precedence {
 	return xt.GetPrecedenceForName(entity.Precedence)
 }
@@ -92,6 +99,7 @@ func (entity *Terminator) GetPeerData() xt.PeerData {
 
 func (entity *Terminator) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucket) {
 	entity.LoadBaseValues(bucket)
+	entity.loadVersion(bucket)
 	entity.Service = bucket.GetStringOrError(FieldTerminatorService)
 	entity.Router = bucket.GetStringOrError(FieldTerminatorRouter)
 	entity.Binding = bucket.GetStringOrError(FieldTerminatorBinding)
@@ -101,6 +109,7 @@ func (entity *Terminator) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucke
 	entity.Cost = uint16(bucket.GetInt32WithDefault(FieldTerminatorCost, 0))
 	entity.Precedence = bucket.GetStringWithDefault(FieldTerminatorPrecedence, xt.Precedences.Default.String())
 	entity.HostId = bucket.GetStringWithDefault(FieldTerminatorHostId, "")
+	entity.Weight = uint16(bucket.GetInt32WithDefault(FieldTerminatorWeight, xt.DefaultWeight))
 	data := bucket.GetBucket(FieldServerPeerData)
 	if data != nil {
 		entity.PeerData = make(map[uint32][]byte)
@@ -113,11 +122,17 @@ func (entity *Terminator) LoadValues(_ boltz.CrudStore, bucket *boltz.TypedBucke
 
 func (entity *Terminator) SetValues(ctx *boltz.PersistContext) {
 	entity.SetBaseValues(ctx)
+	entity.incrementVersion(ctx)
 
 	if entity.Precedence == "" {
 		entity.Precedence = xt.Precedences.Default.String()
 	}
 
+	// a weight of 0 means none was given. Updates to a weight of 0 are rejected before they get here
+	if entity.Weight == 0 {
+		entity.Weight = xt.DefaultWeight
+	}
+
 	terminatorStore := ctx.Store.(*terminatorStoreImpl)
 
 	if ctx.Bucket.HasError() {
@@ -138,6 +153,7 @@ func (entity *Terminator) SetValues(ctx *boltz.PersistContext) {
 	ctx.SetInt32(FieldTerminatorCost, int32(entity.Cost))
 	ctx.SetRequiredString(FieldTerminatorPrecedence, entity.Precedence)
 	ctx.SetString(FieldTerminatorHostId, entity.HostId)
+	ctx.SetInt32(FieldTerminatorWeight, int32(entity.Weight))
 
 	if ctx.ProceedWithSet(FieldServerPeerData) {
 		_ = ctx.Bucket.DeleteBucket([]byte(FieldServerPeerData))
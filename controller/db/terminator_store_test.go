@@ -122,6 +122,7 @@ func (ctx *TestContext) createTestTerminators() *terminatorTestEntities {
 	e.terminator2.Binding = uuid.New().String()
 	e.terminator2.Address = uuid.New().String()
 	e.terminator2.Cost = 100
+	e.terminator2.Weight = 80
 	ctx.RequireCreate(e.terminator2)
 
 	e.service2 = ctx.requireNewService()
@@ -183,6 +184,12 @@ func (ctx *TestContext) testLoadQueryTerminators(t *testing.T) {
 		ctx.EqualValues(e.terminator.Binding, loadedTerminator.Binding)
 		ctx.EqualValues(e.terminator.Address, loadedTerminator.Address)
 		ctx.EqualValues(e.terminator.Cost, loadedTerminator.Cost)
+		ctx.EqualValues(xt.DefaultWeight, loadedTerminator.Weight)
+
+		loadedTerminator, err = ctx.stores.Terminator.LoadOneById(tx, e.terminator2.Id)
+		ctx.NoError(err)
+		ctx.NotNil(loadedTerminator)
+		ctx.EqualValues(80, loadedTerminator.Weight)
 
 		ids, _, err := ctx.stores.Terminator.QueryIdsf(tx, `service = "%v"`, e.service.Id)
 		ctx.NoError(err)
@@ -0,0 +1,59 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package db
+
+import (
+	"strconv"
+
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+// FieldVersion holds a counter which is incremented every time an entity is updated. Unlike updatedAt, which comes
+// from the local clock, the version is the same on every controller in a cluster, so it can be used for optimistic
+// concurrency checks which are applied via raft
+const FieldVersion = "version"
+
+// VersionedEntity can be embedded in entities whose stores should track a version
+type VersionedEntity struct {
+	Version uint64
+}
+
+func (entity *VersionedEntity) GetVersion() uint64 {
+	return entity.Version
+}
+
+func (entity *VersionedEntity) loadVersion(bucket *boltz.TypedBucket) {
+	entity.Version = uint64(bucket.GetInt64WithDefault(FieldVersion, 0))
+}
+
+// incrementVersion bumps the stored version. The field checker is bypassed, since the version must change on every
+// update, including patches which don't mention it
+func (entity *VersionedEntity) incrementVersion(ctx *boltz.PersistContext) {
+	version := ctx.Bucket.GetInt64WithDefault(FieldVersion, 0) + 1
+	ctx.Bucket.SetInt64(FieldVersion, version, nil)
+	entity.Version = uint64(version)
+}
+
+// GetEntityVersion returns the current version of the entity with the given id, formatted as a string
+func GetEntityVersion(tx *bbolt.Tx, store boltz.CrudStore, id string) (string, error) {
+	bucket := store.GetEntityBucket(tx, []byte(id))
+	if bucket == nil {
+		return "", boltz.NewNotFoundError(store.GetSingularEntityType(), "id", id)
+	}
+	return strconv.FormatInt(bucket.GetInt64WithDefault(FieldVersion, 0), 10), nil
+}
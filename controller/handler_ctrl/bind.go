@@ -50,6 +50,7 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(newCircuitRequestHandler(self.router, self.network))
 	binding.AddTypedReceiveHandler(newRouteResultHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newCircuitConfirmationHandler(self.network, self.router))
+	binding.AddTypedReceiveHandler(newCircuitReconciliationHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newCreateTerminatorHandler(self.network, self.router))
 	binding.AddTypedReceiveHandler(newRemoveTerminatorHandler(self.network))
 	binding.AddTypedReceiveHandler(newUpdateTerminatorHandler(self.network))
@@ -0,0 +1,59 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/openziti/channel"
+	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+type circuitReconciliationHandler struct {
+	n *network.Network
+	r *network.Router
+}
+
+func newCircuitReconciliationHandler(n *network.Network, r *network.Router) *circuitReconciliationHandler {
+	return &circuitReconciliationHandler{n, r}
+}
+
+func (self *circuitReconciliationHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_CircuitReconciliationType)
+}
+
+func (self *circuitReconciliationHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	log := logrus.WithField("routerId", self.r.Id)
+	request := &ctrl_pb.CircuitReconciliation{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		log.WithError(err).Error("error unmarshalling circuit reconciliation")
+		return
+	}
+
+	log.WithField("circuitCount", len(request.Circuits)).WithField("full", request.Full).
+		Info("received circuit reconciliation request")
+
+	go func() {
+		result := self.n.ReconcileRouterCircuits(self.r, request.Circuits, request.Full)
+		if err := protobufs.MarshalTyped(result).ReplyTo(msg).WithTimeout(10 * time.Second).Send(ch); err != nil {
+			log.WithError(err).Error("error sending circuit reconciliation result")
+		}
+	}()
+}
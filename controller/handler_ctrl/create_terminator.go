@@ -21,6 +21,7 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/handler_common"
 	"github.com/openziti/fabric/pb/ctrl_pb"
 	"google.golang.org/protobuf/proto"
@@ -59,6 +60,14 @@ func (h *createTerminatorHandler) handleCreateTerminator(msg *channel.Message, c
 		return
 	}
 
+	// a weight of 0 is what an unset weight looks like on the wire, in which case the default weight is used
+	if request.Weight != 0 {
+		if err := xt.ValidateWeight(request.Weight); err != nil {
+			handler_common.SendFailure(msg, ch, err.Error())
+			return
+		}
+	}
+
 	terminator := &network.Terminator{
 		Service:        request.ServiceId,
 		Router:         h.router.Id,
@@ -69,6 +78,7 @@ func (h *createTerminatorHandler) handleCreateTerminator(msg *channel.Message, c
 		PeerData:       request.PeerData,
 		Precedence:     request.GetXtPrecedence(),
 		Cost:           uint16(request.Cost),
+		Weight:         uint16(request.Weight),
 	}
 
 	if err := h.network.Terminators.Create(terminator); err == nil {
@@ -59,7 +59,7 @@ func (h *updateTerminatorHandler) handleUpdateTerminator(msg *channel.Message, c
 		return
 	}
 
-	if !request.UpdateCost && !request.UpdatePrecedence {
+	if !request.UpdateCost && !request.UpdatePrecedence && !request.UpdateWeight {
 		// nothing to do
 		handler_common.SendSuccess(msg, ch, "")
 		return
@@ -69,7 +69,7 @@ func (h *updateTerminatorHandler) handleUpdateTerminator(msg *channel.Message, c
 
 	if request.UpdateCost {
 		if request.Cost > math.MaxUint16 {
-			handler_common.SendFailure(msg, ch, fmt.Sprintf("invalid static cost %v. Must be less than %v", request.Cost, math.MaxUint16))
+			handler_common.SendFailure(msg, ch, fmt.Sprintf("invalid cost %v. cost must be between 0 and %v inclusive", request.Cost, math.MaxUint16))
 			return
 		}
 		terminator.Cost = uint16(request.Cost)
@@ -92,6 +92,15 @@ func (h *updateTerminatorHandler) handleUpdateTerminator(msg *channel.Message, c
 		checker[db.FieldTerminatorPrecedence] = struct{}{}
 	}
 
+	if request.UpdateWeight {
+		if err := xt.ValidateWeight(request.Weight); err != nil {
+			handler_common.SendFailure(msg, ch, err.Error())
+			return
+		}
+		terminator.Weight = uint16(request.Weight)
+		checker[db.FieldTerminatorWeight] = struct{}{}
+	}
+
 	if err := h.network.Terminators.Update(terminator, checker); err != nil {
 		handler_common.SendFailure(msg, ch, err.Error())
 		return
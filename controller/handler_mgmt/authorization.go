@@ -0,0 +1,111 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/controller/rbac"
+	"github.com/openziti/fabric/event"
+	"github.com/openziti/fabric/handler_common"
+	"github.com/openziti/fabric/pb/mgmt_pb"
+	nfpem "github.com/openziti/foundation/v2/pem"
+)
+
+// contentTypeRoles holds the role required for each management channel request type. Request types not listed here,
+// such as those registered by xmgmt extensions, require the admin role
+var contentTypeRoles = map[int32]rbac.Role{
+	int32(mgmt_pb.ContentType_StreamMetricsRequestType):       rbac.RoleReadOnly,
+	int32(mgmt_pb.ContentType_StreamCircuitsRequestType):      rbac.RoleReadOnly,
+	int32(mgmt_pb.ContentType_StreamTracesRequestType):        rbac.RoleReadOnly,
+	int32(mgmt_pb.ContentType_StreamChangesRequestType):       rbac.RoleReadOnly,
+	int32(mgmt_pb.ContentType_RaftListMembersRequestType):     rbac.RoleReadOnly,
+	int32(mgmt_pb.ContentType_InspectRequestType):             rbac.RoleOperator,
+	int32(mgmt_pb.ContentType_TogglePipeTracesRequestType):    rbac.RoleOperator,
+	int32(mgmt_pb.ContentType_ToggleCircuitTracesRequestType): rbac.RoleOperator,
+}
+
+func getFingerprint(ch channel.Channel) string {
+	if certs := ch.Certificates(); len(certs) > 0 {
+		return nfpem.FingerprintFromCertificate(certs[0])
+	}
+	return ""
+}
+
+func GetRequiredRole(contentType int32) rbac.Role {
+	if role, found := contentTypeRoles[contentType]; found {
+		return role
+	}
+	return rbac.RoleAdmin
+}
+
+// authorizingBinding wraps receive handlers registered for content types the channel's role doesn't allow, so that
+// requests of those types are answered with a failure result instead of being processed. Requests which need more than
+// the read-only role, or which are rejected, are recorded as audit events
+type authorizingBinding struct {
+	channel.Binding
+	role        rbac.Role
+	fingerprint string
+	dispatcher  event.Dispatcher
+}
+
+func (self *authorizingBinding) Bind(h channel.BindHandler) error {
+	return h.BindChannel(self)
+}
+
+func (self *authorizingBinding) AddTypedReceiveHandler(h channel.TypedReceiveHandler) {
+	self.AddReceiveHandler(h.ContentType(), h)
+}
+
+func (self *authorizingBinding) AddReceiveHandlerF(contentType int32, h channel.ReceiveHandlerF) {
+	self.AddReceiveHandler(contentType, h)
+}
+
+func (self *authorizingBinding) AddReceiveHandler(contentType int32, h channel.ReceiveHandler) {
+	required := GetRequiredRole(contentType)
+	allowed := self.role.Allows(required)
+
+	self.Binding.AddReceiveHandlerF(contentType, func(msg *channel.Message, ch channel.Channel) {
+		if required != rbac.RoleReadOnly || !allowed {
+			self.audit(contentType, allowed)
+		}
+
+		if allowed {
+			h.HandleReceive(msg, ch)
+		} else {
+			handler_common.SendFailure(msg, ch, fmt.Sprintf("forbidden: role %v required, client has role %v", required, self.role))
+		}
+	})
+}
+
+func (self *authorizingBinding) audit(contentType int32, allowed bool) {
+	result := event.AuditResultAccepted
+	if !allowed {
+		result = event.AuditResultForbidden
+	}
+
+	self.dispatcher.AcceptAuditEvent(&event.AuditEvent{
+		Namespace:   event.AuditEventsNs,
+		EventType:   event.AuditMgmtRequest,
+		Timestamp:   time.Now(),
+		Fingerprint: self.fingerprint,
+		Operation:   mgmt_pb.ContentType(contentType).String(),
+		Result:      result,
+	})
+}
@@ -19,20 +19,29 @@ package handler_mgmt
 import (
 	"github.com/openziti/channel"
 	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/rbac"
 	"github.com/openziti/fabric/controller/xmgmt"
 	"github.com/openziti/fabric/trace"
 )
 
 type BindHandler struct {
-	network *network.Network
-	xmgmts  []xmgmt.Xmgmt
+	network    *network.Network
+	xmgmts     []xmgmt.Xmgmt
+	rbacConfig *rbac.Config
 }
 
-func NewBindHandler(network *network.Network, xmgmts []xmgmt.Xmgmt) channel.BindHandler {
-	return &BindHandler{network: network, xmgmts: xmgmts}
+func NewBindHandler(network *network.Network, xmgmts []xmgmt.Xmgmt, rbacConfig *rbac.Config) channel.BindHandler {
+	return &BindHandler{network: network, xmgmts: xmgmts, rbacConfig: rbacConfig}
 }
 
 func (bindHandler *BindHandler) BindChannel(binding channel.Binding) error {
+	binding = &authorizingBinding{
+		Binding:     binding,
+		role:        bindHandler.rbacConfig.GetRole(binding.GetChannel().Certificates()),
+		fingerprint: getFingerprint(binding.GetChannel()),
+		dispatcher:  bindHandler.network.GetEventDispatcher(),
+	}
+
 	binding.AddTypedReceiveHandler(newInspectHandler(bindHandler.network))
 
 	streamMetricHandler := newStreamMetricsHandler(bindHandler.network)
@@ -47,6 +56,10 @@ func (bindHandler *BindHandler) BindChannel(binding channel.Binding) error {
 	binding.AddTypedReceiveHandler(streamTracesHandler)
 	binding.AddCloseHandler(streamTracesHandler)
 
+	streamChangesHandler := newStreamChangesHandler(bindHandler.network)
+	binding.AddTypedReceiveHandler(streamChangesHandler)
+	binding.AddCloseHandler(streamChangesHandler)
+
 	binding.AddTypedReceiveHandler(newTogglePipeTracesHandler(bindHandler.network))
 
 	traceDispatchWrapper := trace.NewDispatchWrapper(bindHandler.network.GetEventDispatcher().Dispatch)
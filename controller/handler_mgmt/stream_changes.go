@@ -0,0 +1,191 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_mgmt
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/handler_common"
+	"github.com/openziti/fabric/pb/mgmt_pb"
+	"github.com/openziti/foundation/v2/concurrenz"
+	"google.golang.org/protobuf/proto"
+	"sync"
+)
+
+// changesStreamQueueSize is the number of live change events which may be waiting to be sent to a client. The queue
+// also has room for a full replay of the change feed buffer. A client which falls further behind has its channel
+// closed, and may reconnect and resume from the last revision it received
+const changesStreamQueueSize = 256
+
+type streamChangesHandler struct {
+	network        *network.Network
+	lock           sync.Mutex
+	streamHandlers map[*ChangesStreamHandler]struct{}
+}
+
+func newStreamChangesHandler(network *network.Network) *streamChangesHandler {
+	return &streamChangesHandler{
+		network:        network,
+		streamHandlers: map[*ChangesStreamHandler]struct{}{},
+	}
+}
+
+func (*streamChangesHandler) ContentType() int32 {
+	return int32(mgmt_pb.ContentType_StreamChangesRequestType)
+}
+
+func (handler *streamChangesHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	request := &mgmt_pb.StreamChangesRequest{}
+	if err := proto.Unmarshal(msg.Body, request); err != nil {
+		handler_common.SendFailure(msg, ch, err.Error())
+		return
+	}
+
+	feed := handler.network.GetChangeFeed()
+	streamHandler := &ChangesStreamHandler{
+		ch:          ch,
+		feedId:      feed.GetId(),
+		entityTypes: map[string]struct{}{},
+		queue:       make(chan *mgmt_pb.StreamChangesEvent, feed.GetBufferSize()+changesStreamQueueSize),
+		closeNotify: make(chan struct{}),
+		parent:      handler,
+	}
+	for _, entityType := range request.EntityTypes {
+		streamHandler.entityTypes[entityType] = struct{}{}
+	}
+
+	handler.lock.Lock()
+	handler.streamHandlers[streamHandler] = struct{}{}
+	handler.lock.Unlock()
+
+	// the replay is queued before the sender starts. If the stream is closed in the meantime, the sender exits
+	// immediately and unsubscribes it
+	feed.Subscribe(streamHandler, request.FeedId, request.SinceRevision)
+	go streamHandler.run()
+}
+
+func (handler *streamChangesHandler) HandleClose(channel.Channel) {
+	handler.lock.Lock()
+	var streamHandlers []*ChangesStreamHandler
+	for streamHandler := range handler.streamHandlers {
+		streamHandlers = append(streamHandlers, streamHandler)
+	}
+	handler.lock.Unlock()
+
+	for _, streamHandler := range streamHandlers {
+		streamHandler.close()
+	}
+}
+
+// remove is called once a stream's sender has exited. It can't be done from close, as a stream may be closed by
+// the change feed while the feed is locked
+func (handler *streamChangesHandler) remove(streamHandler *ChangesStreamHandler) {
+	handler.network.GetChangeFeed().Unsubscribe(streamHandler)
+
+	handler.lock.Lock()
+	defer handler.lock.Unlock()
+	delete(handler.streamHandlers, streamHandler)
+}
+
+// ChangesStreamHandler queues change feed entries for a single client. Changes are queued rather than sent directly,
+// since the change feed calls its listeners while locked
+type ChangesStreamHandler struct {
+	ch          channel.Channel
+	feedId      string
+	entityTypes map[string]struct{}
+	queue       chan *mgmt_pb.StreamChangesEvent
+	closeNotify chan struct{}
+	closed      concurrenz.AtomicBoolean
+	parent      *streamChangesHandler
+}
+
+func (handler *ChangesStreamHandler) ResyncRequired(feedId string, revision uint64) {
+	handler.queueEvent(&mgmt_pb.StreamChangesEvent{
+		EventType: mgmt_pb.StreamChangeEventType_ResyncRequired,
+		FeedId:    feedId,
+		Revision:  revision,
+	})
+}
+
+func (handler *ChangesStreamHandler) AcceptEntityChange(change *network.EntityChange) {
+	if len(handler.entityTypes) > 0 {
+		if _, found := handler.entityTypes[change.EntityType]; !found {
+			return
+		}
+	}
+
+	eventType := mgmt_pb.StreamChangeEventType_EntityCreated
+	if change.ChangeType == network.EntityUpdated {
+		eventType = mgmt_pb.StreamChangeEventType_EntityUpdated
+	} else if change.ChangeType == network.EntityDeleted {
+		eventType = mgmt_pb.StreamChangeEventType_EntityDeleted
+	}
+
+	handler.queueEvent(&mgmt_pb.StreamChangesEvent{
+		EventType:  eventType,
+		FeedId:     handler.feedId,
+		Revision:   change.Revision,
+		EntityType: change.EntityType,
+		EntityId:   change.EntityId,
+		Version:    change.Version,
+	})
+}
+
+func (handler *ChangesStreamHandler) queueEvent(event *mgmt_pb.StreamChangesEvent) {
+	select {
+	case handler.queue <- event:
+	case <-handler.closeNotify:
+	default:
+		pfxlog.Logger().Errorf("change stream queue full for mgmt channel %v, closing", handler.ch.Label())
+		handler.close()
+	}
+}
+
+func (handler *ChangesStreamHandler) run() {
+	defer handler.parent.remove(handler)
+
+	for {
+		select {
+		case event := <-handler.queue:
+			if err := handler.sendEvent(event); err != nil {
+				pfxlog.Logger().WithError(err).Error("unexpected error sending StreamChangesEvent")
+				handler.close()
+				return
+			}
+		case <-handler.closeNotify:
+			return
+		}
+	}
+}
+
+func (handler *ChangesStreamHandler) sendEvent(event *mgmt_pb.StreamChangesEvent) error {
+	body, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return handler.ch.Send(channel.NewMessage(int32(mgmt_pb.ContentType_StreamChangesEventType), body))
+}
+
+func (handler *ChangesStreamHandler) close() {
+	if handler.closed.CompareAndSwap(false, true) {
+		close(handler.closeNotify)
+		if err := handler.ch.Close(); err != nil {
+			pfxlog.Logger().WithError(err).Error("unexpected error closing mgmt channel")
+		}
+	}
+}
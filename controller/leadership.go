@@ -0,0 +1,67 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/controller/network"
+	"github.com/openziti/fabric/controller/raft"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"time"
+)
+
+// leadershipNotifier keeps routers informed of whether this controller is the raft cluster leader. Routers
+// connected to multiple controllers use this to decide where circuit and terminator requests should go.
+type leadershipNotifier struct {
+	network        *network.Network
+	raftController *raft.Controller
+}
+
+func newLeadershipNotifier(network *network.Network, raftController *raft.Controller) *leadershipNotifier {
+	result := &leadershipNotifier{
+		network:        network,
+		raftController: raftController,
+	}
+	raftController.AddLeadershipChangeHandler(result.leadershipChanged)
+	return result
+}
+
+func (self *leadershipNotifier) RouterConnected(r *network.Router) {
+	self.notifyRouter(r, self.raftController.IsLeader())
+}
+
+func (self *leadershipNotifier) RouterDisconnected(*network.Router) {
+	// nothing to do
+}
+
+func (self *leadershipNotifier) leadershipChanged(isLeader bool) {
+	for _, r := range self.network.AllConnectedRouters() {
+		self.notifyRouter(r, isLeader)
+	}
+}
+
+func (self *leadershipNotifier) notifyRouter(r *network.Router, isLeader bool) {
+	if r.Control == nil {
+		return
+	}
+	update := &ctrl_pb.CtrlLeaderUpdate{IsLeader: isLeader}
+	if err := protobufs.MarshalTyped(update).WithTimeout(5 * time.Second).Send(r.Control); err != nil {
+		pfxlog.Logger().WithError(err).WithField("routerId", r.Id).
+			WithField("isLeader", isLeader).Error("failed to send leadership update to router")
+	}
+}
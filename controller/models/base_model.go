@@ -24,6 +24,7 @@ import (
 	"github.com/openziti/storage/boltz"
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
+	"math"
 	"reflect"
 	"time"
 )
@@ -41,11 +42,13 @@ type EntityRetriever[T Entity] interface {
 
 	BaseList(query string) (*EntityListResult[T], error)
 	BasePreparedList(query ast.Query) (*EntityListResult[T], error)
+	BasePreparedListAfter(after string, query ast.Query) (*EntityListResult[T], error)
 
 	ListWithHandler(query string, handler ListResultHandler) error
 	PreparedListWithHandler(query ast.Query, handler ListResultHandler) error
 
 	PreparedListAssociatedWithHandler(id string, association string, query ast.Query, handler ListResultHandler) error
+	PreparedListAssociatedAfterWithHandler(id string, association string, after string, query ast.Query, handler ListResultHandler) error
 
 	GetStore() boltz.CrudStore
 
@@ -70,6 +73,12 @@ type BaseEntity struct {
 	UpdatedAt time.Time
 	Tags      map[string]interface{}
 	IsSystem  bool
+	Version   uint64
+}
+
+// VersionedEntity is implemented by entities which track a version that is bumped on every update
+type VersionedEntity interface {
+	GetVersion() uint64
 }
 
 func (entity *BaseEntity) GetId() string {
@@ -96,12 +105,19 @@ func (entity *BaseEntity) IsSystemEntity() bool {
 	return entity.IsSystem
 }
 
+func (entity *BaseEntity) GetVersion() uint64 {
+	return entity.Version
+}
+
 func (entity *BaseEntity) FillCommon(boltEntity boltz.ExtEntity) {
 	entity.Id = boltEntity.GetId()
 	entity.CreatedAt = boltEntity.GetCreatedAt()
 	entity.UpdatedAt = boltEntity.GetUpdatedAt()
 	entity.Tags = boltEntity.GetTags()
 	entity.IsSystem = boltEntity.IsSystemEntity()
+	if versioned, ok := boltEntity.(VersionedEntity); ok {
+		entity.Version = versioned.GetVersion()
+	}
 }
 
 type EntityListResult[T Entity] struct {
@@ -186,6 +202,121 @@ func (ctrl *BaseEntityManager) PreparedListWithTx(tx *bbolt.Tx, query ast.Query,
 	return resultHandler(tx, keys, qmd)
 }
 
+// PreparedListAfterWithTx lists the entities matching the query whose ids sort after the given id, in id order. Unlike
+// PreparedListWithTx it seeks straight to the given id and stops once the page is full, so the total number of matching
+// entities isn't known. The count is set to the number of entities returned, plus one if there are more to read
+func (ctrl *BaseEntityManager) PreparedListAfterWithTx(tx *bbolt.Tx, after string, query ast.Query, resultHandler ListResultHandler) error {
+	ctrl.checkLimits(query)
+
+	limit := getPageLimit(query)
+	cursor := ctrl.Store.IterateValidIds(tx, query.GetPredicate())
+	cursor.Seek([]byte(after))
+
+	var keys []string
+	var count int64
+	for cursor.IsValid() && count <= limit {
+		if id := string(cursor.Current()); id != after {
+			if count < limit {
+				keys = append(keys, id)
+			}
+			count++
+		}
+		cursor.Next()
+	}
+
+	qmd := &QueryMetaData{
+		Count:            count,
+		Limit:            *query.GetLimit(),
+		Offset:           0,
+		FilterableFields: ctrl.Store.GetPublicSymbols(),
+	}
+	return resultHandler(tx, keys, qmd)
+}
+
+// PreparedListAssociatedAfterWithTx is the association equivalent of PreparedListAfterWithTx. The related ids following
+// the given id are filtered by the query in batches, until the page is full
+func (ctrl *BaseEntityManager) PreparedListAssociatedAfterWithTx(tx *bbolt.Tx, id, association, after string, query ast.Query, resultHandler ListResultHandler) error {
+	ctrl.checkLimits(query)
+
+	symbol := ctrl.GetStore().GetSymbol(association)
+	if symbol == nil {
+		return errors.Errorf("invalid association: '%v'", association)
+	}
+
+	linkedType := symbol.GetLinkedType()
+	if linkedType == nil {
+		return errors.Errorf("invalid association: '%v'", association)
+	}
+
+	related := symbol.GetStore().GetRelatedEntitiesCursor(tx, id, association, true)
+	if seekable, ok := related.(ast.SeekableSetCursor); ok {
+		seekable.Seek([]byte(after))
+	}
+	for related.IsValid() && string(related.Current()) <= after {
+		related.Next()
+	}
+
+	limit := getPageLimit(query)
+	batchSize := limit + 1
+	if batchSize > ListLimitMax {
+		batchSize = ListLimitMax
+	}
+
+	var keys []string
+	more := false
+	for related.IsValid() && !more {
+		batch := &windowCursor{SetCursor: related, remaining: batchSize}
+		matched, count, err := linkedType.QueryWithCursorC(tx, func(*bbolt.Tx, bool) ast.SetCursor { return batch }, query)
+		if err != nil {
+			return err
+		}
+
+		available := limit - int64(len(keys))
+		if int64(len(matched)) > available {
+			matched = matched[:available]
+		}
+		keys = append(keys, matched...)
+		more = count > available
+	}
+
+	count := int64(len(keys))
+	if more {
+		count++
+	}
+
+	qmd := &QueryMetaData{
+		Count:            count,
+		Limit:            *query.GetLimit(),
+		Offset:           0,
+		FilterableFields: linkedType.GetPublicSymbols(),
+	}
+	return resultHandler(tx, keys, qmd)
+}
+
+// getPageLimit returns the number of entities to put in a page, treating a negative limit as unlimited
+func getPageLimit(query ast.Query) int64 {
+	if limit := *query.GetLimit(); limit >= 0 {
+		return limit
+	}
+	return math.MaxInt64 - 1
+}
+
+// windowCursor passes through at most the given number of entries from the wrapped cursor, leaving the wrapped cursor
+// positioned at the next entry, so a scan can be resumed from where the previous one stopped
+type windowCursor struct {
+	ast.SetCursor
+	remaining int64
+}
+
+func (self *windowCursor) IsValid() bool {
+	return self.remaining > 0 && self.SetCursor.IsValid()
+}
+
+func (self *windowCursor) Next() {
+	self.SetCursor.Next()
+	self.remaining--
+}
+
 func (ctrl *BaseEntityManager) PreparedListAssociatedWithTx(tx *bbolt.Tx, id, association string, query ast.Query, resultHandler ListResultHandler) error {
 	ctrl.checkLimits(query)
 
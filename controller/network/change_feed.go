@@ -0,0 +1,174 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/idgen"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/storage/boltz"
+)
+
+type EntityChangeType string
+
+const (
+	EntityCreated EntityChangeType = "created"
+	EntityUpdated EntityChangeType = "updated"
+	EntityDeleted EntityChangeType = "deleted"
+)
+
+// EntityChange describes a single create, update or delete of an entity in one of the fabric stores. Revisions increase
+// by one with every change and are assigned in the order the store listeners are notified. Store listeners are
+// notified asynchronously after commit, so changes from concurrent transactions may be numbered out of commit order.
+// Changes carry only the entity id and version, so clients should read the entity to get its current state
+type EntityChange struct {
+	Revision   uint64
+	ChangeType EntityChangeType
+	EntityType string
+	EntityId   string
+	Version    uint64
+}
+
+type EntityChangeListener interface {
+	AcceptEntityChange(change *EntityChange)
+	ResyncRequired(feedId string, revision uint64)
+}
+
+// ChangeFeed tracks changes to all fabric stores and keeps the most recent ones, so that listeners can resume from a
+// known revision. Revisions are held in memory and restart at zero when the controller restarts. The feed id changes
+// with every restart, so clients can tell when a revision they hold is no longer meaningful
+type ChangeFeed struct {
+	id        string
+	lock      sync.Mutex
+	revision  uint64
+	buffer    []*EntityChange
+	next      int
+	listeners map[EntityChangeListener]struct{}
+}
+
+func newChangeFeed(stores *db.Stores, bufferSize int) *ChangeFeed {
+	result := &ChangeFeed{
+		id:        idgen.New(),
+		buffer:    make([]*EntityChange, 0, bufferSize),
+		listeners: map[EntityChangeListener]struct{}{},
+	}
+
+	for _, store := range stores.GetStoreList() {
+		store.AddListener(boltz.EventCreate, result.newListener(store, EntityCreated))
+		store.AddListener(boltz.EventUpdate, result.newListener(store, EntityUpdated))
+		store.AddListener(boltz.EventDelete, result.newListener(store, EntityDeleted))
+	}
+
+	return result
+}
+
+func (self *ChangeFeed) newListener(store boltz.CrudStore, changeType EntityChangeType) func(...interface{}) {
+	return func(i ...interface{}) {
+		for _, val := range i {
+			if entity, ok := val.(boltz.Entity); ok {
+				self.entityChanged(changeType, store.GetEntityType(), entity)
+			} else {
+				pfxlog.Logger().Errorf("error in %v change feed listener. expected boltz.Entity, got %T", store.GetEntityType(), val)
+			}
+		}
+	}
+}
+
+func (self *ChangeFeed) entityChanged(changeType EntityChangeType, entityType string, entity boltz.Entity) {
+	change := &EntityChange{
+		ChangeType: changeType,
+		EntityType: entityType,
+		EntityId:   entity.GetId(),
+	}
+
+	if versioned, ok := entity.(models.VersionedEntity); ok {
+		change.Version = versioned.GetVersion()
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.revision++
+	change.Revision = self.revision
+
+	if len(self.buffer) < cap(self.buffer) {
+		self.buffer = append(self.buffer, change)
+	} else if len(self.buffer) > 0 {
+		self.buffer[self.next] = change
+		self.next = (self.next + 1) % len(self.buffer)
+	}
+
+	for listener := range self.listeners {
+		listener.AcceptEntityChange(change)
+	}
+}
+
+func (self *ChangeFeed) GetId() string {
+	return self.id
+}
+
+// GetBufferSize returns the maximum number of changes which may be replayed to a new subscriber
+func (self *ChangeFeed) GetBufferSize() int {
+	return cap(self.buffer)
+}
+
+func (self *ChangeFeed) GetRevision() uint64 {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	return self.revision
+}
+
+// Subscribe registers the listener for all changes after the given revision. If the feed id matches and those changes
+// are still buffered, they are replayed to the listener and true is returned. Otherwise the listener's ResyncRequired
+// is called with the current feed id and revision, and false is returned. Listeners are called while the feed is
+// locked, so they shouldn't block
+func (self *ChangeFeed) Subscribe(listener EntityChangeListener, feedId string, sinceRevision uint64) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.listeners[listener] = struct{}{}
+
+	if !self.canReplay(feedId, sinceRevision) {
+		listener.ResyncRequired(self.id, self.revision)
+		return false
+	}
+
+	for i := 0; i < len(self.buffer); i++ {
+		change := self.buffer[(self.next+i)%len(self.buffer)]
+		if change.Revision > sinceRevision {
+			listener.AcceptEntityChange(change)
+		}
+	}
+
+	return true
+}
+
+func (self *ChangeFeed) canReplay(feedId string, sinceRevision uint64) bool {
+	if feedId != self.id || sinceRevision > self.revision {
+		return false
+	}
+	return sinceRevision >= self.revision-uint64(len(self.buffer))
+}
+
+func (self *ChangeFeed) Unsubscribe(listener EntityChangeListener) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.listeners, listener)
+}
@@ -0,0 +1,126 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+type testChangeListener struct {
+	sync.Mutex
+	changes        []*EntityChange
+	resyncRevision *uint64
+}
+
+func (self *testChangeListener) AcceptEntityChange(change *EntityChange) {
+	self.Lock()
+	defer self.Unlock()
+	self.changes = append(self.changes, change)
+}
+
+func (self *testChangeListener) ResyncRequired(_ string, revision uint64) {
+	self.Lock()
+	defer self.Unlock()
+	self.resyncRevision = &revision
+}
+
+func (self *testChangeListener) getChanges() []*EntityChange {
+	self.Lock()
+	defer self.Unlock()
+	return append([]*EntityChange(nil), self.changes...)
+}
+
+func TestChangeFeed(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	config.options.ChangeFeedBufferSize = 4
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	feed := n.GetChangeFeed()
+	live := &testChangeListener{}
+	req.False(feed.Subscribe(live, "", 0))
+	req.NotNil(live.resyncRevision)
+	req.Equal(uint64(0), *live.resyncRevision)
+
+	for _, id := range []string{"svc1", "svc2", "svc3"} {
+		req.NoError(n.Services.Create(&Service{
+			BaseEntity:         models.BaseEntity{Id: id},
+			Name:               id,
+			TerminatorStrategy: "smartrouting",
+		}))
+	}
+	req.NoError(n.Services.Delete("svc1"))
+
+	req.Eventually(func() bool {
+		return len(live.getChanges()) == 4
+	}, time.Second, 10*time.Millisecond)
+
+	// changes from separate transactions are notified asynchronously, so don't depend on their relative order
+	changes := live.getChanges()
+	seen := map[string]struct{}{}
+	for idx, change := range changes {
+		req.Equal(uint64(idx+1), change.Revision)
+		req.Equal(db.EntityTypeServices, change.EntityType)
+		seen[change.EntityId+"/"+string(change.ChangeType)] = struct{}{}
+	}
+	req.Contains(seen, "svc1/created")
+	req.Contains(seen, "svc2/created")
+	req.Contains(seen, "svc3/created")
+	req.Contains(seen, "svc1/deleted")
+
+	// changes after revision 2 are still buffered, so they should be replayed
+	resumed := &testChangeListener{}
+	req.True(feed.Subscribe(resumed, feed.GetId(), 2))
+	req.Nil(resumed.resyncRevision)
+	req.Equal(changes[2:], resumed.getChanges())
+
+	req.NoError(n.Services.Delete("svc2"))
+	req.Eventually(func() bool {
+		return len(live.getChanges()) == 5 && len(resumed.getChanges()) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// revision 0 has been pushed out of the buffer
+	stale := &testChangeListener{}
+	req.False(feed.Subscribe(stale, feed.GetId(), 0))
+	req.Equal(uint64(5), *stale.resyncRevision)
+	req.Empty(stale.getChanges())
+
+	// revisions from a different feed aren't meaningful
+	other := &testChangeListener{}
+	req.False(feed.Subscribe(other, "other", 4))
+	req.NotNil(other.resyncRevision)
+
+	feed.Unsubscribe(live)
+	req.NoError(n.Services.Delete("svc3"))
+	req.Eventually(func() bool {
+		return len(resumed.getChanges()) == 4
+	}, time.Second, 10*time.Millisecond)
+	req.Len(live.getChanges(), 5)
+}
@@ -58,6 +58,39 @@ func (self *Circuit) HasRouter(routerId string) bool {
 	return false
 }
 
+// CircuitFilter selects circuits by service, client, router on path, terminator and age. A circuit matches if it meets
+// all the criteria which are set
+type CircuitFilter struct {
+	ServiceId    string
+	ClientId     string
+	RouterId     string
+	TerminatorId string
+	MinAge       time.Duration
+}
+
+func (self *CircuitFilter) IsEmpty() bool {
+	return self.ServiceId == "" && self.ClientId == "" && self.RouterId == "" && self.TerminatorId == "" && self.MinAge == 0
+}
+
+func (self *CircuitFilter) Matches(circuit *Circuit, now time.Time) bool {
+	if self.ServiceId != "" && (circuit.Service == nil || circuit.Service.Id != self.ServiceId) {
+		return false
+	}
+	if self.ClientId != "" && circuit.ClientId != self.ClientId {
+		return false
+	}
+	if self.RouterId != "" && !circuit.HasRouter(self.RouterId) {
+		return false
+	}
+	if self.TerminatorId != "" && (circuit.Terminator == nil || circuit.Terminator.GetId() != self.TerminatorId) {
+		return false
+	}
+	if self.MinAge > 0 && now.Sub(circuit.CreatedAt) < self.MinAge {
+		return false
+	}
+	return true
+}
+
 type circuitController struct {
 	circuits    cmap.ConcurrentMap[*Circuit]
 	idGenerator idgen.Generator
@@ -61,6 +61,12 @@ func (network *Network) CircuitEvent(eventType event.CircuitEventType, circuit *
 	}
 	network.fillCircuitPath(circuitEvent, circuit.Path)
 	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
+
+	if eventType == event.CircuitCreated {
+		network.serviceStats.circuitCreated(circuit.Id, circuit.Service.Id)
+	} else if eventType == event.CircuitDeleted {
+		network.serviceStats.circuitRemoved(circuit.Id)
+	}
 }
 
 type CircuitFailureCause string
@@ -74,6 +80,7 @@ const (
 	CircuitFailurePathMissingLink                  CircuitFailureCause = "PATH_MISSING_LINK"
 	CircuitFailureInvalidStrategy                  CircuitFailureCause = "INVALID_STRATEGY"
 	CircuitFailureStrategyError                    CircuitFailureCause = "STRATEGY_ERR"
+	CircuitFailureServiceLimitExceeded             CircuitFailureCause = "SERVICE_LIMIT_EXCEEDED"
 	CircuitFailureRouterErrGeneric                 CircuitFailureCause = "ROUTER_ERR_GENERIC"
 	CircuitFailureRouterErrInvalidTerminator       CircuitFailureCause = "ROUTER_ERR_INVALID_TERMINATOR"
 	CircuitFailureRouterErrMisconfiguredTerminator CircuitFailureCause = "ROUTER_ERR_MISCONFIGURED_TERMINATOR"
@@ -147,4 +154,5 @@ func (network *Network) CircuitFailedEvent(circuitId string, clientId string, se
 	}
 	network.fillCircuitPath(circuitEvent, path)
 	network.eventDispatcher.AcceptCircuitEvent(circuitEvent)
+	network.serviceStats.circuitFailed(serviceId, cause)
 }
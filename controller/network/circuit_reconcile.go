@@ -0,0 +1,78 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"time"
+)
+
+// ReconcileRouterCircuits compares a router's circuit digest with the controller's circuits. Circuits the
+// controller doesn't know about, or which don't pass through the router, are returned to be unrouted. Circuits
+// the controller owns are returned to be adopted. If the router is forwarding a circuit somewhere the path
+// doesn't go, the circuit is re-routed.
+//
+// If full is set, the digest is expected to contain every circuit the router has for this controller, so any
+// established circuits which pass through the router but are missing from the digest are re-routed as well.
+func (network *Network) ReconcileRouterCircuits(r *Router, circuits []*ctrl_pb.CircuitDigest, full bool) *ctrl_pb.CircuitReconciliationResult {
+	log := pfxlog.Logger().WithField("routerId", r.Id)
+	result := &ctrl_pb.CircuitReconciliationResult{}
+	var reroute []string
+
+	reported := map[string]struct{}{}
+	for _, digest := range circuits {
+		reported[digest.CircuitId] = struct{}{}
+		circuit, found := network.GetCircuit(digest.CircuitId)
+		if !found || !circuit.HasRouter(r.Id) {
+			result.Unroute = append(result.Unroute, digest.CircuitId)
+			continue
+		}
+		result.Adopt = append(result.Adopt, digest.CircuitId)
+		if !circuit.Path.hasAddresses(digest.Addresses) && !circuit.Rerouting.Get() {
+			log.WithField("circuitId", circuit.Id).Info("router forwarding state diverges from circuit path")
+			reroute = append(reroute, circuit.Id)
+		}
+	}
+
+	if full {
+		// circuits which are still being established may not have been routed yet
+		cutoff := time.Now().Add(-network.options.RouteTimeout)
+		for _, circuit := range network.GetAllCircuits() {
+			if _, found := reported[circuit.Id]; found {
+				continue
+			}
+			if circuit.HasRouter(r.Id) && circuit.CreatedAt.Before(cutoff) && !circuit.Rerouting.Get() {
+				log.WithField("circuitId", circuit.Id).Info("router missing forwarding state for circuit")
+				reroute = append(reroute, circuit.Id)
+			}
+		}
+	}
+
+	if len(reroute) > 0 {
+		network.ReportForwardingFaults(&ForwardingFaultReport{R: r, CircuitIds: reroute})
+	}
+
+	log.WithField("circuitCount", len(circuits)).
+		WithField("full", full).
+		WithField("unrouteCount", len(result.Unroute)).
+		WithField("adoptCount", len(result.Adopt)).
+		WithField("rerouteCount", len(reroute)).
+		Info("reconciled router circuits")
+
+	return result
+}
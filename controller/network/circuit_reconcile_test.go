@@ -0,0 +1,97 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileRouterCircuits(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", transportAddr, nil, 0, false)
+	r1 := newRouterForTest("r1", "", transportAddr, nil, 0, false)
+	r2 := newRouterForTest("r2", "", transportAddr, nil, 0, false)
+
+	l0 := newTestLink("l0", "tls")
+	l0.Src = r0
+	l0.Dst = r1
+
+	old := time.Now().Add(-time.Hour)
+	n.circuitController.add(&Circuit{
+		Id:        "c0",
+		Path:      &Path{Nodes: []*Router{r0, r1}, Links: []*Link{l0}, IngressId: "i0", EgressId: "e0"},
+		CreatedAt: old,
+	})
+	n.circuitController.add(&Circuit{
+		Id:        "c1",
+		Path:      &Path{Nodes: []*Router{r1, r2}, IngressId: "i1", EgressId: "e1"},
+		CreatedAt: old,
+	})
+	n.circuitController.add(&Circuit{
+		Id:        "c2",
+		Path:      &Path{Nodes: []*Router{r0, r1}, Links: []*Link{l0}, IngressId: "i2", EgressId: "e2"},
+		CreatedAt: old,
+	})
+	n.circuitController.add(&Circuit{
+		Id:        "c3",
+		Path:      &Path{Nodes: []*Router{r0, r1}, Links: []*Link{l0}, IngressId: "i3", EgressId: "e3"},
+		CreatedAt: time.Now(),
+	})
+
+	digests := []*ctrl_pb.CircuitDigest{
+		{CircuitId: "c0", Addresses: []string{"i0", "l0"}},
+		{CircuitId: "c1", Addresses: []string{"e1"}},
+		{CircuitId: "c4", Addresses: []string{"l9"}},
+	}
+
+	result := n.ReconcileRouterCircuits(r0, digests, false)
+	req.Equal([]string{"c1", "c4"}, result.Unroute)
+	req.Equal([]string{"c0"}, result.Adopt)
+	req.Equal(0, len(n.forwardingFaults))
+
+	// diverged paths are re-routed
+	digests[0].Addresses = []string{"i0", "l7"}
+	result = n.ReconcileRouterCircuits(r0, digests, false)
+	req.Equal([]string{"c0"}, result.Adopt)
+	ffr := <-n.forwardingFaults
+	req.Equal([]string{"c0"}, ffr.CircuitIds)
+
+	// on a full reconciliation, established circuits the router doesn't have are re-routed
+	result = n.ReconcileRouterCircuits(r0, digests[:1], true)
+	req.Equal([]string{"c0"}, result.Adopt)
+	ffr = <-n.forwardingFaults
+	req.ElementsMatch([]string{"c0", "c2"}, ffr.CircuitIds)
+}
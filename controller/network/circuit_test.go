@@ -0,0 +1,84 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitFilter(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	now := time.Now()
+	r0 := NewRouter("r0", "r0", "", 0, false)
+	r1 := NewRouter("r1", "r1", "", 0, false)
+	svc1 := &Service{BaseEntity: models.BaseEntity{Id: "svc1"}}
+	svc2 := &Service{BaseEntity: models.BaseEntity{Id: "svc2"}}
+
+	newCircuit := func(id string, service *Service, clientId string, age time.Duration, routers ...*Router) *Circuit {
+		circuit := &Circuit{
+			Id:         id,
+			ClientId:   clientId,
+			Service:    service,
+			Terminator: &RoutingTerminator{Terminator: &Terminator{BaseEntity: models.BaseEntity{Id: "t-" + service.Id}}},
+			Path:       &Path{Nodes: routers},
+			CreatedAt:  now.Add(-age),
+		}
+		n.circuitController.add(circuit)
+		return circuit
+	}
+
+	newCircuit("c1", svc1, "client1", time.Minute, r0)
+	newCircuit("c2", svc1, "client2", time.Hour, r0, r1)
+	newCircuit("c3", svc2, "client1", time.Hour, r1)
+
+	req.True((&CircuitFilter{}).IsEmpty())
+	req.Equal([]string{"c1", "c2"}, n.RemoveCircuits(&CircuitFilter{ServiceId: "svc1"}, false, true))
+	req.Equal([]string{"c1", "c3"}, n.RemoveCircuits(&CircuitFilter{ClientId: "client1"}, false, true))
+	req.Equal([]string{"c2", "c3"}, n.RemoveCircuits(&CircuitFilter{RouterId: "r1"}, false, true))
+	req.Equal([]string{"c3"}, n.RemoveCircuits(&CircuitFilter{TerminatorId: "t-svc2"}, false, true))
+	req.Equal([]string{"c2", "c3"}, n.RemoveCircuits(&CircuitFilter{MinAge: 10 * time.Minute}, false, true))
+	req.Equal([]string{"c2"}, n.RemoveCircuits(&CircuitFilter{ServiceId: "svc1", MinAge: 10 * time.Minute}, false, true))
+	req.Empty(n.RemoveCircuits(&CircuitFilter{ServiceId: "svc3"}, false, true))
+
+	// a dry run must leave the circuits in place
+	req.Len(n.GetAllCircuits(), 3)
+
+	// circuits without a path don't need unroutes sent, so they can be removed without connected routers
+	newCircuit("c4", svc2, "client3", time.Minute)
+	newCircuit("c5", svc2, "client3", time.Hour)
+
+	req.Equal([]string{"c4", "c5"}, n.RemoveCircuits(&CircuitFilter{ClientId: "client3"}, true, false))
+	req.Len(n.GetAllCircuits(), 3)
+	_, found := n.GetCircuit("c4")
+	req.False(found)
+	req.Empty(n.RemoveCircuits(&CircuitFilter{ClientId: "client3"}, true, false))
+}
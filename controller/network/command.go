@@ -42,6 +42,7 @@ func (self *CommandManager) registerGenericCommands() {
 	self.Decoders.RegisterF(int32(cmd_pb.CommandType_UpdateEntityType), self.decodeUpdateEntityCommand)
 	self.Decoders.RegisterF(int32(cmd_pb.CommandType_DeleteEntityType), self.decodeDeleteEntityCommand)
 	self.Decoders.RegisterF(int32(cmd_pb.CommandType_SyncSnapshot), self.decodeSyncSnapshotCommand)
+	self.Decoders.RegisterF(int32(cmd_pb.CommandType_Batch), self.decodeBatchCommand)
 }
 
 func (self *CommandManager) decodeCreateEntityCommand(_ int32, data []byte) (command.Command, error) {
@@ -101,6 +102,27 @@ func (self *CommandManager) decodeSyncSnapshotCommand(_ int32, data []byte) (com
 	return cmd, nil
 }
 
+func (self *CommandManager) decodeBatchCommand(_ int32, data []byte) (command.Command, error) {
+	msg := &cmd_pb.BatchCommand{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, err
+	}
+
+	cmd := &command.BatchCommand{
+		Db: self.db,
+	}
+
+	for _, encoded := range msg.Commands {
+		child, err := self.Decoders.Decode(encoded)
+		if err != nil {
+			return nil, err
+		}
+		cmd.Commands = append(cmd.Commands, child)
+	}
+
+	return cmd, nil
+}
+
 // CommandMsg is a TypedMessage which is also a pointer type.
 //
 // T is message type. We want to enforce that the TypeMessage implementation is a pointer type
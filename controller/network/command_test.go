@@ -6,7 +6,10 @@ import (
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/fabric/pb/cmd_pb"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
 	"github.com/stretchr/testify/require"
+	"net/http"
 	"testing"
 )
 
@@ -91,3 +94,136 @@ func BenchmarkRegisterCommand(t *testing.B) {
 		req.NoError(err)
 	}
 }
+
+func TestBatchCommand(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	service := &Service{
+		BaseEntity:         models.BaseEntity{Id: "svc"},
+		Name:               "svc",
+		TerminatorStrategy: "smartrouting",
+	}
+
+	router := &Router{
+		BaseEntity: models.BaseEntity{Id: "r1"},
+		Name:       "r1",
+	}
+
+	terminator := &Terminator{
+		BaseEntity: models.BaseEntity{Id: "t1"},
+		Service:    "svc",
+		Router:     "r1",
+		Address:    "tcp:localhost:1234",
+	}
+
+	serviceCreate, err := NewCreateCommand[*Service](n.Services, service)
+	req.NoError(err)
+	routerCreate, err := NewCreateCommand[*Router](n.Routers, router)
+	req.NoError(err)
+	terminatorCreate, err := NewCreateCommand[*Terminator](n.Terminators, terminator)
+	req.NoError(err)
+
+	req.NoError(n.Managers.DispatchBatch(serviceCreate, routerCreate, terminatorCreate))
+
+	loaded, err := n.Terminators.Read("t1")
+	req.NoError(err)
+	req.Equal("svc", loaded.Service)
+	req.Equal("r1", loaded.Router)
+
+	_, found := n.Routers.cache.Get("r1")
+	req.True(found, "routers created in a batch should be cached")
+
+	// if any command fails, none of the batch should be applied
+	service2Create, err := NewCreateCommand[*Service](n.Services, &Service{
+		BaseEntity:         models.BaseEntity{Id: "svc2"},
+		Name:               "svc2",
+		TerminatorStrategy: "smartrouting",
+	})
+	req.NoError(err)
+
+	missingUpdate := NewUpdateCommand[*Service](n.Services, &Service{
+		BaseEntity: models.BaseEntity{Id: "missing"},
+		Name:       "missing",
+	}, nil)
+
+	router2Create, err := NewCreateCommand[*Router](n.Routers, &Router{
+		BaseEntity: models.BaseEntity{Id: "r2"},
+		Name:       "r2",
+	})
+	req.NoError(err)
+
+	err = n.Managers.DispatchBatch(service2Create, router2Create, NewDeleteCommand(n.Terminators, "t1"), missingUpdate)
+	req.Error(err)
+
+	_, found = n.Routers.cache.Get("r2")
+	req.False(found, "routers from a rolled back batch shouldn't be cached")
+
+	_, err = n.Services.Read("svc2")
+	req.True(boltz.IsErrNotFoundErr(err))
+
+	_, err = n.Terminators.Read("t1")
+	req.NoError(err)
+}
+
+func TestUpdateIfVersion(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	req.NoError(n.Services.Create(&Service{
+		BaseEntity:         models.BaseEntity{Id: "svc"},
+		Name:               "svc",
+		TerminatorStrategy: "smartrouting",
+	}))
+
+	service, err := n.Services.Read("svc")
+	req.NoError(err)
+	req.Equal(uint64(1), service.Version)
+
+	service.MaxCircuits = 10
+	req.NoError(n.Services.UpdateIfVersion(service, nil, "1"))
+
+	service, err = n.Services.Read("svc")
+	req.NoError(err)
+	req.Equal(uint64(2), service.Version)
+	req.Equal(uint32(10), service.MaxCircuits)
+
+	// a stale version should be rejected, and the update shouldn't be applied. Use a copy, since Read may return the
+	// cached instance
+	stale := *service
+	stale.MaxCircuits = 20
+	err = n.Services.UpdateIfVersion(&stale, nil, "1")
+	req.Error(err)
+	apiErr, ok := err.(*errorz.ApiError)
+	req.True(ok)
+	req.Equal(http.StatusPreconditionFailed, apiErr.Status)
+
+	service, err = n.Services.Read("svc")
+	req.NoError(err)
+	req.Equal(uint32(10), service.MaxCircuits)
+
+	// the version must survive the encode/decode round trip used by raft
+	cmd := NewUpdateCommand[*Service](n.Services, service, nil)
+	cmd.Version = "2"
+	encoded, err := cmd.Encode()
+	req.NoError(err)
+	decoded, err := n.Managers.Command.Decoders.Decode(encoded)
+	req.NoError(err)
+	req.Equal("2", decoded.(*command.UpdateEntityCommand[*Service]).Version)
+}
@@ -0,0 +1,461 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"bytes"
+	"reflect"
+
+	"github.com/openziti/fabric/controller/command"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/idgen"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/foundation/v2/errorz"
+	"github.com/openziti/storage/boltz"
+	"go.etcd.io/bbolt"
+)
+
+type ImportAction string
+
+const (
+	ImportActionCreate    ImportAction = "create"
+	ImportActionUpdate    ImportAction = "update"
+	ImportActionUnchanged ImportAction = "unchanged"
+	ImportActionDelete    ImportAction = "delete"
+)
+
+// EntitySet is a set of fabric entities, as exported from or imported into the data model
+type EntitySet struct {
+	Services    []*Service
+	Routers     []*Router
+	Terminators []*Terminator
+}
+
+// ImportChange describes what an import or reconcile did, or would do in the case of a dry run, to a single entity
+type ImportChange struct {
+	EntityType    string
+	Id            string
+	Name          string
+	Action        ImportAction
+	ChangedFields []string
+}
+
+func (self *Managers) Export() (*EntitySet, error) {
+	result := &EntitySet{}
+	err := self.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		if result.Services, err = exportEntities(tx, &self.Services.baseEntityManager); err != nil {
+			return err
+		}
+		if result.Routers, err = exportEntities(tx, &self.Routers.baseEntityManager); err != nil {
+			return err
+		}
+		result.Terminators, err = exportEntities(tx, &self.Terminators.baseEntityManager)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func exportEntities[T models.Entity](tx *bbolt.Tx, manager *baseEntityManager[T]) ([]T, error) {
+	ids, _, err := manager.GetStore().QueryIds(tx, "true limit none")
+	if err != nil {
+		return nil, err
+	}
+	var result []T
+	for _, id := range ids {
+		entity, err := manager.BaseLoadInTx(tx, id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entity)
+	}
+	return result, nil
+}
+
+// Import creates or updates the given entities. Entities are matched on id, or on name for services and routers
+// which were given without an id. Entities which aren't part of the import are left alone. The changes are first
+// planned in a read-only transaction, so they can be reported and any errors caught before anything is dispatched. If
+// dryRun is false, the planned creates and updates are then dispatched as a single batch, so they're applied
+// atomically on every controller.
+func (self *Managers) Import(entities *EntitySet, dryRun bool) ([]*ImportChange, error) {
+	return self.importEntities(entities, false, dryRun)
+}
+
+// Reconcile makes the data model match the given desired state. It works like Import, except that services, routers
+// and static terminators which aren't part of the desired state are deleted. Terminators which were established by a
+// hosting application, identified by having a host id or instance secret, are only deleted if their service or router
+// is deleted.
+func (self *Managers) Reconcile(entities *EntitySet, dryRun bool) ([]*ImportChange, error) {
+	return self.importEntities(entities, true, dryRun)
+}
+
+func (self *Managers) importEntities(entities *EntitySet, prune bool, dryRun bool) ([]*ImportChange, error) {
+	if err := self.resolveImportIds(entities); err != nil {
+		return nil, err
+	}
+
+	var plan *importPlan
+	err := self.db.View(func(tx *bbolt.Tx) error {
+		var err error
+		plan, err = self.planImport(tx, entities, prune)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun || len(plan.commands) == 0 {
+		return plan.changes, nil
+	}
+
+	if err = self.DispatchBatch(plan.commands...); err != nil {
+		return nil, err
+	}
+	return plan.changes, nil
+}
+
+// resolveImportIds assigns ids to entities which don't have them, so that every controller applies the same ids
+func (self *Managers) resolveImportIds(entities *EntitySet) error {
+	return self.db.View(func(tx *bbolt.Tx) error {
+		for _, entity := range entities.Services {
+			if err := resolveImportId(tx, self.stores.Service, &entity.BaseEntity.Id, entity.Name); err != nil {
+				return err
+			}
+		}
+		for _, entity := range entities.Routers {
+			if err := resolveImportId(tx, self.stores.Router, &entity.BaseEntity.Id, entity.Name); err != nil {
+				return err
+			}
+		}
+		for _, entity := range entities.Terminators {
+			if entity.Id == "" {
+				return errorz.NewFieldError("terminator id is required", "id", entity.Id)
+			}
+		}
+		return nil
+	})
+}
+
+func resolveImportId(tx *bbolt.Tx, store db.NameIndexedStore, id *string, name string) error {
+	if *id != "" {
+		return nil
+	}
+	if existingId := store.GetNameIndex().Read(tx, []byte(name)); existingId != nil {
+		*id = string(existingId)
+		return nil
+	}
+	newId, err := idgen.NewUUIDString()
+	if err != nil {
+		return err
+	}
+	*id = newId
+	return nil
+}
+
+// importPlan holds the changes an import will make, along with the commands which make them
+type importPlan struct {
+	changes  []*ImportChange
+	commands []command.Command
+}
+
+func (self *importPlan) add(change *ImportChange, cmd command.Command) {
+	self.changes = append(self.changes, change)
+	if cmd != nil {
+		self.commands = append(self.commands, cmd)
+	}
+}
+
+// importManager is the subset of the entity managers needed to plan an import
+type importManager[T models.Entity] interface {
+	command.EntityManager[T]
+	GetStore() boltz.CrudStore
+	BaseLoadInTx(tx *bbolt.Tx, id string) (T, error)
+	ValidateNameOnCreate(ctx boltz.MutateContext, entity interface{}) error
+	ValidateNameOnUpdate(ctx boltz.MutateContext, updatedEntity, existingEntity boltz.Entity, checker boltz.FieldChecker) error
+}
+
+func (self *Managers) planImport(tx *bbolt.Tx, entities *EntitySet, prune bool) (*importPlan, error) {
+	plan := &importPlan{}
+
+	for _, entity := range entities.Services {
+		if err := planEntity[*Service](tx, plan, self.Services, entity, entity.Name, (*Service).toBolt, diffServices); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, entity := range entities.Routers {
+		if err := planEntity[*Router](tx, plan, self.Routers, entity, entity.Name, (*Router).toBolt, diffRouters); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, entity := range entities.Terminators {
+		self.Terminators.checkBinding(entity)
+		if err := planEntity[*Terminator](tx, plan, self.Terminators, entity, entity.Address, terminatorToBolt, diffTerminators); err != nil {
+			return nil, err
+		}
+	}
+
+	if prune {
+		if err := self.planPrune(tx, plan, entities); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+func (self *Managers) planPrune(tx *bbolt.Tx, plan *importPlan, entities *EntitySet) error {
+	serviceDeletes, err := findPrunable(tx, self.stores.Service, entities.Services)
+	if err != nil {
+		return err
+	}
+
+	routerDeletes, err := findPrunable(tx, self.stores.Router, entities.Routers)
+	if err != nil {
+		return err
+	}
+
+	terminatorDeletes, err := findPrunable(tx, self.stores.Terminator, entities.Terminators)
+	if err != nil {
+		return err
+	}
+
+	deletedServices := map[string]struct{}{}
+	for _, id := range serviceDeletes {
+		deletedServices[id] = struct{}{}
+	}
+
+	deletedRouters := map[string]struct{}{}
+	for _, id := range routerDeletes {
+		deletedRouters[id] = struct{}{}
+	}
+
+	// terminators are deleted explicitly, even when they'd be removed along with their service or router, so that
+	// they're reported
+	for _, id := range terminatorDeletes {
+		terminator, err := self.stores.Terminator.LoadOneById(tx, id)
+		if err != nil {
+			return err
+		}
+		if terminator.HostId != "" || len(terminator.InstanceSecret) > 0 {
+			_, serviceDeleted := deletedServices[terminator.Service]
+			_, routerDeleted := deletedRouters[terminator.Router]
+			if !serviceDeleted && !routerDeleted {
+				continue
+			}
+		}
+		plan.add(newDeleteChange(self.stores.Terminator, id, terminator.Address), NewDeleteCommand(self.Terminators, id))
+	}
+
+	for _, id := range serviceDeletes {
+		service, err := self.stores.Service.LoadOneById(tx, id)
+		if err != nil {
+			return err
+		}
+		plan.add(newDeleteChange(self.stores.Service, id, service.Name), NewDeleteCommand(self.Services, id))
+	}
+
+	for _, id := range routerDeletes {
+		router, err := self.stores.Router.LoadOneById(tx, id)
+		if err != nil {
+			return err
+		}
+		plan.add(newDeleteChange(self.stores.Router, id, router.Name), NewDeleteCommand(self.Routers, id))
+	}
+
+	return nil
+}
+
+// findPrunable returns the ids of the entities in the store which aren't in the given desired set
+func findPrunable[T models.Entity](tx *bbolt.Tx, store boltz.CrudStore, desired []T) ([]string, error) {
+	ids, _, err := store.QueryIds(tx, "true limit none")
+	if err != nil {
+		return nil, err
+	}
+
+	keep := map[string]struct{}{}
+	for _, entity := range desired {
+		keep[entity.GetId()] = struct{}{}
+	}
+
+	var result []string
+	for _, id := range ids {
+		if _, found := keep[id]; !found {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+func newDeleteChange(store boltz.CrudStore, id, name string) *ImportChange {
+	return &ImportChange{
+		EntityType: store.GetEntityType(),
+		Id:         id,
+		Name:       name,
+		Action:     ImportActionDelete,
+	}
+}
+
+// planEntity works out whether the given entity needs to be created or updated and adds the change, along with the
+// command to make it, to the plan. Updates are only applied if the entity is still at the version it was planned
+// against, so changes made between planning and applying aren't silently overwritten
+func planEntity[T models.Entity](tx *bbolt.Tx, plan *importPlan, manager importManager[T], entity T, name string,
+	toBolt func(T) boltz.Entity, diff func(existing, imported T) []string) error {
+	store := manager.GetStore()
+	change := &ImportChange{
+		EntityType: store.GetEntityType(),
+		Id:         entity.GetId(),
+		Name:       name,
+	}
+
+	// name validation only reads, so it's safe to run against the read-only transaction
+	ctx := boltz.NewMutateContext(tx)
+
+	if !store.IsEntityPresent(tx, entity.GetId()) {
+		change.Action = ImportActionCreate
+		if err := manager.ValidateNameOnCreate(ctx, entity); err != nil {
+			return err
+		}
+		cmd, err := NewCreateCommand[T](manager, entity)
+		if err != nil {
+			return err
+		}
+		plan.add(change, cmd)
+		return nil
+	}
+
+	existing, err := manager.BaseLoadInTx(tx, entity.GetId())
+	if err != nil {
+		return err
+	}
+
+	change.ChangedFields = diff(existing, entity)
+	if len(change.ChangedFields) == 0 {
+		change.Action = ImportActionUnchanged
+		plan.add(change, nil)
+		return nil
+	}
+
+	change.Action = ImportActionUpdate
+	checker := fields.SliceToUpdatedFields(change.ChangedFields)
+	if err = manager.ValidateNameOnUpdate(ctx, toBolt(entity), toBolt(existing), checker); err != nil {
+		return err
+	}
+
+	version, err := db.GetEntityVersion(tx, store, entity.GetId())
+	if err != nil {
+		return err
+	}
+
+	cmd := NewUpdateCommand[T](manager, entity, checker)
+	cmd.Version = version
+	plan.add(change, cmd)
+	return nil
+}
+
+type fieldDiff []string
+
+func (self *fieldDiff) check(field string, changed bool) {
+	if changed {
+		*self = append(*self, field)
+	}
+}
+
+func tagsChanged(existing, imported map[string]interface{}) bool {
+	if len(existing) == 0 && len(imported) == 0 {
+		return false
+	}
+	return !reflect.DeepEqual(existing, imported)
+}
+
+func diffServices(existing, imported *Service) []string {
+	var result fieldDiff
+	result.check(db.FieldName, existing.Name != imported.Name)
+	result.check(db.FieldServiceTerminatorStrategy, existing.TerminatorStrategy != imported.TerminatorStrategy)
+	result.check(db.FieldServiceMaxCircuits, existing.MaxCircuits != imported.MaxCircuits)
+	result.check(db.FieldServiceMaxCircuitsPerClient, existing.MaxCircuitsPerClient != imported.MaxCircuitsPerClient)
+	result.check(db.FieldServiceMaxDialRate, existing.MaxDialRate != imported.MaxDialRate)
+	result.check(db.FieldServicePriority, existing.GetPriority() != imported.GetPriority())
+	result.check(db.FieldServiceMaxRouterBandwidth, existing.MaxRouterBandwidth != imported.MaxRouterBandwidth)
+	result.check(db.FieldServiceMaxCircuitBandwidth, existing.MaxCircuitBandwidth != imported.MaxCircuitBandwidth)
+	result.check(boltz.FieldTags, tagsChanged(existing.Tags, imported.Tags))
+	return result
+}
+
+func diffRouters(existing, imported *Router) []string {
+	var result fieldDiff
+	result.check(db.FieldName, existing.Name != imported.Name)
+	result.check(db.FieldRouterFingerprint, stringPtrValue(existing.Fingerprint) != stringPtrValue(imported.Fingerprint))
+	result.check(db.FieldRouterCost, existing.Cost != imported.Cost)
+	result.check(db.FieldRouterNoTraversal, existing.NoTraversal != imported.NoTraversal)
+	result.check(boltz.FieldTags, tagsChanged(existing.Tags, imported.Tags))
+	return result
+}
+
+func diffTerminators(existing, imported *Terminator) []string {
+	existingBolt, importedBolt := existing.toBolt(), imported.toBolt()
+	var result fieldDiff
+	result.check(db.FieldTerminatorService, existing.Service != imported.Service)
+	result.check(db.FieldTerminatorRouter, existing.Router != imported.Router)
+	result.check(db.FieldTerminatorBinding, existing.Binding != imported.Binding)
+	result.check(db.FieldTerminatorAddress, existing.Address != imported.Address)
+	result.check(db.FieldTerminatorInstanceId, existing.InstanceId != imported.InstanceId)
+	result.check(db.FieldTerminatorInstanceSecret, !bytes.Equal(existing.InstanceSecret, imported.InstanceSecret))
+	result.check(db.FieldTerminatorCost, existing.Cost != imported.Cost)
+	result.check(db.FieldTerminatorPrecedence, existingBolt.Precedence != importedBolt.Precedence)
+	result.check(db.FieldTerminatorWeight, weightOrDefault(existing.Weight) != weightOrDefault(imported.Weight))
+	result.check(db.FieldTerminatorHostId, existing.HostId != imported.HostId)
+	result.check(db.FieldServerPeerData, peerDataChanged(existing.PeerData, imported.PeerData))
+	result.check(boltz.FieldTags, tagsChanged(existing.Tags, imported.Tags))
+	return result
+}
+
+func terminatorToBolt(terminator *Terminator) boltz.Entity {
+	return terminator.toBolt()
+}
+
+func weightOrDefault(weight uint16) uint16 {
+	if weight == 0 {
+		return xt.DefaultWeight
+	}
+	return weight
+}
+
+func peerDataChanged(existing, imported map[uint32][]byte) bool {
+	if len(existing) != len(imported) {
+		return true
+	}
+	for k, v := range existing {
+		if importedV, found := imported[k]; !found || !bytes.Equal(v, importedV) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,273 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/openziti/storage/boltz"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportExport(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	req.NoError(n.Services.Create(&Service{
+		BaseEntity:         models.BaseEntity{Id: "svc1"},
+		Name:               "service-one",
+		TerminatorStrategy: "smartrouting",
+	}))
+
+	req.NoError(n.Routers.Create(&Router{
+		BaseEntity: models.BaseEntity{Id: "r1"},
+		Name:       "router-one",
+		Cost:       10,
+	}))
+
+	entities := &EntitySet{
+		Services: []*Service{
+			{
+				BaseEntity:         models.BaseEntity{Id: "svc1", Tags: map[string]interface{}{"env": "test"}},
+				Name:               "service-one",
+				TerminatorStrategy: "smartrouting",
+				MaxCircuits:        10,
+			},
+			{
+				Name:               "service-two",
+				TerminatorStrategy: "smartrouting",
+				MaxCircuits:        5,
+			},
+		},
+		Routers: []*Router{
+			{
+				BaseEntity: models.BaseEntity{Id: "r1"},
+				Name:       "router-one",
+				Cost:       10,
+			},
+		},
+		Terminators: []*Terminator{
+			{
+				BaseEntity: models.BaseEntity{Id: "t1"},
+				Service:    "svc1",
+				Router:     "r1",
+				Address:    "tcp:localhost:1234",
+				Precedence: xt.Precedences.Default,
+				Weight:     20,
+			},
+		},
+	}
+
+	changes, err := n.Managers.Import(entities, true)
+	req.NoError(err)
+	req.Len(changes, 4)
+
+	req.Equal(ImportActionUpdate, changes[0].Action)
+	req.ElementsMatch([]string{db.FieldServiceMaxCircuits, "tags"}, changes[0].ChangedFields)
+	req.Equal(ImportActionCreate, changes[1].Action)
+	req.Equal("service-two", changes[1].Name)
+	req.NotEmpty(changes[1].Id)
+	req.Equal(ImportActionUnchanged, changes[2].Action)
+	req.Equal(ImportActionCreate, changes[3].Action)
+
+	// dry run shouldn't have changed anything
+	svc, err := n.Services.Read("svc1")
+	req.NoError(err)
+	req.Equal(uint32(0), svc.MaxCircuits)
+	_, err = n.Terminators.Read("t1")
+	req.True(boltz.IsErrNotFoundErr(err))
+
+	_, err = n.Managers.Import(entities, false)
+	req.NoError(err)
+
+	svc, err = n.Services.Read("svc1")
+	req.NoError(err)
+	req.Equal(uint32(10), svc.MaxCircuits)
+	req.Equal("test", svc.Tags["env"])
+
+	terminator, err := n.Terminators.Read("t1")
+	req.NoError(err)
+	req.Equal("transport", terminator.Binding)
+	req.Equal(uint16(20), terminator.Weight)
+
+	exported, err := n.Managers.Export()
+	req.NoError(err)
+	req.Len(exported.Services, 2)
+	req.Len(exported.Routers, 1)
+	req.Len(exported.Terminators, 1)
+
+	// re-importing the export should be a no-op
+	changes, err = n.Managers.Import(exported, true)
+	req.NoError(err)
+	for _, change := range changes {
+		req.Equal(ImportActionUnchanged, change.Action, "%v %v changed: %v", change.EntityType, change.Id, change.ChangedFields)
+	}
+}
+
+func TestImportIsAtomic(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	entities := &EntitySet{
+		Services: []*Service{
+			{
+				BaseEntity:         models.BaseEntity{Id: "svc1"},
+				Name:               "service-one",
+				TerminatorStrategy: "smartrouting",
+			},
+		},
+		Terminators: []*Terminator{
+			{
+				BaseEntity: models.BaseEntity{Id: "t1"},
+				Service:    "svc1",
+				Router:     "missing",
+				Address:    "tcp:localhost:1234",
+			},
+		},
+	}
+
+	_, err = n.Managers.Import(entities, false)
+	req.Error(err)
+	_, err = n.Services.Read("svc1")
+	req.True(boltz.IsErrNotFoundErr(err))
+}
+
+func TestImportDryRunValidatesNames(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	req.NoError(n.Services.Create(&Service{BaseEntity: models.BaseEntity{Id: "svc1"}, Name: "one", TerminatorStrategy: "smartrouting"}))
+
+	entities := &EntitySet{
+		Services: []*Service{{BaseEntity: models.BaseEntity{Id: "svc2"}, Name: "one", TerminatorStrategy: "smartrouting"}},
+	}
+
+	_, err = n.Managers.Import(entities, true)
+	req.Error(err)
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	req.NoError(n.Services.Create(&Service{BaseEntity: models.BaseEntity{Id: "svc1"}, Name: "one", TerminatorStrategy: "smartrouting"}))
+	req.NoError(n.Services.Create(&Service{BaseEntity: models.BaseEntity{Id: "svc2"}, Name: "two", TerminatorStrategy: "smartrouting"}))
+	req.NoError(n.Routers.Create(&Router{BaseEntity: models.BaseEntity{Id: "r1"}, Name: "router-one"}))
+	req.NoError(n.Routers.Create(&Router{BaseEntity: models.BaseEntity{Id: "r2"}, Name: "router-two"}))
+
+	req.NoError(n.Terminators.Create(&Terminator{
+		BaseEntity: models.BaseEntity{Id: "static"},
+		Service:    "svc1",
+		Router:     "r1",
+		Address:    "tcp:localhost:1234",
+	}))
+
+	req.NoError(n.Terminators.Create(&Terminator{
+		BaseEntity: models.BaseEntity{Id: "hosted"},
+		Service:    "svc1",
+		Router:     "r1",
+		Address:    "hosted",
+		HostId:     "host",
+	}))
+
+	req.NoError(n.Terminators.Create(&Terminator{
+		BaseEntity: models.BaseEntity{Id: "hosted2"},
+		Service:    "svc2",
+		Router:     "r1",
+		Address:    "hosted",
+		HostId:     "host",
+	}))
+
+	desired := &EntitySet{
+		Services: []*Service{{Name: "one", TerminatorStrategy: "smartrouting"}},
+		Routers:  []*Router{{BaseEntity: models.BaseEntity{Id: "r1"}, Name: "router-one"}},
+	}
+
+	changes, err := n.Managers.Reconcile(desired, true)
+	req.NoError(err)
+
+	deletes := map[string]string{}
+	for _, change := range changes {
+		if change.Action == ImportActionDelete {
+			deletes[change.Id] = change.EntityType
+		} else {
+			req.Equal(ImportActionUnchanged, change.Action)
+		}
+	}
+	req.Equal(map[string]string{
+		"static":  db.EntityTypeTerminators,
+		"hosted2": db.EntityTypeTerminators,
+		"svc2":    db.EntityTypeServices,
+		"r2":      db.EntityTypeRouters,
+	}, deletes)
+
+	_, err = n.Services.Read("svc2")
+	req.NoError(err)
+
+	_, err = n.Routers.Read("r2")
+	req.NoError(err)
+
+	_, err = n.Managers.Reconcile(desired, false)
+	req.NoError(err)
+
+	_, err = n.Services.Read("svc2")
+	req.True(boltz.IsErrNotFoundErr(err))
+
+	_, err = n.Terminators.Read("static")
+	req.True(boltz.IsErrNotFoundErr(err))
+
+	_, err = n.Terminators.Read("hosted")
+	req.NoError(err)
+
+	_, err = n.Routers.Read("r2")
+	req.True(boltz.IsErrNotFoundErr(err))
+}
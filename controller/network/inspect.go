@@ -17,9 +17,11 @@
 package network
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/inspect"
 	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/foundation/v2/concurrenz"
 	"regexp"
@@ -70,6 +72,66 @@ func (self *InspectionsManager) Inspect(appRegex string, values []string) *Inspe
 	return ctx.RunInspections()
 }
 
+// CircuitRouterInspection holds a router's view of a circuit. Detail is nil if the router couldn't be reached, or
+// doesn't have the circuit, in which case Error describes why
+type CircuitRouterInspection struct {
+	Router *Router
+	Detail *inspect.CircuitInspectDetail
+	Error  string
+}
+
+// InspectCircuit asks each router on the circuit's path for its forwarding and xgress state for the circuit. Routers
+// are queried in parallel and results are returned in path order
+func (self *InspectionsManager) InspectCircuit(circuit *Circuit, timeout time.Duration) []*CircuitRouterInspection {
+	result := make([]*CircuitRouterInspection, len(circuit.Path.Nodes))
+	waitGroup := sync.WaitGroup{}
+
+	for idx, node := range circuit.Path.Nodes {
+		inspection := &CircuitRouterInspection{Router: node}
+		result[idx] = inspection
+
+		router := self.network.GetConnectedRouter(node.Id)
+		if router == nil {
+			inspection.Error = "router not connected"
+			continue
+		}
+
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			inspection.Detail, inspection.Error = self.inspectCircuitOnRouter(router, circuit.Id, timeout)
+		}()
+	}
+
+	waitGroup.Wait()
+	return result
+}
+
+func (self *InspectionsManager) inspectCircuitOnRouter(router *Router, circuitId string, timeout time.Duration) (*inspect.CircuitInspectDetail, string) {
+	requested := "circuit:" + circuitId
+	request := &ctrl_pb.InspectRequest{RequestedValues: []string{requested}}
+	resp := &ctrl_pb.InspectResponse{}
+	respMsg, err := protobufs.MarshalTyped(request).WithTimeout(timeout).SendForReply(router.Control)
+	if err = protobufs.TypedResponse(resp).Unmarshall(respMsg, err); err != nil {
+		return nil, err.Error()
+	}
+
+	for _, val := range resp.Values {
+		if val.Name == requested {
+			detail := &inspect.CircuitInspectDetail{}
+			if err = json.Unmarshal([]byte(val.Value), detail); err != nil {
+				return nil, fmt.Sprintf("unable to parse circuit inspect result (%v)", err)
+			}
+			return detail, ""
+		}
+	}
+
+	if len(resp.Errors) > 0 {
+		return nil, resp.Errors[0]
+	}
+	return nil, "circuit not found on router"
+}
+
 type inspectRequestContext struct {
 	network         *Network
 	timeout         time.Duration
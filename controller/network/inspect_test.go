@@ -0,0 +1,165 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/channel/protobufs"
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/inspect"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/identity"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// newInspectableRouter returns a router whose control channel is answered by the given function, in place of a
+// real router's inspect handler
+func newInspectableRouter(t *testing.T, id string, delay time.Duration, respond func(request *ctrl_pb.InspectRequest) *ctrl_pb.InspectResponse) *Router {
+	req := require.New(t)
+
+	ctrlConn, routerConn := net.Pipe()
+
+	routerBindHandler := channel.BindHandlerF(func(binding channel.Binding) error {
+		binding.AddReceiveHandlerF(int32(ctrl_pb.ContentType_InspectRequestType), func(m *channel.Message, ch channel.Channel) {
+			request := &ctrl_pb.InspectRequest{}
+			if err := proto.Unmarshal(m.Body, request); err != nil {
+				pfxlog.Logger().WithError(err).Error("unable to unmarshal inspect request")
+				return
+			}
+			time.Sleep(delay)
+			if err := protobufs.MarshalTyped(respond(request)).ReplyTo(m).WithTimeout(time.Second).Send(ch); err != nil {
+				pfxlog.Logger().WithError(err).Error("unable to send inspect response")
+			}
+		})
+		return nil
+	})
+
+	routerChC := make(chan channel.Channel, 1)
+	go func() {
+		listener := channel.NewExistingConnListener(&identity.TokenId{Token: id}, routerConn, nil)
+		ch, err := channel.NewChannel("router", listener, routerBindHandler, nil)
+		if err != nil {
+			pfxlog.Logger().WithError(err).Error("unable to create router channel")
+		}
+		routerChC <- ch
+	}()
+
+	dialer := channel.NewExistingConnDialer(&identity.TokenId{Token: "ctrl"}, ctrlConn, nil)
+	ctrlCh, err := channel.NewChannel("ctrl", dialer, nil, nil)
+	req.NoError(err)
+
+	routerCh := <-routerChC
+	req.NotNil(routerCh)
+
+	t.Cleanup(func() {
+		_ = ctrlCh.Close()
+		_ = routerCh.Close()
+	})
+
+	return newRouterForTest(id, "", nil, ctrlCh, 0, false)
+}
+
+func circuitInspectResponse(t *testing.T, request *ctrl_pb.InspectRequest, routerId string) *ctrl_pb.InspectResponse {
+	detail := &inspect.CircuitInspectDetail{
+		CircuitId: routerId,
+		Forwards:  map[string]string{"a": "b"},
+	}
+	val, err := json.Marshal(detail)
+	require.NoError(t, err)
+
+	return &ctrl_pb.InspectResponse{
+		Success: true,
+		Values: []*ctrl_pb.InspectResponse_InspectValue{
+			{Name: request.RequestedValues[0], Value: string(val)},
+		},
+	}
+}
+
+func TestInspectCircuit(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	// the first router answers last, so results have to be placed by path position rather than arrival order
+	r0 := newInspectableRouter(t, "r0", 100*time.Millisecond, func(request *ctrl_pb.InspectRequest) *ctrl_pb.InspectResponse {
+		return circuitInspectResponse(t, request, "r0")
+	})
+
+	// r1 is never connected
+	r1 := newRouterForTest("r1", "", nil, nil, 0, false)
+
+	// r2 doesn't have the circuit
+	r2 := newInspectableRouter(t, "r2", 0, func(request *ctrl_pb.InspectRequest) *ctrl_pb.InspectResponse {
+		return &ctrl_pb.InspectResponse{Success: true}
+	})
+
+	// r3 reports an error for the circuit
+	r3 := newInspectableRouter(t, "r3", 0, func(request *ctrl_pb.InspectRequest) *ctrl_pb.InspectResponse {
+		return &ctrl_pb.InspectResponse{Success: false, Errors: []string{"inspect failed"}}
+	})
+
+	r4 := newInspectableRouter(t, "r4", 0, func(request *ctrl_pb.InspectRequest) *ctrl_pb.InspectResponse {
+		return circuitInspectResponse(t, request, "r4")
+	})
+
+	for _, r := range []*Router{r0, r2, r3, r4} {
+		n.Routers.markConnected(r)
+	}
+
+	circuit := &Circuit{
+		Id:   "c1",
+		Path: &Path{Nodes: []*Router{r0, r1, r2, r3, r4}},
+	}
+
+	results := n.Managers.Inspections.InspectCircuit(circuit, time.Second)
+	req.Len(results, 5)
+
+	for idx, r := range circuit.Path.Nodes {
+		req.Equal(r, results[idx].Router)
+	}
+
+	req.Equal("", results[0].Error)
+	req.NotNil(results[0].Detail)
+	req.Equal("r0", results[0].Detail.CircuitId)
+	req.Equal("b", results[0].Detail.Forwards["a"])
+
+	req.Equal("router not connected", results[1].Error)
+	req.Nil(results[1].Detail)
+
+	req.Equal("circuit not found on router", results[2].Error)
+	req.Nil(results[2].Detail)
+
+	req.Equal("inspect failed", results[3].Error)
+	req.Nil(results[3].Detail)
+
+	req.Equal("", results[4].Error)
+	req.Equal("r4", results[4].Detail.CircuitId)
+}
@@ -18,6 +18,7 @@ package network
 
 import (
 	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/controller/apierror"
 	"github.com/openziti/fabric/controller/command"
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/fields"
@@ -58,6 +59,14 @@ func (self *Managers) Dispatch(command command.Command) error {
 	return self.Dispatcher.Dispatch(command)
 }
 
+// DispatchBatch dispatches the given commands as a single command, so they're applied atomically, in one transaction
+func (self *Managers) DispatchBatch(commands ...command.Command) error {
+	return self.Dispatch(&command.BatchCommand{
+		Db:       self.db,
+		Commands: commands,
+	})
+}
+
 type creator[T models.Entity] interface {
 	command.EntityCreator[T]
 	Dispatch(cmd command.Command) error
@@ -69,30 +78,59 @@ type updater[T models.Entity] interface {
 }
 
 func DispatchCreate[T models.Entity](c creator[T], entity T) error {
+	cmd, err := NewCreateCommand[T](c, entity)
+	if err != nil {
+		return err
+	}
+	return c.Dispatch(cmd)
+}
+
+func DispatchUpdate[T models.Entity](u updater[T], entity T, updatedFields fields.UpdatedFields) error {
+	return u.Dispatch(NewUpdateCommand[T](u, entity, updatedFields))
+}
+
+// DispatchUpdateIfVersion dispatches an update which is only applied if the entity is still at the given version. An
+// empty version skips the check
+func DispatchUpdateIfVersion[T models.Entity](u updater[T], entity T, updatedFields fields.UpdatedFields, version string) error {
+	cmd := NewUpdateCommand[T](u, entity, updatedFields)
+	cmd.Version = version
+	return u.Dispatch(cmd)
+}
+
+// NewCreateCommand returns a command which will create the given entity, assigning it an id if it doesn't have one.
+// Use it to build up a batch with DispatchBatch
+func NewCreateCommand[T models.Entity](c command.EntityCreator[T], entity T) (*command.CreateEntityCommand[T], error) {
 	if entity.GetId() == "" {
 		id, err := idgen.NewUUIDString()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		entity.SetId(id)
 	}
 
-	cmd := &command.CreateEntityCommand[T]{
+	return &command.CreateEntityCommand[T]{
 		Creator: c,
 		Entity:  entity,
-	}
-
-	return c.Dispatch(cmd)
+	}, nil
 }
 
-func DispatchUpdate[T models.Entity](u updater[T], entity T, updatedFields fields.UpdatedFields) error {
-	cmd := &command.UpdateEntityCommand[T]{
+// NewUpdateCommand returns a command which will update the given entity. Use it to build up a batch with
+// DispatchBatch
+func NewUpdateCommand[T models.Entity](u command.EntityUpdater[T], entity T, updatedFields fields.UpdatedFields) *command.UpdateEntityCommand[T] {
+	return &command.UpdateEntityCommand[T]{
 		Updater:       u,
 		Entity:        entity,
 		UpdatedFields: updatedFields,
 	}
+}
 
-	return u.Dispatch(cmd)
+// NewDeleteCommand returns a command which will delete the entity with the given id. Use it to build up a batch with
+// DispatchBatch
+func NewDeleteCommand(d command.EntityDeleter, id string) *command.DeleteEntityCommand {
+	return &command.DeleteEntityCommand{
+		Deleter: d,
+		Id:      id,
+	}
 }
 
 type createDecoderF func(cmd *cmd_pb.CreateEntityCommand) (command.Command, error)
@@ -126,6 +164,7 @@ func RegisterUpdateDecoder[T models.Entity](managers *Managers, updater command.
 			Updater:       updater,
 			UpdatedFields: fields.SliceToUpdatedFields(cmd.UpdatedFields),
 			Flags:         cmd.Flags,
+			Version:       cmd.Version,
 		}, nil
 	}))
 }
@@ -206,20 +245,31 @@ func (self *baseEntityManager[T]) GetEntityTypeId() string {
 }
 
 func (self *baseEntityManager[T]) Delete(id string) error {
-	cmd := &command.DeleteEntityCommand{
-		Deleter: self,
-		Id:      id,
-	}
-	return self.Managers.Dispatch(cmd)
+	return self.Managers.Dispatch(NewDeleteCommand(self, id))
 }
 
 func (self *baseEntityManager[T]) ApplyDelete(cmd *command.DeleteEntityCommand) error {
 	return self.db.Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
-		return self.Store.DeleteById(ctx, cmd.Id)
+		return self.ApplyDeleteInTx(boltz.NewMutateContext(tx), cmd)
 	})
 }
 
+func (self *baseEntityManager[T]) ApplyDeleteInTx(ctx boltz.MutateContext, cmd *command.DeleteEntityCommand) error {
+	return self.Store.DeleteById(ctx, cmd.Id)
+}
+
+// CheckVersionInTx returns an error if the entity with the given id isn't at the given version
+func (ctrl *baseEntityManager[T]) CheckVersionInTx(tx *bbolt.Tx, id string, version string) error {
+	current, err := db.GetEntityVersion(tx, ctrl.GetStore(), id)
+	if err != nil {
+		return err
+	}
+	if current != version {
+		return apierror.NewEntityVersionMismatch(version, current)
+	}
+	return nil
+}
+
 func (ctrl *baseEntityManager[T]) BaseLoad(id string) (T, error) {
 	entity := ctrl.newModelEntity()
 	if err := ctrl.readEntity(id, entity); err != nil {
@@ -285,6 +335,17 @@ func (ctrl *baseEntityManager[T]) BasePreparedList(query ast.Query) (*models.Ent
 	return result, nil
 }
 
+func (ctrl *baseEntityManager[T]) BasePreparedListAfter(after string, query ast.Query) (*models.EntityListResult[T], error) {
+	result := &models.EntityListResult[T]{Loader: ctrl}
+	err := ctrl.db.View(func(tx *bbolt.Tx) error {
+		return ctrl.PreparedListAfterWithTx(tx, after, query, result.Collect)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (ctrl *baseEntityManager[T]) PreparedListWithHandler(query ast.Query, resultHandler models.ListResultHandler) error {
 	return ctrl.db.View(func(tx *bbolt.Tx) error {
 		return ctrl.PreparedListWithTx(tx, query, resultHandler)
@@ -297,33 +358,36 @@ func (ctrl *baseEntityManager[T]) PreparedListAssociatedWithHandler(id string, a
 	})
 }
 
+func (ctrl *baseEntityManager[T]) PreparedListAssociatedAfterWithHandler(id string, association string, after string, query ast.Query, handler models.ListResultHandler) error {
+	return ctrl.db.View(func(tx *bbolt.Tx) error {
+		return ctrl.PreparedListAssociatedAfterWithTx(tx, id, association, after, query, handler)
+	})
+}
+
 type boltEntitySource interface {
 	models.Entity
 	toBolt() boltz.Entity
 }
 
-func (ctrl *baseEntityManager[T]) updateGeneral(modelEntity boltEntitySource, checker boltz.FieldChecker) error {
-	return ctrl.db.Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
-		existing := ctrl.GetStore().NewStoreEntity()
-		found, err := ctrl.GetStore().BaseLoadOneById(tx, modelEntity.GetId(), existing)
-		if err != nil {
-			return err
-		}
-		if !found {
-			return boltz.NewNotFoundError(ctrl.GetStore().GetSingularEntityType(), "id", modelEntity.GetId())
-		}
+func (ctrl *baseEntityManager[T]) updateGeneralInTx(ctx boltz.MutateContext, modelEntity boltEntitySource, checker boltz.FieldChecker) error {
+	existing := ctrl.GetStore().NewStoreEntity()
+	found, err := ctrl.GetStore().BaseLoadOneById(ctx.Tx(), modelEntity.GetId(), existing)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return boltz.NewNotFoundError(ctrl.GetStore().GetSingularEntityType(), "id", modelEntity.GetId())
+	}
 
-		boltEntity := modelEntity.toBolt()
+	boltEntity := modelEntity.toBolt()
 
-		if err := ctrl.ValidateNameOnUpdate(ctx, boltEntity, existing, checker); err != nil {
-			return err
-		}
+	if err := ctrl.ValidateNameOnUpdate(ctx, boltEntity, existing, checker); err != nil {
+		return err
+	}
 
-		if err := ctrl.GetStore().Update(ctx, boltEntity, checker); err != nil {
-			pfxlog.Logger().WithError(err).Errorf("could not update %v entity", ctrl.GetStore().GetEntityType())
-			return err
-		}
-		return nil
-	})
+	if err := ctrl.GetStore().Update(ctx, boltEntity, checker); err != nil {
+		pfxlog.Logger().WithError(err).Errorf("could not update %v entity", ctrl.GetStore().GetEntityType())
+		return err
+	}
+	return nil
 }
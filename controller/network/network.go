@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/openziti/fabric/controller/command"
+	"github.com/openziti/fabric/controller/raft"
 	"github.com/openziti/fabric/event"
 	"github.com/openziti/foundation/v2/versions"
 	"sort"
@@ -55,6 +56,7 @@ type Config interface {
 	GetMetricsRegistry() metrics.Registry
 	GetOptions() *Options
 	GetCommandDispatcher() command.Dispatcher
+	GetRaftController() *raft.Controller
 	GetDb() boltz.Db
 	GetVersionProvider() versions.VersionProvider
 	GetEventDispatcher() event.Dispatcher
@@ -82,6 +84,9 @@ type Network struct {
 	lastSnapshot           time.Time
 	metricsRegistry        metrics.Registry
 	VersionProvider        versions.VersionProvider
+	changeFeed             *ChangeFeed
+	raftController         *raft.Controller
+	serviceStats           *serviceStatsTracker
 
 	serviceEventMetrics          metrics.UsageRegistry
 	serviceDialSuccessCounter    metrics.IntervalCounter
@@ -93,6 +98,11 @@ type Network struct {
 	serviceTerminatorConnectionRefusedCounter metrics.IntervalCounter
 	serviceInvalidTerminatorCounter           metrics.IntervalCounter
 	serviceMisconfiguredTerminatorCounter     metrics.IntervalCounter
+
+	serviceLimiter                           *serviceLimiter
+	serviceCircuitLimitExceededCounter       metrics.IntervalCounter
+	serviceClientCircuitLimitExceededCounter metrics.IntervalCounter
+	serviceDialRateLimitExceededCounter      metrics.IntervalCounter
 }
 
 func NewNetwork(config Config) (*Network, error) {
@@ -120,6 +130,7 @@ func NewNetwork(config Config) (*Network, error) {
 		lastSnapshot:          time.Now().Add(-time.Hour),
 		metricsRegistry:       config.GetMetricsRegistry(),
 		VersionProvider:       config.GetVersionProvider(),
+		raftController:        config.GetRaftController(),
 
 		serviceEventMetrics:          serviceEventMetrics,
 		serviceDialSuccessCounter:    serviceEventMetrics.IntervalCounter("service.dial.success", time.Minute),
@@ -131,10 +142,25 @@ func NewNetwork(config Config) (*Network, error) {
 		serviceTerminatorConnectionRefusedCounter: serviceEventMetrics.IntervalCounter("service.dial.terminator.connection_refused", time.Minute),
 		serviceInvalidTerminatorCounter:           serviceEventMetrics.IntervalCounter("service.dial.terminator.invalid", time.Minute),
 		serviceMisconfiguredTerminatorCounter:     serviceEventMetrics.IntervalCounter("service.dial.terminator.misconfigured", time.Minute),
+
+		serviceCircuitLimitExceededCounter:       serviceEventMetrics.IntervalCounter("service.dial.limit.circuits", time.Minute),
+		serviceClientCircuitLimitExceededCounter: serviceEventMetrics.IntervalCounter("service.dial.limit.client_circuits", time.Minute),
+		serviceDialRateLimitExceededCounter:      serviceEventMetrics.IntervalCounter("service.dial.limit.rate", time.Minute),
 	}
+	network.serviceLimiter = newServiceLimiter(network)
+	stores.Service.AddListener(boltz.EventDelete, func(i ...interface{}) {
+		for _, val := range i {
+			if entity, ok := val.(boltz.Entity); ok {
+				network.serviceLimiter.remove(entity.GetId())
+			}
+		}
+	})
 
 	network.Managers = NewManagers(network, config.GetCommandDispatcher(), config.GetDb(), stores)
 	network.Managers.Inspections.network = network
+	network.changeFeed = newChangeFeed(stores, int(network.options.ChangeFeedBufferSize))
+	network.serviceStats = newServiceStatsTracker(network.options.ServiceStatsWindow)
+	network.eventDispatcher.AddUsageEventHandler(network.serviceStats)
 
 	network.AddCapability("ziti.fabric")
 	network.showOptions()
@@ -184,6 +210,10 @@ func (network *Network) GetManagers() *Managers {
 	return network.Managers
 }
 
+func (network *Network) GetChangeFeed() *ChangeFeed {
+	return network.changeFeed
+}
+
 func (network *Network) CreateRouter(router *Router) error {
 	return network.Routers.Create(router)
 }
@@ -238,6 +268,11 @@ func (network *Network) removeRouteSender(rs *routeSender) {
 	network.routeSenderController.removeRouteSender(rs)
 }
 
+// GetRaftController returns the raft controller, or nil if the controller isn't running clustered
+func (network *Network) GetRaftController() *raft.Controller {
+	return network.raftController
+}
+
 func (network *Network) GetEventDispatcher() event.Dispatcher {
 	return network.eventDispatcher
 }
@@ -427,6 +462,15 @@ func (network *Network) CreateCircuit(srcR *Router, clientId *identity.TokenId,
 	allCleanups := make(map[string]struct{})
 	rs := network.newRouteSender(circuitId)
 	defer func() { network.removeRouteSender(rs) }()
+
+	limitAcquired := false
+	circuitCreated := false
+	defer func() {
+		if limitAcquired && !circuitCreated {
+			network.serviceLimiter.release(serviceId, clientId.Token)
+		}
+	}()
+
 	for {
 		// 2: Find Service
 		svc, err := network.Services.Read(serviceId)
@@ -437,6 +481,15 @@ func (network *Network) CreateCircuit(srcR *Router, clientId *identity.TokenId,
 		}
 		logger = logger.WithField("serviceName", svc.Name)
 
+		// 2a: Enforce service limits
+		if !limitAcquired {
+			if circuitErr := network.serviceLimiter.acquire(svc, clientId.Token, startTime); circuitErr != nil {
+				network.CircuitFailedEvent(circuitId, clientId.Token, serviceId, instanceId, startTime, nil, nil, circuitErr.Cause())
+				return nil, circuitErr
+			}
+			limitAcquired = true
+		}
+
 		// 3: select terminator
 		strategy, terminator, pathNodes, circuitErr := network.selectPath(srcR, svc, instanceId, ctx)
 		if circuitErr != nil {
@@ -454,7 +507,7 @@ func (network *Network) CreateCircuit(srcR *Router, clientId *identity.TokenId,
 		}
 
 		// 4a: Create Route Messages
-		rms := path.CreateRouteMessages(attempt, circuitId, terminator, deadline)
+		rms := path.CreateRouteMessages(attempt, circuitId, svc, terminator, deadline)
 		rms[len(rms)-1].Egress.PeerData = clientId.Data
 
 		for _, msg := range rms {
@@ -535,6 +588,7 @@ func (network *Network) CreateCircuit(srcR *Router, clientId *identity.TokenId,
 			CreatedAt:  time.Now(),
 		}
 		network.circuitController.add(circuit)
+		circuitCreated = true
 		creationTimespan := time.Since(startTime)
 		network.CircuitEvent(event.CircuitCreated, circuit, &creationTimespan)
 
@@ -679,6 +733,7 @@ func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 			}
 		}
 		network.circuitController.remove(circuit)
+		network.serviceLimiter.release(circuit.Service.Id, circuit.ClientId)
 		network.CircuitEvent(event.CircuitDeleted, circuit, nil)
 
 		if strategy, err := network.strategyRegistry.GetStrategy(circuit.Service.TerminatorStrategy); strategy != nil {
@@ -694,6 +749,32 @@ func (network *Network) RemoveCircuit(circuitId string, now bool) error {
 	return InvalidCircuitError{circuitId: circuitId}
 }
 
+// RemoveCircuits removes all circuits matching the filter, returning the ids of the circuits removed. If dryRun is
+// set, the ids of the matching circuits are returned, but the circuits are left in place
+func (network *Network) RemoveCircuits(filter *CircuitFilter, now bool, dryRun bool) []string {
+	var result []string
+	currentTime := time.Now()
+
+	for _, circuit := range network.GetAllCircuits() {
+		if !filter.Matches(circuit, currentTime) {
+			continue
+		}
+
+		if !dryRun {
+			if err := network.RemoveCircuit(circuit.Id, now); err != nil {
+				// the circuit was removed by something else since it was listed
+				pfxlog.Logger().WithField("circuitId", circuit.Id).WithError(err).Debug("unable to remove circuit matched by filter")
+				continue
+			}
+		}
+
+		result = append(result, circuit.Id)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
 func (network *Network) CreatePath(srcR, dstR *Router) (*Path, error) {
 	ingressId, err := network.sequence.NextHash()
 	if err != nil {
@@ -800,6 +881,7 @@ func (network *Network) Run() {
 
 		case <-network.closeNotify:
 			network.eventDispatcher.RemoveMetricsMessageHandler(network)
+			network.eventDispatcher.RemoveUsageEventHandler(network.serviceStats)
 			network.metricsRegistry.DisposeAll()
 			return
 		}
@@ -911,7 +993,7 @@ func (network *Network) rerouteCircuit(circuit *Circuit, deadline time.Time) err
 		if cq, err := network.UpdatePath(circuit.Path); err == nil {
 			circuit.Path = cq
 
-			rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+			rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Service, circuit.Terminator, deadline)
 
 			for i := 0; i < len(cq.Nodes); i++ {
 				if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
@@ -940,7 +1022,7 @@ func (network *Network) smartReroute(circuit *Circuit, cq *Path, deadline time.T
 
 		circuit.Path = cq
 
-		rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Terminator, deadline)
+		rms := cq.CreateRouteMessages(SmartRerouteAttempt, circuit.Id, circuit.Service, circuit.Terminator, deadline)
 
 		for i := 0; i < len(cq.Nodes); i++ {
 			if _, err := sendRoute(cq.Nodes[i], rms[i], network.options.RouteTimeout); err != nil {
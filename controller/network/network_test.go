@@ -4,6 +4,7 @@ import (
 	"github.com/openziti/fabric/controller/command"
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/raft"
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/event"
 	"github.com/openziti/fabric/logcontext"
@@ -61,6 +62,10 @@ func (self *testConfig) GetCommandDispatcher() command.Dispatcher {
 	return nil
 }
 
+func (self *testConfig) GetRaftController() *raft.Controller {
+	return nil
+}
+
 func (self *testConfig) GetDb() boltz.Db {
 	return self.ctx.GetDb()
 }
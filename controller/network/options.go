@@ -36,6 +36,8 @@ const (
 	DefaultNetworkOptionsSmartRerouteCap         = 4
 	DefaultNetworkOptionsInitialLinkLatency      = 65 * time.Second
 	DefaultNetworkOptionsMetricsReportInterval   = time.Minute
+	DefaultNetworkOptionsChangeFeedBufferSize    = 1024
+	DefaultNetworkOptionsServiceStatsWindow      = time.Hour
 )
 
 type Options struct {
@@ -52,6 +54,8 @@ type Options struct {
 	RouterConnectChurnLimit time.Duration
 	InitialLinkLatency      time.Duration
 	MetricsReportInterval   time.Duration
+	ChangeFeedBufferSize    uint32
+	ServiceStatsWindow      time.Duration
 }
 
 func DefaultOptions() *Options {
@@ -65,6 +69,8 @@ func DefaultOptions() *Options {
 		RouterConnectChurnLimit: DefaultNetworkOptionsRouterConnectChurnLimit,
 		InitialLinkLatency:      DefaultNetworkOptionsInitialLinkLatency,
 		MetricsReportInterval:   DefaultNetworkOptionsMetricsReportInterval,
+		ChangeFeedBufferSize:    DefaultNetworkOptionsChangeFeedBufferSize,
+		ServiceStatsWindow:      DefaultNetworkOptionsServiceStatsWindow,
 	}
 	options.Smart.RerouteFraction = DefaultNetworkOptionsSmartRerouteFraction
 	options.Smart.RerouteCap = DefaultNetworkOptionsSmartRerouteCap
@@ -186,5 +192,28 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 		}
 	}
 
+	if value, found := src["changeFeedBufferSize"]; found {
+		if bufferSize, ok := value.(int); ok && bufferSize > 0 && uint64(bufferSize) <= math.MaxUint32 {
+			options.ChangeFeedBufferSize = uint32(bufferSize)
+		} else {
+			return nil, errors.Errorf("invalid value for 'changeFeedBufferSize'. Must be a number between 1 and %v", uint32(math.MaxUint32))
+		}
+	}
+
+	if value, found := src["serviceStatsWindow"]; found {
+		if sval, ok := value.(string); ok {
+			val, err := time.ParseDuration(sval)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid value for 'serviceStatsWindow'")
+			}
+			if val < serviceStatsBucketInterval {
+				return nil, errors.Errorf("invalid value for 'serviceStatsWindow'. Must be at least %v", serviceStatsBucketInterval)
+			}
+			options.ServiceStatsWindow = val
+		} else {
+			return nil, errors.New("invalid value for 'serviceStatsWindow'")
+		}
+	}
+
 	return options, nil
 }
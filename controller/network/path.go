@@ -76,7 +76,7 @@ func (self *Path) EgressRouter() *Router {
 	return nil
 }
 
-func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminator xt.Terminator, deadline time.Time) []*ctrl_pb.Route {
+func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, service *Service, terminator xt.Terminator, deadline time.Time) []*ctrl_pb.Route {
 	var routeMessages []*ctrl_pb.Route
 	remainingTime := deadline.Sub(time.Now())
 	if len(self.Links) == 0 {
@@ -155,9 +155,37 @@ func (self *Path) CreateRouteMessages(attempt uint32, circuitId string, terminat
 			routeMessages = append(routeMessages, routeMessage)
 		}
 	}
+
+	for _, routeMessage := range routeMessages {
+		routeMessage.Priority = service.GetCircuitPriority()
+		routeMessage.ServiceId = service.Id
+		routeMessage.ServiceBandwidthLimit = service.MaxRouterBandwidth
+		routeMessage.CircuitBandwidthLimit = service.MaxCircuitBandwidth
+	}
+
 	return routeMessages
 }
 
+// hasAddresses returns true if every given address is a link or xgress address on the path
+func (self *Path) hasAddresses(addresses []string) bool {
+	for _, address := range addresses {
+		if address == self.IngressId || address == self.EgressId {
+			continue
+		}
+		found := false
+		for _, link := range self.Links {
+			if link.Id == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 func (self *Path) usesLink(l *Link) bool {
 	if self.Links != nil {
 		for _, o := range self.Links {
@@ -25,6 +25,7 @@ import (
 	"github.com/openziti/channel"
 	"github.com/openziti/fabric/controller/db"
 	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/transport/v2"
 	"github.com/openziti/transport/v2/tcp"
 	"github.com/stretchr/testify/assert"
@@ -67,13 +68,23 @@ func TestSimplePath2(t *testing.T) {
 	assert.Equal(t, r1, path.EgressRouter())
 
 	terminator := &Terminator{Address: addr, Binding: "transport"}
-	routeMessages := path.CreateRouteMessages(0, "s0", terminator, time.Now().Add(DefaultNetworkOptionsRouteTimeout))
+	service := &Service{
+		BaseEntity:          models.BaseEntity{Id: "svc"},
+		Priority:            db.ServicePriorityInteractive,
+		MaxRouterBandwidth:  1024 * 1024,
+		MaxCircuitBandwidth: 64 * 1024,
+	}
+	routeMessages := path.CreateRouteMessages(0, "s0", service, terminator, time.Now().Add(DefaultNetworkOptionsRouteTimeout))
 	assert.NotNil(t, routeMessages)
 	assert.Equal(t, 2, len(routeMessages))
 
 	// ingress route message
 	rm0 := routeMessages[0]
 	assert.Equal(t, "s0", rm0.CircuitId)
+	assert.Equal(t, ctrl_pb.CircuitPriority_Interactive, rm0.Priority)
+	assert.Equal(t, "svc", rm0.ServiceId)
+	assert.Equal(t, uint64(1024*1024), rm0.ServiceBandwidthLimit)
+	assert.Equal(t, uint64(64*1024), rm0.CircuitBandwidthLimit)
 	assert.Nil(t, rm0.Egress)
 	assert.Equal(t, 2, len(rm0.Forwards))
 	assert.Equal(t, path.IngressId, rm0.Forwards[0].SrcAddress)
@@ -84,6 +95,8 @@ func TestSimplePath2(t *testing.T) {
 	// egress route message
 	rm1 := routeMessages[1]
 	assert.Equal(t, "s0", rm1.CircuitId)
+	assert.Equal(t, ctrl_pb.CircuitPriority_Interactive, rm1.Priority)
+	assert.Equal(t, uint64(64*1024), rm1.CircuitBandwidthLimit)
 	assert.NotNil(t, rm1.Egress)
 	assert.Equal(t, path.EgressId, rm1.Egress.Address)
 	assert.Equal(t, addr, rm1.Egress.Destination)
@@ -141,7 +154,7 @@ func TestTransitPath2(t *testing.T) {
 	assert.Equal(t, r2, path.EgressRouter())
 
 	terminator := &Terminator{Address: addr, Binding: "transport"}
-	routeMessages := path.CreateRouteMessages(0, "s0", terminator, time.Now().Add(DefaultNetworkOptionsRouteTimeout))
+	routeMessages := path.CreateRouteMessages(0, "s0", &Service{}, terminator, time.Now().Add(DefaultNetworkOptionsRouteTimeout))
 	assert.NotNil(t, routeMessages)
 	assert.Equal(t, 3, len(routeMessages))
 
@@ -185,14 +185,9 @@ func (self *RouterManager) Create(entity *Router) error {
 }
 
 func (self *RouterManager) ApplyCreate(cmd *command.CreateEntityCommand[*Router]) error {
-	router := cmd.Entity
-	err := self.db.Update(func(tx *bbolt.Tx) error {
-		return self.store.Create(boltz.NewMutateContext(tx), router.toBolt())
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		return self.ApplyCreateInTx(boltz.NewMutateContext(tx), cmd)
 	})
-	if err != nil {
-		self.cache.Set(router.Id, router)
-	}
-	return err
 }
 
 func (self *RouterManager) Read(id string) (entity *Router, err error) {
@@ -248,8 +243,50 @@ func (self *RouterManager) Update(entity *Router, updatedFields fields.UpdatedFi
 	return DispatchUpdate[*Router](self, entity, updatedFields)
 }
 
+func (self *RouterManager) ApplyCreateInTx(ctx boltz.MutateContext, cmd *command.CreateEntityCommand[*Router]) error {
+	router := cmd.Entity
+	if err := self.store.Create(ctx, router.toBolt()); err != nil {
+		return err
+	}
+	// only cache the router once it's committed, so a rolled back batch doesn't leave it behind
+	ctx.Tx().OnCommit(func() {
+		self.cache.Set(router.Id, router)
+	})
+	return nil
+}
+
+func (self *RouterManager) UpdateIfVersion(entity *Router, updatedFields fields.UpdatedFields, version string) error {
+	return DispatchUpdateIfVersion[*Router](self, entity, updatedFields, version)
+}
+
 func (self *RouterManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Router]) error {
-	return self.updateGeneral(cmd.Entity, cmd.UpdatedFields)
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		return self.ApplyUpdateInTx(boltz.NewMutateContext(tx), cmd)
+	})
+}
+
+func (self *RouterManager) ApplyUpdateInTx(ctx boltz.MutateContext, cmd *command.UpdateEntityCommand[*Router]) error {
+	if err := cmd.CheckVersion(ctx.Tx()); err != nil {
+		return err
+	}
+	return self.updateGeneralInTx(ctx, cmd.Entity, cmd.UpdatedFields)
+}
+
+func (self *RouterManager) ApplyDelete(cmd *command.DeleteEntityCommand) error {
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		return self.ApplyDeleteInTx(boltz.NewMutateContext(tx), cmd)
+	})
+}
+
+func (self *RouterManager) ApplyDeleteInTx(ctx boltz.MutateContext, cmd *command.DeleteEntityCommand) error {
+	if err := self.store.DeleteById(ctx, cmd.Id); err != nil {
+		return err
+	}
+	// HandleRouterDelete will also clear the cache, but runs asynchronously, from the store listener
+	ctx.Tx().OnCommit(func() {
+		self.cache.Remove(cmd.Id)
+	})
+	return nil
 }
 
 func (self *RouterManager) HandleRouterDelete(id string) {
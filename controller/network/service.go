@@ -23,6 +23,7 @@ import (
 	"github.com/openziti/fabric/controller/fields"
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/fabric/pb/cmd_pb"
+	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/storage/boltz"
 	"github.com/orcaman/concurrent-map/v2"
 	"github.com/pkg/errors"
@@ -33,9 +34,15 @@ import (
 
 type Service struct {
 	models.BaseEntity
-	Name               string
-	TerminatorStrategy string
-	Terminators        []*Terminator
+	Name                 string
+	TerminatorStrategy   string
+	MaxCircuits          uint32
+	MaxCircuitsPerClient uint32
+	MaxDialRate          uint32
+	Priority             string
+	MaxRouterBandwidth   uint64
+	MaxCircuitBandwidth  uint64
+	Terminators          []*Terminator
 }
 
 func (self *Service) GetName() string {
@@ -44,9 +51,35 @@ func (self *Service) GetName() string {
 
 func (entity *Service) toBolt() boltz.Entity {
 	return &db.Service{
-		BaseExtEntity:      *boltz.NewExtEntity(entity.Id, entity.Tags),
-		Name:               entity.Name,
-		TerminatorStrategy: entity.TerminatorStrategy,
+		BaseExtEntity:        *boltz.NewExtEntity(entity.Id, entity.Tags),
+		Name:                 entity.Name,
+		TerminatorStrategy:   entity.TerminatorStrategy,
+		MaxCircuits:          entity.MaxCircuits,
+		MaxCircuitsPerClient: entity.MaxCircuitsPerClient,
+		MaxDialRate:          entity.MaxDialRate,
+		Priority:             entity.Priority,
+		MaxRouterBandwidth:   entity.MaxRouterBandwidth,
+		MaxCircuitBandwidth:  entity.MaxCircuitBandwidth,
+	}
+}
+
+// GetPriority returns the service's priority class, defaulting to standard if none has been set
+func (entity *Service) GetPriority() string {
+	if entity.Priority == "" {
+		return db.ServicePriorityStandard
+	}
+	return entity.Priority
+}
+
+// GetCircuitPriority returns the priority class which routers should use when scheduling the service's payloads
+func (entity *Service) GetCircuitPriority() ctrl_pb.CircuitPriority {
+	switch entity.GetPriority() {
+	case db.ServicePriorityInteractive:
+		return ctrl_pb.CircuitPriority_Interactive
+	case db.ServicePriorityBulk:
+		return ctrl_pb.CircuitPriority_Bulk
+	default:
+		return ctrl_pb.CircuitPriority_Standard
 	}
 }
 
@@ -113,33 +146,62 @@ func (self *ServiceManager) Create(entity *Service) error {
 }
 
 func (self *ServiceManager) ApplyCreate(cmd *command.CreateEntityCommand[*Service]) error {
-	s := cmd.Entity
-	err := self.db.Update(func(tx *bbolt.Tx) error {
-		ctx := boltz.NewMutateContext(tx)
-		if err := self.ValidateNameOnCreate(ctx, s); err != nil {
-			return err
-		}
-		if err := self.store.Create(ctx, s.toBolt()); err != nil {
-			return err
-		}
-		return nil
+	// don't cache, wait for first read. entity may not match data store as data store may have set defaults
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		return self.ApplyCreateInTx(boltz.NewMutateContext(tx), cmd)
 	})
-	if err != nil {
+}
+
+func (self *ServiceManager) ApplyCreateInTx(ctx boltz.MutateContext, cmd *command.CreateEntityCommand[*Service]) error {
+	s := cmd.Entity
+	if err := self.ValidateNameOnCreate(ctx, s); err != nil {
 		return err
 	}
-	// don't cache, wait for first read. entity may not match data store as data store may have set defaults
-	return nil
+	return self.store.Create(ctx, s.toBolt())
 }
 
 func (self *ServiceManager) Update(entity *Service, updatedFields fields.UpdatedFields) error {
 	return DispatchUpdate[*Service](self, entity, updatedFields)
 }
 
+func (self *ServiceManager) UpdateIfVersion(entity *Service, updatedFields fields.UpdatedFields, version string) error {
+	return DispatchUpdateIfVersion[*Service](self, entity, updatedFields, version)
+}
+
 func (self *ServiceManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Service]) error {
-	if err := self.updateGeneral(cmd.Entity, cmd.UpdatedFields); err != nil {
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		return self.ApplyUpdateInTx(boltz.NewMutateContext(tx), cmd)
+	})
+}
+
+func (self *ServiceManager) ApplyUpdateInTx(ctx boltz.MutateContext, cmd *command.UpdateEntityCommand[*Service]) error {
+	if err := cmd.CheckVersion(ctx.Tx()); err != nil {
+		return err
+	}
+	if err := self.updateGeneralInTx(ctx, cmd.Entity, cmd.UpdatedFields); err != nil {
+		return err
+	}
+	// the store listener will also clear the cache, but does so asynchronously
+	ctx.Tx().OnCommit(func() {
+		self.RemoveFromCache(cmd.Entity.Id)
+	})
+	return nil
+}
+
+func (self *ServiceManager) ApplyDelete(cmd *command.DeleteEntityCommand) error {
+	return self.db.Update(func(tx *bbolt.Tx) error {
+		return self.ApplyDeleteInTx(boltz.NewMutateContext(tx), cmd)
+	})
+}
+
+func (self *ServiceManager) ApplyDeleteInTx(ctx boltz.MutateContext, cmd *command.DeleteEntityCommand) error {
+	if err := self.store.DeleteById(ctx, cmd.Id); err != nil {
 		return err
 	}
-	self.RemoveFromCache(cmd.Entity.Id)
+	// the store listener will also clear the cache, but does so asynchronously
+	ctx.Tx().OnCommit(func() {
+		self.RemoveFromCache(cmd.Id)
+	})
 	return nil
 }
 
@@ -184,6 +246,12 @@ func (self *ServiceManager) populateService(entity *Service, tx *bbolt.Tx, boltE
 	}
 	entity.Name = boltService.Name
 	entity.TerminatorStrategy = boltService.TerminatorStrategy
+	entity.MaxCircuits = boltService.MaxCircuits
+	entity.MaxCircuitsPerClient = boltService.MaxCircuitsPerClient
+	entity.MaxDialRate = boltService.MaxDialRate
+	entity.Priority = boltService.Priority
+	entity.MaxRouterBandwidth = boltService.MaxRouterBandwidth
+	entity.MaxCircuitBandwidth = boltService.MaxCircuitBandwidth
 	entity.FillCommon(boltService)
 
 	terminatorIds := self.store.GetRelatedEntitiesIdList(tx, entity.Id, db.EntityTypeTerminators)
@@ -220,10 +288,16 @@ func (self *ServiceManager) Marshall(entity *Service) ([]byte, error) {
 	}
 
 	msg := &cmd_pb.Service{
-		Id:                 entity.Id,
-		Name:               entity.Name,
-		TerminatorStrategy: entity.TerminatorStrategy,
-		Tags:               tags,
+		Id:                   entity.Id,
+		Name:                 entity.Name,
+		TerminatorStrategy:   entity.TerminatorStrategy,
+		Tags:                 tags,
+		MaxCircuits:          entity.MaxCircuits,
+		MaxCircuitsPerClient: entity.MaxCircuitsPerClient,
+		MaxDialRate:          entity.MaxDialRate,
+		Priority:             entity.Priority,
+		MaxRouterBandwidth:   entity.MaxRouterBandwidth,
+		MaxCircuitBandwidth:  entity.MaxCircuitBandwidth,
 	}
 
 	return proto.Marshal(msg)
@@ -240,7 +314,13 @@ func (self *ServiceManager) Unmarshall(bytes []byte) (*Service, error) {
 			Id:   msg.Id,
 			Tags: cmd_pb.DecodeTags(msg.Tags),
 		},
-		Name:               msg.Name,
-		TerminatorStrategy: msg.TerminatorStrategy,
+		Name:                 msg.Name,
+		TerminatorStrategy:   msg.TerminatorStrategy,
+		MaxCircuits:          msg.MaxCircuits,
+		MaxCircuitsPerClient: msg.MaxCircuitsPerClient,
+		MaxDialRate:          msg.MaxDialRate,
+		Priority:             msg.Priority,
+		MaxRouterBandwidth:   msg.MaxRouterBandwidth,
+		MaxCircuitBandwidth:  msg.MaxCircuitBandwidth,
 	}, nil
 }
@@ -15,21 +15,28 @@ type ServiceCounters interface {
 	ServiceTerminatorConnectionRefused(serviceId, terminatorId string)
 	ServiceInvalidTerminator(serviceId, terminatorId string)
 	ServiceMisconfiguredTerminator(serviceId, terminatorId string)
+
+	ServiceCircuitLimitExceeded(serviceId string)
+	ServiceClientCircuitLimitExceeded(serviceId string)
+	ServiceDialRateLimitExceeded(serviceId string)
 }
 
 func (network *Network) ServiceDialSuccess(serviceId, terminatorId string) {
 	combinedId := network.joinIds(serviceId, terminatorId)
 	network.serviceDialSuccessCounter.Update(combinedId, time.Now(), 1)
+	network.serviceStats.terminatorDialSucceeded(serviceId, terminatorId)
 }
 
 func (network *Network) ServiceDialFail(serviceId, terminatorId string) {
 	combinedId := network.joinIds(serviceId, terminatorId)
 	network.serviceDialFailCounter.Update(combinedId, time.Now(), 1)
+	network.serviceStats.terminatorDialFailed(serviceId, terminatorId)
 }
 
 func (network *Network) ServiceDialTimeout(serviceId, terminatorId string) {
 	combinedId := network.joinIds(serviceId, terminatorId)
 	network.serviceDialTimeoutCounter.Update(combinedId, time.Now(), 1)
+	network.serviceStats.terminatorDialFailed(serviceId, terminatorId)
 }
 
 func (network *Network) ServiceDialOtherError(serviceId string) {
@@ -54,6 +61,18 @@ func (network *Network) ServiceMisconfiguredTerminator(serviceId, terminatorId s
 	network.serviceMisconfiguredTerminatorCounter.Update(combinedId, time.Now(), 1)
 }
 
+func (network *Network) ServiceCircuitLimitExceeded(serviceId string) {
+	network.serviceCircuitLimitExceededCounter.Update(serviceId, time.Now(), 1)
+}
+
+func (network *Network) ServiceClientCircuitLimitExceeded(serviceId string) {
+	network.serviceClientCircuitLimitExceededCounter.Update(serviceId, time.Now(), 1)
+}
+
+func (network *Network) ServiceDialRateLimitExceeded(serviceId string) {
+	network.serviceDialRateLimitExceededCounter.Update(serviceId, time.Now(), 1)
+}
+
 func (network *Network) joinIds(serviceId, terminatorId string) string {
 	return fmt.Sprintf("%v:%v", serviceId, terminatorId)
 }
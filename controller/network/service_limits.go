@@ -0,0 +1,165 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sync"
+	"time"
+)
+
+// serviceLimiter tracks circuit counts and dial rates per service, so that the limits configured on a service can be
+// enforced before a path is selected. Counts are reserved when a dial starts and released when either the dial fails
+// or the resulting circuit is removed. Counts are kept for all services, so limits added to a service with existing
+// circuits take effect immediately. A service with no circuits and a full dial rate bucket is indistinguishable from
+// one which has never been dialed, so its state is dropped.
+type serviceLimiter struct {
+	lock     sync.Mutex
+	services map[string]*serviceLimitState
+	counters ServiceCounters
+}
+
+type serviceLimitState struct {
+	circuits       uint32
+	clientCircuits map[string]uint32
+	dialRate       float64
+	tokens         float64
+	lastRefill     time.Time
+	expiryPending  bool
+}
+
+// refilledAt returns when the dial rate bucket will be full again
+func (self *serviceLimitState) refilledAt() time.Time {
+	if self.dialRate <= 0 || self.lastRefill.IsZero() {
+		return time.Time{}
+	}
+	missing := self.dialRate - self.tokens
+	return self.lastRefill.Add(time.Duration(missing / self.dialRate * float64(time.Second)))
+}
+
+func newServiceLimiter(counters ServiceCounters) *serviceLimiter {
+	return &serviceLimiter{
+		services: map[string]*serviceLimitState{},
+		counters: counters,
+	}
+}
+
+func (self *serviceLimiter) acquire(svc *Service, clientId string, now time.Time) CircuitError {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	state := self.getState(svc.Id)
+
+	if svc.MaxCircuits > 0 && state.circuits >= svc.MaxCircuits {
+		self.counters.ServiceCircuitLimitExceeded(svc.Id)
+		return newCircuitErrorf(CircuitFailureServiceLimitExceeded, "service %v has reached its limit of %v circuits", svc.Id, svc.MaxCircuits)
+	}
+
+	if svc.MaxCircuitsPerClient > 0 && state.clientCircuits[clientId] >= svc.MaxCircuitsPerClient {
+		self.counters.ServiceClientCircuitLimitExceeded(svc.Id)
+		return newCircuitErrorf(CircuitFailureServiceLimitExceeded, "client %v has reached the limit of %v circuits for service %v", clientId, svc.MaxCircuitsPerClient, svc.Id)
+	}
+
+	if svc.MaxDialRate > 0 {
+		rate := float64(svc.MaxDialRate)
+		state.dialRate = rate
+		if state.lastRefill.IsZero() {
+			state.tokens = rate
+		} else {
+			state.tokens += now.Sub(state.lastRefill).Seconds() * rate
+			if state.tokens > rate {
+				state.tokens = rate
+			}
+		}
+		state.lastRefill = now
+
+		if state.tokens < 1 {
+			self.counters.ServiceDialRateLimitExceeded(svc.Id)
+			return newCircuitErrorf(CircuitFailureServiceLimitExceeded, "service %v has exceeded its dial rate of %v per second", svc.Id, svc.MaxDialRate)
+		}
+		state.tokens--
+	}
+
+	state.circuits++
+	state.clientCircuits[clientId]++
+	return nil
+}
+
+func (self *serviceLimiter) release(serviceId, clientId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	state, found := self.services[serviceId]
+	if !found {
+		return
+	}
+
+	if count, found := state.clientCircuits[clientId]; found {
+		if count <= 1 {
+			delete(state.clientCircuits, clientId)
+		} else {
+			state.clientCircuits[clientId] = count - 1
+		}
+
+		if state.circuits > 0 {
+			state.circuits--
+		}
+	}
+
+	self.expire(serviceId, state, time.Now())
+}
+
+// expire drops the state for a service once it has no circuits and its dial rate bucket has refilled. If the
+// bucket is still refilling, the check is repeated once it's full. Must be called with the lock held
+func (self *serviceLimiter) expire(serviceId string, state *serviceLimitState, now time.Time) {
+	if state.circuits > 0 || state.expiryPending {
+		return
+	}
+
+	if refilledAt := state.refilledAt(); refilledAt.After(now) {
+		state.expiryPending = true
+		time.AfterFunc(refilledAt.Sub(now), func() {
+			self.lock.Lock()
+			defer self.lock.Unlock()
+			state.expiryPending = false
+			if self.services[serviceId] == state {
+				self.expire(serviceId, state, time.Now())
+			}
+		})
+		return
+	}
+
+	delete(self.services, serviceId)
+}
+
+// remove drops all tracking for a deleted service. Circuits for the service which are still being torn down will
+// find no state to release, which is harmless
+func (self *serviceLimiter) remove(serviceId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.services, serviceId)
+}
+
+func (self *serviceLimiter) getState(serviceId string) *serviceLimitState {
+	state, found := self.services[serviceId]
+	if !found {
+		state = &serviceLimitState{
+			clientCircuits: map[string]uint32{},
+		}
+		self.services[serviceId] = state
+	}
+	return state
+}
@@ -0,0 +1,122 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/models"
+	"github.com/stretchr/testify/require"
+)
+
+type limitCounters struct {
+	ServiceCounters
+	circuitLimit       int
+	clientCircuitLimit int
+	rateLimit          int
+}
+
+func (self *limitCounters) ServiceCircuitLimitExceeded(string) {
+	self.circuitLimit++
+}
+
+func (self *limitCounters) ServiceClientCircuitLimitExceeded(string) {
+	self.clientCircuitLimit++
+}
+
+func (self *limitCounters) ServiceDialRateLimitExceeded(string) {
+	self.rateLimit++
+}
+
+func TestServiceLimiterMaxCircuits(t *testing.T) {
+	req := require.New(t)
+	counters := &limitCounters{}
+	limiter := newServiceLimiter(counters)
+	svc := &Service{BaseEntity: models.BaseEntity{Id: "svc"}, MaxCircuits: 2}
+	now := time.Now()
+
+	req.Nil(limiter.acquire(svc, "c1", now))
+	req.Nil(limiter.acquire(svc, "c2", now))
+
+	err := limiter.acquire(svc, "c3", now)
+	req.NotNil(err)
+	req.Equal(CircuitFailureServiceLimitExceeded, err.Cause())
+	req.Equal(1, counters.circuitLimit)
+
+	limiter.release(svc.Id, "c1")
+	req.Nil(limiter.acquire(svc, "c3", now))
+}
+
+func TestServiceLimiterMaxCircuitsPerClient(t *testing.T) {
+	req := require.New(t)
+	counters := &limitCounters{}
+	limiter := newServiceLimiter(counters)
+	svc := &Service{BaseEntity: models.BaseEntity{Id: "svc"}, MaxCircuitsPerClient: 1}
+	now := time.Now()
+
+	req.Nil(limiter.acquire(svc, "c1", now))
+	req.NotNil(limiter.acquire(svc, "c1", now))
+	req.Nil(limiter.acquire(svc, "c2", now))
+	req.Equal(1, counters.clientCircuitLimit)
+
+	limiter.release(svc.Id, "c1")
+	req.Nil(limiter.acquire(svc, "c1", now))
+}
+
+func TestServiceLimiterDialRate(t *testing.T) {
+	req := require.New(t)
+	counters := &limitCounters{}
+	limiter := newServiceLimiter(counters)
+	svc := &Service{BaseEntity: models.BaseEntity{Id: "svc"}, MaxDialRate: 2}
+	now := time.Now()
+
+	req.Nil(limiter.acquire(svc, "c1", now))
+	req.Nil(limiter.acquire(svc, "c1", now))
+	req.NotNil(limiter.acquire(svc, "c1", now))
+	req.Equal(1, counters.rateLimit)
+
+	now = now.Add(500 * time.Millisecond)
+	req.Nil(limiter.acquire(svc, "c1", now))
+	req.NotNil(limiter.acquire(svc, "c1", now))
+	req.Equal(2, counters.rateLimit)
+}
+
+func TestServiceLimiterExpiresIdleState(t *testing.T) {
+	req := require.New(t)
+	limiter := newServiceLimiter(&limitCounters{})
+	svc := &Service{BaseEntity: models.BaseEntity{Id: "svc"}, MaxDialRate: 20}
+
+	req.Nil(limiter.acquire(svc, "c1", time.Now()))
+	limiter.release(svc.Id, "c1")
+	req.True(limiter.hasState(svc.Id))
+
+	req.Eventually(func() bool {
+		return !limiter.hasState(svc.Id)
+	}, time.Second, 10*time.Millisecond)
+
+	req.Nil(limiter.acquire(svc, "c1", time.Now()))
+	limiter.remove(svc.Id)
+	req.False(limiter.hasState(svc.Id))
+}
+
+func (self *serviceLimiter) hasState(serviceId string) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	_, found := self.services[serviceId]
+	return found
+}
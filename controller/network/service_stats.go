@@ -0,0 +1,314 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openziti/fabric/event"
+)
+
+const (
+	// serviceStatsBucketInterval is the granularity of the rolling window used for service stats
+	serviceStatsBucketInterval = time.Minute
+
+	// serviceStatsClosedCircuitRetention is how long the service for a closed circuit is remembered. Routers report
+	// usage on an interval, so usage for a circuit may arrive after the circuit has been removed
+	serviceStatsClosedCircuitRetention = 5 * time.Minute
+
+	usageIngressRx = "usage.ingress.rx"
+	usageIngressTx = "usage.ingress.tx"
+)
+
+// ServiceStats holds aggregates for a service over the rolling service stats window. As with circuit failed events,
+// failures are counted per circuit creation attempt, so a circuit which is created on the second attempt counts as
+// one failure and one success. Byte counts are as seen by the ingress router, so RxBytes were received from clients
+// and TxBytes were sent to clients.
+type ServiceStats struct {
+	ServiceId       string
+	WindowStart     time.Time
+	WindowEnd       time.Time
+	Dials           uint64
+	DialSuccesses   uint64
+	DialFailures    uint64
+	FailuresByCause map[string]uint64
+	ActiveCircuits  uint64
+	RxBytes         uint64
+	TxBytes         uint64
+	Terminators     []*ServiceTerminatorStats
+}
+
+// ServiceTerminatorStats holds dial attempt aggregates and the active circuit count for a single terminator
+type ServiceTerminatorStats struct {
+	TerminatorId   string
+	DialSuccesses  uint64
+	DialFailures   uint64
+	ActiveCircuits uint64
+}
+
+type serviceStatsCounts struct {
+	dialSuccesses   uint64
+	dialFailures    uint64
+	failuresByCause map[string]uint64
+	rxBytes         uint64
+	txBytes         uint64
+	terminators     map[string]*serviceTerminatorStatsCounts
+}
+
+func (self *serviceStatsCounts) getTerminator(terminatorId string) *serviceTerminatorStatsCounts {
+	result, found := self.terminators[terminatorId]
+	if !found {
+		result = &serviceTerminatorStatsCounts{}
+		self.terminators[terminatorId] = result
+	}
+	return result
+}
+
+type serviceTerminatorStatsCounts struct {
+	dialSuccesses uint64
+	dialFailures  uint64
+}
+
+type serviceStatsBucket struct {
+	start    time.Time
+	services map[string]*serviceStatsCounts
+}
+
+type serviceStatsCircuit struct {
+	serviceId string
+	closedAt  *time.Time
+}
+
+// serviceStatsTracker maintains per-service counts in one minute buckets, covering the configured window
+type serviceStatsTracker struct {
+	lock     sync.Mutex
+	window   time.Duration
+	buckets  map[int64]*serviceStatsBucket
+	circuits map[string]*serviceStatsCircuit
+}
+
+func newServiceStatsTracker(window time.Duration) *serviceStatsTracker {
+	return &serviceStatsTracker{
+		window:   window,
+		buckets:  map[int64]*serviceStatsBucket{},
+		circuits: map[string]*serviceStatsCircuit{},
+	}
+}
+
+func (self *serviceStatsTracker) getCounts(serviceId string, t time.Time) *serviceStatsCounts {
+	now := time.Now()
+	self.prune(now)
+
+	start := t.Truncate(serviceStatsBucketInterval)
+	if start.Before(self.windowStart(now)) {
+		return nil
+	}
+
+	bucket, found := self.buckets[start.Unix()]
+	if !found {
+		bucket = &serviceStatsBucket{
+			start:    start,
+			services: map[string]*serviceStatsCounts{},
+		}
+		self.buckets[start.Unix()] = bucket
+	}
+
+	counts, found := bucket.services[serviceId]
+	if !found {
+		counts = &serviceStatsCounts{
+			failuresByCause: map[string]uint64{},
+			terminators:     map[string]*serviceTerminatorStatsCounts{},
+		}
+		bucket.services[serviceId] = counts
+	}
+	return counts
+}
+
+func (self *serviceStatsTracker) windowStart(now time.Time) time.Time {
+	return now.Add(-self.window).Truncate(serviceStatsBucketInterval)
+}
+
+func (self *serviceStatsTracker) prune(now time.Time) {
+	windowStart := self.windowStart(now)
+	for key, bucket := range self.buckets {
+		if bucket.start.Before(windowStart) {
+			delete(self.buckets, key)
+		}
+	}
+
+	for circuitId, circuit := range self.circuits {
+		if circuit.closedAt != nil && now.Sub(*circuit.closedAt) > serviceStatsClosedCircuitRetention {
+			delete(self.circuits, circuitId)
+		}
+	}
+}
+
+func (self *serviceStatsTracker) circuitCreated(circuitId, serviceId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.circuits[circuitId] = &serviceStatsCircuit{serviceId: serviceId}
+	if counts := self.getCounts(serviceId, time.Now()); counts != nil {
+		counts.dialSuccesses++
+	}
+}
+
+func (self *serviceStatsTracker) circuitRemoved(circuitId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if circuit, found := self.circuits[circuitId]; found && circuit.closedAt == nil {
+		now := time.Now()
+		circuit.closedAt = &now
+	}
+}
+
+func (self *serviceStatsTracker) circuitFailed(serviceId string, cause CircuitFailureCause) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if counts := self.getCounts(serviceId, time.Now()); counts != nil {
+		counts.dialFailures++
+		counts.failuresByCause[string(cause)]++
+	}
+}
+
+func (self *serviceStatsTracker) terminatorDialSucceeded(serviceId, terminatorId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if counts := self.getCounts(serviceId, time.Now()); counts != nil {
+		counts.getTerminator(terminatorId).dialSuccesses++
+	}
+}
+
+func (self *serviceStatsTracker) terminatorDialFailed(serviceId, terminatorId string) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if counts := self.getCounts(serviceId, time.Now()); counts != nil {
+		counts.getTerminator(terminatorId).dialFailures++
+	}
+}
+
+func (self *serviceStatsTracker) AcceptUsageEvent(evt *event.UsageEvent) {
+	if evt.EventType != usageIngressRx && evt.EventType != usageIngressTx {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	circuit, found := self.circuits[evt.CircuitId]
+	if !found {
+		return
+	}
+
+	if counts := self.getCounts(circuit.serviceId, time.Unix(evt.IntervalStartUTC, 0)); counts != nil {
+		if evt.EventType == usageIngressRx {
+			counts.rxBytes += evt.Usage
+		} else {
+			counts.txBytes += evt.Usage
+		}
+	}
+}
+
+// getStats returns the aggregated counts for each of the given services over the current window, along with the
+// terminator counts for each service, keyed by terminator id
+func (self *serviceStatsTracker) getStats(serviceIds []string) (map[string]*ServiceStats, map[string]map[string]*ServiceTerminatorStats) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := time.Now()
+	self.prune(now)
+
+	result := map[string]*ServiceStats{}
+	terminators := map[string]map[string]*ServiceTerminatorStats{}
+	for _, serviceId := range serviceIds {
+		result[serviceId] = &ServiceStats{
+			ServiceId:       serviceId,
+			WindowStart:     self.windowStart(now),
+			WindowEnd:       now,
+			FailuresByCause: map[string]uint64{},
+		}
+		terminators[serviceId] = map[string]*ServiceTerminatorStats{}
+	}
+
+	for _, bucket := range self.buckets {
+		for serviceId, counts := range bucket.services {
+			stats, found := result[serviceId]
+			if !found {
+				continue
+			}
+			stats.DialSuccesses += counts.dialSuccesses
+			stats.DialFailures += counts.dialFailures
+			stats.Dials += counts.dialSuccesses + counts.dialFailures
+			stats.RxBytes += counts.rxBytes
+			stats.TxBytes += counts.txBytes
+			for cause, count := range counts.failuresByCause {
+				stats.FailuresByCause[cause] += count
+			}
+			for terminatorId, terminatorCounts := range counts.terminators {
+				terminatorStats := getServiceTerminatorStats(terminators[serviceId], terminatorId)
+				terminatorStats.DialSuccesses += terminatorCounts.dialSuccesses
+				terminatorStats.DialFailures += terminatorCounts.dialFailures
+			}
+		}
+	}
+
+	return result, terminators
+}
+
+func getServiceTerminatorStats(m map[string]*ServiceTerminatorStats, terminatorId string) *ServiceTerminatorStats {
+	result, found := m[terminatorId]
+	if !found {
+		result = &ServiceTerminatorStats{TerminatorId: terminatorId}
+		m[terminatorId] = result
+	}
+	return result
+}
+
+// GetServiceStats returns the rolling stats for each of the given services, in the order given
+func (network *Network) GetServiceStats(serviceIds ...string) []*ServiceStats {
+	statsMap, terminators := network.serviceStats.getStats(serviceIds)
+
+	for _, circuit := range network.GetAllCircuits() {
+		stats, found := statsMap[circuit.Service.Id]
+		if !found {
+			continue
+		}
+		stats.ActiveCircuits++
+		if circuit.Terminator != nil {
+			getServiceTerminatorStats(terminators[circuit.Service.Id], circuit.Terminator.GetId()).ActiveCircuits++
+		}
+	}
+
+	result := make([]*ServiceStats, 0, len(serviceIds))
+	for _, serviceId := range serviceIds {
+		stats := statsMap[serviceId]
+		for _, terminatorStats := range terminators[serviceId] {
+			stats.Terminators = append(stats.Terminators, terminatorStats)
+		}
+		sort.Slice(stats.Terminators, func(i, j int) bool {
+			return stats.Terminators[i].TerminatorId < stats.Terminators[j].TerminatorId
+		})
+		result = append(result, stats)
+	}
+	return result
+}
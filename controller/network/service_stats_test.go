@@ -0,0 +1,119 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceStats(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	svc1 := &Service{BaseEntity: models.BaseEntity{Id: "svc1"}}
+	svc2 := &Service{BaseEntity: models.BaseEntity{Id: "svc2"}}
+
+	newCircuit := func(id string, service *Service, terminatorId string) *Circuit {
+		circuit := &Circuit{
+			Id:         id,
+			Service:    service,
+			Terminator: &RoutingTerminator{Terminator: &Terminator{BaseEntity: models.BaseEntity{Id: terminatorId}}},
+			Path:       &Path{},
+			CreatedAt:  time.Now(),
+		}
+		n.circuitController.add(circuit)
+		n.CircuitEvent(event.CircuitCreated, circuit, nil)
+		return circuit
+	}
+
+	usage := func(circuitId, usageType string, intervalStart time.Time, bytes uint64) {
+		n.serviceStats.AcceptUsageEvent(&event.UsageEvent{
+			EventType:        usageType,
+			CircuitId:        circuitId,
+			Usage:            bytes,
+			IntervalStartUTC: intervalStart.Unix(),
+			IntervalLength:   60,
+		})
+	}
+
+	n.ServiceDialSuccess(svc1.Id, "t1")
+	newCircuit("c1", svc1, "t1")
+	n.ServiceDialSuccess(svc1.Id, "t2")
+	c2 := newCircuit("c2", svc1, "t2")
+	n.ServiceDialFail(svc1.Id, "t1")
+	n.CircuitFailedEvent("c3", "client", svc1.Id, "", time.Now(), nil, nil, CircuitFailureRouterErrDialConnRefused)
+	n.CircuitFailedEvent("c4", "client", svc1.Id, "", time.Now(), nil, nil, CircuitFailureNoTerminators)
+	n.CircuitFailedEvent("c5", "client", svc1.Id, "", time.Now(), nil, nil, CircuitFailureNoTerminators)
+	newCircuit("c6", svc2, "t3")
+
+	usage("c1", usageIngressRx, time.Now(), 100)
+	usage("c1", usageIngressTx, time.Now(), 1000)
+	usage("c1", "usage.egress.rx", time.Now(), 50)
+
+	// usage reported after a circuit is removed should still be attributed to the service
+	n.circuitController.remove(c2)
+	n.CircuitEvent(event.CircuitDeleted, c2, nil)
+	usage("c2", usageIngressRx, time.Now(), 10)
+
+	// usage outside the window and for unknown circuits should be ignored
+	usage("c1", usageIngressRx, time.Now().Add(-2*config.options.ServiceStatsWindow), 5000)
+	usage("unknown", usageIngressRx, time.Now(), 5000)
+
+	stats := n.GetServiceStats(svc1.Id, svc2.Id, "svc3")
+	req.Len(stats, 3)
+
+	svc1Stats := stats[0]
+	req.Equal(svc1.Id, svc1Stats.ServiceId)
+	req.Equal(uint64(5), svc1Stats.Dials)
+	req.Equal(uint64(2), svc1Stats.DialSuccesses)
+	req.Equal(uint64(3), svc1Stats.DialFailures)
+	req.Equal(map[string]uint64{
+		string(CircuitFailureRouterErrDialConnRefused): 1,
+		string(CircuitFailureNoTerminators):            2,
+	}, svc1Stats.FailuresByCause)
+	req.Equal(uint64(1), svc1Stats.ActiveCircuits)
+	req.Equal(uint64(110), svc1Stats.RxBytes)
+	req.Equal(uint64(1000), svc1Stats.TxBytes)
+	req.Equal([]*ServiceTerminatorStats{
+		{TerminatorId: "t1", DialSuccesses: 1, DialFailures: 1, ActiveCircuits: 1},
+		{TerminatorId: "t2", DialSuccesses: 1},
+	}, svc1Stats.Terminators)
+
+	svc2Stats := stats[1]
+	req.Equal(uint64(1), svc2Stats.Dials)
+	req.Equal(uint64(1), svc2Stats.ActiveCircuits)
+	req.Equal([]*ServiceTerminatorStats{{TerminatorId: "t3", ActiveCircuits: 1}}, svc2Stats.Terminators)
+
+	svc3Stats := stats[2]
+	req.Equal("svc3", svc3Stats.ServiceId)
+	req.Equal(uint64(0), svc3Stats.Dials)
+	req.Empty(svc3Stats.Terminators)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/openziti/fabric/controller/models"
 	"github.com/openziti/fabric/controller/xt"
 	"github.com/openziti/fabric/pb/cmd_pb"
+	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/storage/boltz"
 	"github.com/pkg/errors"
 	"go.etcd.io/bbolt"
@@ -41,6 +42,7 @@ type Terminator struct {
 	Precedence     xt.Precedence
 	PeerData       map[uint32][]byte
 	HostId         string
+	Weight         uint16
 }
 
 func (entity *Terminator) GetServiceId() string {
@@ -71,6 +73,10 @@ func (entity *Terminator) GetCost() uint16 {
 	return entity.Cost
 }
 
+func (entity *Terminator) GetWeight() uint16 {
+	return entity.Weight
+}
+
 func (entity *Terminator) GetPrecedence() xt.Precedence {
 	return entity.Precedence
 }
@@ -96,6 +102,7 @@ func (entity *Terminator) toBolt() *db.Terminator {
 		Precedence:     precedence,
 		PeerData:       entity.PeerData,
 		HostId:         entity.HostId,
+		Weight:         entity.Weight,
 	}
 }
 
@@ -132,19 +139,23 @@ func (self *TerminatorManager) Create(entity *Terminator) error {
 
 func (self *TerminatorManager) ApplyCreate(cmd *command.CreateEntityCommand[*Terminator]) error {
 	return self.db.Update(func(tx *bbolt.Tx) error {
-		self.checkBinding(cmd.Entity)
-		boltTerminator := cmd.Entity.toBolt()
-		err := self.GetStore().Create(boltz.NewMutateContext(tx), boltTerminator)
-		if err != nil {
-			return err
-		}
-		if cmd.PostCreateHook != nil {
-			return cmd.PostCreateHook(tx, cmd.Entity)
-		}
-		return nil
+		return self.ApplyCreateInTx(boltz.NewMutateContext(tx), cmd)
 	})
 }
 
+func (self *TerminatorManager) ApplyCreateInTx(ctx boltz.MutateContext, cmd *command.CreateEntityCommand[*Terminator]) error {
+	self.checkBinding(cmd.Entity)
+	boltTerminator := cmd.Entity.toBolt()
+	err := self.GetStore().Create(ctx, boltTerminator)
+	if err != nil {
+		return err
+	}
+	if cmd.PostCreateHook != nil {
+		return cmd.PostCreateHook(ctx.Tx(), cmd.Entity)
+	}
+	return nil
+}
+
 func (self *TerminatorManager) checkBinding(terminator *Terminator) {
 	if terminator.Binding == "" {
 		if strings.HasPrefix(terminator.Address, "udp:") {
@@ -177,14 +188,30 @@ func (self *TerminatorManager) Update(entity *Terminator, updatedFields fields.U
 	return DispatchUpdate[*Terminator](self, entity, updatedFields)
 }
 
+func (self *TerminatorManager) UpdateIfVersion(entity *Terminator, updatedFields fields.UpdatedFields, version string) error {
+	return DispatchUpdateIfVersion[*Terminator](self, entity, updatedFields, version)
+}
+
 func (self *TerminatorManager) ApplyUpdate(cmd *command.UpdateEntityCommand[*Terminator]) error {
-	terminator := cmd.Entity
 	return self.db.Update(func(tx *bbolt.Tx) error {
-		self.checkBinding(terminator)
-		return self.GetStore().Update(boltz.NewMutateContext(tx), terminator.toBolt(), cmd.UpdatedFields)
+		return self.ApplyUpdateInTx(boltz.NewMutateContext(tx), cmd)
 	})
 }
 
+func (self *TerminatorManager) ApplyUpdateInTx(ctx boltz.MutateContext, cmd *command.UpdateEntityCommand[*Terminator]) error {
+	if err := cmd.CheckVersion(ctx.Tx()); err != nil {
+		return err
+	}
+	terminator := cmd.Entity
+	if cmd.UpdatedFields == nil || cmd.UpdatedFields.IsUpdated(db.FieldTerminatorWeight) {
+		if err := xt.ValidateWeight(uint32(terminator.Weight)); err != nil {
+			return errorz.NewFieldError(err.Error(), db.FieldTerminatorWeight, terminator.Weight)
+		}
+	}
+	self.checkBinding(terminator)
+	return self.GetStore().Update(ctx, terminator.toBolt(), cmd.UpdatedFields)
+}
+
 func (self *TerminatorManager) Read(id string) (entity *Terminator, err error) {
 	err = self.db.View(func(tx *bbolt.Tx) error {
 		entity, err = self.readInTx(tx, id)
@@ -228,6 +255,7 @@ func (self *TerminatorManager) populateTerminator(entity *Terminator, _ *bbolt.T
 	entity.Cost = boltTerminator.Cost
 	entity.Precedence = xt.GetPrecedenceForName(boltTerminator.Precedence)
 	entity.HostId = boltTerminator.HostId
+	entity.Weight = boltTerminator.Weight
 	entity.FillCommon(boltTerminator)
 	return nil
 }
@@ -260,6 +288,7 @@ func (self *TerminatorManager) Marshall(entity *Terminator) ([]byte, error) {
 		PeerData:       entity.PeerData,
 		Tags:           tags,
 		HostId:         entity.HostId,
+		Weight:         uint32(entity.Weight),
 	}
 
 	return proto.Marshal(msg)
@@ -293,6 +322,7 @@ func (self *TerminatorManager) Unmarshall(bytes []byte) (*Terminator, error) {
 		Precedence:     precedence,
 		PeerData:       msg.PeerData,
 		HostId:         msg.HostId,
+		Weight:         uint16(msg.Weight),
 	}
 
 	return result, nil
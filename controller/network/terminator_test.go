@@ -0,0 +1,70 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/fields"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminatorWeight(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	req.NoError(n.Services.Create(&Service{BaseEntity: models.BaseEntity{Id: "svc1"}, Name: "one", TerminatorStrategy: "smartrouting"}))
+	req.NoError(n.Routers.Create(&Router{BaseEntity: models.BaseEntity{Id: "r1"}, Name: "router-one"}))
+
+	// no weight given
+	req.NoError(n.Terminators.Create(&Terminator{
+		BaseEntity: models.BaseEntity{Id: "t1"},
+		Service:    "svc1",
+		Router:     "r1",
+		Address:    "tcp:localhost:1234",
+	}))
+
+	terminator, err := n.Terminators.Read("t1")
+	req.NoError(err)
+	req.Equal(uint16(xt.DefaultWeight), terminator.Weight)
+
+	checker := fields.UpdatedFieldsMap{db.FieldTerminatorWeight: struct{}{}}
+
+	terminator.Weight = 0
+	req.Error(n.Terminators.Update(terminator, checker))
+
+	terminator.Weight = 80
+	req.NoError(n.Terminators.Update(terminator, checker))
+
+	terminator, err = n.Terminators.Read("t1")
+	req.NoError(err)
+	req.Equal(uint16(80), terminator.Weight)
+
+	req.NoError(xt.ValidateWeight(xt.MaxWeight))
+	req.Error(xt.ValidateWeight(xt.MaxWeight + 1))
+}
@@ -0,0 +1,154 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Topology is a snapshot of the connected routers and the links between them, laid out as a node-link graph
+type Topology struct {
+	Directed bool            `json:"directed"`
+	Nodes    []*TopologyNode `json:"nodes"`
+	Links    []*TopologyLink `json:"links"`
+}
+
+// TopologyNode is a connected router
+type TopologyNode struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Cost        uint16 `json:"cost"`
+	NoTraversal bool   `json:"noTraversal"`
+}
+
+// TopologyLink is a link between two routers. Source is the dialing router and Target is the dialed router.
+// CircuitCount is only set if circuit counts were requested
+type TopologyLink struct {
+	Id            string  `json:"id"`
+	Source        string  `json:"source"`
+	Target        string  `json:"target"`
+	Protocol      string  `json:"protocol"`
+	State         string  `json:"state"`
+	Down          bool    `json:"down"`
+	Cost          int64   `json:"cost"`
+	StaticCost    int32   `json:"staticCost"`
+	SourceLatency int64   `json:"sourceLatency"`
+	TargetLatency int64   `json:"targetLatency"`
+	CircuitCount  *uint64 `json:"circuitCount,omitempty"`
+}
+
+// GetTopology returns the connected routers and the known links between them. If includeCircuitCounts is set, each
+// link will include the number of circuits currently routed over it
+func (network *Network) GetTopology(includeCircuitCounts bool) *Topology {
+	result := &Topology{
+		Directed: true,
+		Nodes:    []*TopologyNode{},
+		Links:    []*TopologyLink{},
+	}
+
+	for _, router := range network.Routers.allConnected() {
+		result.Nodes = append(result.Nodes, &TopologyNode{
+			Id:          router.Id,
+			Name:        router.Name,
+			Cost:        router.Cost,
+			NoTraversal: router.NoTraversal,
+		})
+	}
+
+	var circuitCounts map[string]uint64
+	if includeCircuitCounts {
+		circuitCounts = map[string]uint64{}
+		for _, circuit := range network.circuitController.all() {
+			if circuit.Path != nil {
+				for _, link := range circuit.Path.Links {
+					circuitCounts[link.Id]++
+				}
+			}
+		}
+	}
+
+	for _, link := range network.linkController.all() {
+		state := ""
+		if linkState := link.CurrentState(); linkState != nil {
+			state = linkState.Mode.String()
+		}
+
+		topologyLink := &TopologyLink{
+			Id:            link.Id,
+			Source:        link.Src.Id,
+			Target:        link.Dst.Id,
+			Protocol:      link.Protocol,
+			State:         state,
+			Down:          link.IsDown(),
+			Cost:          link.GetCost(),
+			StaticCost:    link.GetStaticCost(),
+			SourceLatency: link.GetSrcLatency(),
+			TargetLatency: link.GetDstLatency(),
+		}
+
+		if includeCircuitCounts {
+			count := circuitCounts[link.Id]
+			topologyLink.CircuitCount = &count
+		}
+
+		result.Links = append(result.Links, topologyLink)
+	}
+
+	sort.Slice(result.Nodes, func(i, j int) bool {
+		return result.Nodes[i].Id < result.Nodes[j].Id
+	})
+
+	sort.Slice(result.Links, func(i, j int) bool {
+		return result.Links[i].Id < result.Links[j].Id
+	})
+
+	return result
+}
+
+// ToDot renders the topology in the Graphviz DOT language. Links which aren't usable are drawn dashed
+func (self *Topology) ToDot() string {
+	builder := &strings.Builder{}
+	builder.WriteString("digraph topology {\n")
+
+	for _, node := range self.Nodes {
+		_, _ = fmt.Fprintf(builder, "  %s [label=%s];\n", dotQuote(node.Id), dotQuote(node.Name))
+	}
+
+	for _, link := range self.Links {
+		label := fmt.Sprintf("%s\\ncost=%d", link.Id, link.Cost)
+		if link.CircuitCount != nil {
+			label += fmt.Sprintf("\\ncircuits=%d", *link.CircuitCount)
+		}
+
+		style := "solid"
+		if link.Down || link.State != Connected.String() {
+			style = "dashed"
+		}
+
+		_, _ = fmt.Fprintf(builder, "  %s -> %s [label=\"%s\", style=%s];\n",
+			dotQuote(link.Source), dotQuote(link.Target), strings.ReplaceAll(label, `"`, `\"`), style)
+	}
+
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,87 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package network
+
+import (
+	"testing"
+
+	"github.com/openziti/fabric/controller/db"
+	"github.com/openziti/fabric/controller/models"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopology(t *testing.T) {
+	ctx := db.NewTestContext(t)
+	defer ctx.Cleanup()
+
+	req := require.New(t)
+
+	config := newTestConfig(ctx)
+	defer close(config.closeNotify)
+
+	n, err := NewNetwork(config)
+	req.NoError(err)
+
+	transportAddr, err := tcp.AddressParser{}.Parse("tcp:0.0.0.0:0")
+	req.NoError(err)
+
+	r0 := newRouterForTest("r0", "", transportAddr, nil, 0, false)
+	r0.Name = "router-0"
+	n.Routers.markConnected(r0)
+
+	r1 := newRouterForTest("r1", "", transportAddr, nil, 0, false)
+	r1.Name = "router-1"
+	n.Routers.markConnected(r1)
+
+	l0 := newTestLink("l0", "tls")
+	l0.Src = r0
+	l0.Dst = r1
+	l0.addState(newLinkState(Connected))
+	n.linkController.add(l0)
+
+	l1 := newTestLink("l1", "tls")
+	l1.Src = r1
+	l1.Dst = r0
+	n.linkController.add(l1)
+
+	n.circuitController.add(&Circuit{
+		Id:      "c0",
+		Service: &Service{BaseEntity: models.BaseEntity{Id: "svc"}},
+		Path:    &Path{Nodes: []*Router{r0, r1}, Links: []*Link{l0}},
+	})
+
+	topology := n.GetTopology(false)
+	req.Len(topology.Nodes, 2)
+	req.Equal(&TopologyNode{Id: "r0", Name: "router-0"}, topology.Nodes[0])
+	req.Len(topology.Links, 2)
+	req.Equal("l0", topology.Links[0].Id)
+	req.Equal("r0", topology.Links[0].Source)
+	req.Equal("r1", topology.Links[0].Target)
+	req.Equal(Connected.String(), topology.Links[0].State)
+	req.Equal(Pending.String(), topology.Links[1].State)
+	req.Nil(topology.Links[0].CircuitCount)
+
+	topology = n.GetTopology(true)
+	req.Equal(uint64(1), *topology.Links[0].CircuitCount)
+	req.Equal(uint64(0), *topology.Links[1].CircuitCount)
+
+	dot := topology.ToDot()
+	req.Contains(dot, `"r0" [label="router-0"];`)
+	req.Contains(dot, `"r0" -> "r1" [label="l0\ncost=1\ncircuits=1", style=solid];`)
+	req.Contains(dot, `"r1" -> "r0" [label="l1\ncost=1\ncircuits=0", style=dashed];`)
+}
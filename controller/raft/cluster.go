@@ -0,0 +1,76 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package raft
+
+import (
+	"strconv"
+)
+
+// SnapshotStatus describes the most recent raft snapshot
+type SnapshotStatus struct {
+	LastIndex uint64
+	LastTerm  uint64
+}
+
+// ClusterStatus describes the raft state of the local node and of the cluster as seen from the local node
+type ClusterStatus struct {
+	Id           string
+	Addr         string
+	State        string
+	Term         uint64
+	LeaderId     string
+	LeaderAddr   string
+	CommitIndex  uint64
+	AppliedIndex uint64
+	LastLogIndex uint64
+	Snapshot     SnapshotStatus
+	Members      []*Member
+}
+
+// GetClusterStatus returns the raft state of the local node, along with the current cluster membership
+func (self *Controller) GetClusterStatus() (*ClusterStatus, error) {
+	members, err := self.ListMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	r := self.GetRaft()
+	stats := r.Stats()
+	leaderAddr, leaderId := r.LeaderWithID()
+
+	return &ClusterStatus{
+		Id:           self.tempId,
+		Addr:         self.Config.AdvertiseAddress,
+		State:        r.State().String(),
+		Term:         statAsUint64(stats, "term"),
+		LeaderId:     string(leaderId),
+		LeaderAddr:   string(leaderAddr),
+		CommitIndex:  statAsUint64(stats, "commit_index"),
+		AppliedIndex: r.AppliedIndex(),
+		LastLogIndex: r.LastIndex(),
+		Snapshot: SnapshotStatus{
+			LastIndex: statAsUint64(stats, "last_snapshot_index"),
+			LastTerm:  statAsUint64(stats, "last_snapshot_term"),
+		},
+		Members: members,
+	}, nil
+}
+
+func statAsUint64(stats map[string]string, key string) uint64 {
+	val, _ := strconv.ParseUint(stats[key], 10, 64)
+	return val
+}
@@ -0,0 +1,104 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package raft
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+)
+
+func newSingleNodeTestController(t *testing.T) *Controller {
+	req := require.New(t)
+
+	conf := raft.DefaultConfig()
+	conf.LocalID = "ctrl1"
+	conf.HeartbeatTimeout = 50 * time.Millisecond
+	conf.ElectionTimeout = 50 * time.Millisecond
+	conf.LeaderLeaseTimeout = 50 * time.Millisecond
+	conf.CommitTimeout = 5 * time.Millisecond
+	conf.Logger = hclog.NewNullLogger()
+
+	addr, transport := raft.NewInmemTransport("")
+	store := raft.NewInmemStore()
+
+	r, err := raft.NewRaft(conf, &raft.MockFSM{}, store, store, raft.NewInmemSnapshotStore(), transport)
+	req.NoError(err)
+	t.Cleanup(func() {
+		_ = r.Shutdown().Error()
+	})
+
+	req.NoError(r.BootstrapCluster(raft.Configuration{
+		Servers: []raft.Server{{ID: conf.LocalID, Address: addr, Suffrage: raft.Voter}},
+	}).Error())
+
+	select {
+	case isLeader := <-r.LeaderCh():
+		req.True(isLeader)
+	case <-time.After(5 * time.Second):
+		req.FailNow("timed out waiting for leadership")
+	}
+
+	return &Controller{
+		tempId: string(conf.LocalID),
+		Config: &Config{AdvertiseAddress: string(addr)},
+		Raft:   r,
+	}
+}
+
+func TestListMembers(t *testing.T) {
+	req := require.New(t)
+	ctrl := newSingleNodeTestController(t)
+
+	members, err := ctrl.ListMembers()
+	req.NoError(err)
+	req.Len(members, 1)
+
+	member := members[0]
+	req.Equal("ctrl1", member.Id)
+	req.Equal(ctrl.Config.AdvertiseAddress, member.Addr)
+	req.True(member.Voter)
+	req.True(member.Leader)
+	req.True(member.Connected)
+	req.False(member.LastContact.IsZero())
+}
+
+func TestGetClusterStatus(t *testing.T) {
+	req := require.New(t)
+	ctrl := newSingleNodeTestController(t)
+
+	// make sure there's something in the log past the bootstrap configuration
+	req.NoError(ctrl.Raft.Barrier(5 * time.Second).Error())
+
+	status, err := ctrl.GetClusterStatus()
+	req.NoError(err)
+
+	req.Equal("ctrl1", status.Id)
+	req.Equal(ctrl.Config.AdvertiseAddress, status.Addr)
+	req.Equal(raft.Leader.String(), status.State)
+	req.Equal("ctrl1", status.LeaderId)
+	req.Equal(ctrl.Config.AdvertiseAddress, status.LeaderAddr)
+	req.True(status.Term > 0)
+	req.True(status.LastLogIndex > 0)
+	req.Equal(status.LastLogIndex, status.CommitIndex)
+	req.Equal(status.LastLogIndex, status.AppliedIndex)
+	req.Len(status.Members, 1)
+	req.True(status.Members[0].Leader)
+}
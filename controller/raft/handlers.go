@@ -23,6 +23,8 @@ const (
 	JoinRequestType     = 2053
 	RemoveRequestType   = 2054
 
+	TransferLeadershipRequestType = 2055
+
 	HeaderErrorCode = 1000
 
 	ErrorCodeBadMessage = 1
@@ -119,6 +121,46 @@ func (self *removeHandler) HandleReceive(m *channel.Message, ch channel.Channel)
 	}()
 }
 
+func NewTransferLeadershipHandler(controller *Controller) channel.TypedReceiveHandler {
+	return &transferLeadershipHandler{
+		controller: controller,
+	}
+}
+
+type transferLeadershipHandler struct {
+	controller *Controller
+}
+
+func (self *transferLeadershipHandler) ContentType() int32 {
+	return TransferLeadershipRequestType
+}
+
+func (self *transferLeadershipHandler) HandleReceive(m *channel.Message, ch channel.Channel) {
+	go func() {
+		req := &TransferLeadershipRequest{}
+		err := req.Decode(m)
+
+		if err != nil {
+			logrus.WithError(err).Error("error decoding transfer leadership request")
+			sendErrorResponse(m, ch, err, ErrorCodeBadMessage)
+			return
+		}
+
+		logrus.Infof("received transfer leadership request id: %v", req.Id)
+
+		err = self.controller.HandleTransferLeadership(req)
+		if err != nil {
+			if errors.Is(err, raft.ErrNotLeader) {
+				sendErrorResponse(m, ch, err, ErrorCodeNotLeader)
+			} else {
+				sendErrorResponse(m, ch, err, ErrorCodeGeneric)
+			}
+		} else {
+			sendSuccessResponse(m, ch)
+		}
+	}()
+}
+
 func NewCommandHandler(controller *Controller) channel.TypedReceiveHandler {
 	poolConfig := goroutines.PoolConfig{
 		QueueSize:   uint32(controller.Config.CommandHandlerOptions.MaxQueueSize),
@@ -250,3 +292,27 @@ func (self *RemoveRequest) Decode(msg *channel.Message) error {
 	decoder := gob.NewDecoder(buf)
 	return decoder.Decode(self)
 }
+
+// TransferLeadershipRequest asks the leader to hand leadership to the node with the given id. If the id is
+// blank, raft will pick the most up to date voter
+type TransferLeadershipRequest struct {
+	Id string
+}
+
+func (self *TransferLeadershipRequest) Encode() (*channel.Message, error) {
+	buf := &bytes.Buffer{}
+
+	encoder := gob.NewEncoder(buf)
+	err := encoder.Encode(self)
+	if err != nil {
+		return nil, err
+	}
+
+	return channel.NewMessage(TransferLeadershipRequestType, buf.Bytes()), nil
+}
+
+func (self *TransferLeadershipRequest) Decode(msg *channel.Message) error {
+	buf := bytes.NewReader(msg.Body)
+	decoder := gob.NewDecoder(buf)
+	return decoder.Decode(self)
+}
@@ -2,16 +2,19 @@ package raft
 
 import (
 	"github.com/hashicorp/raft"
+	"github.com/openziti/channel"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"time"
 )
 
 type Member struct {
-	Id     string
-	Addr   string
-	Voter  bool
-	Leader bool
+	Id          string
+	Addr        string
+	Voter       bool
+	Leader      bool
+	Connected   bool
+	LastContact time.Time
 }
 
 // MemberModel presents information about and operations on RAFT membership
@@ -22,6 +25,8 @@ type MemberModel interface {
 	HandleJoin(req *JoinRequest) error
 	// HandleRemove removes a node from the raft cluster
 	HandleRemove(req *RemoveRequest) error
+	// HandleTransferLeadership transfers leadership of the raft cluster to another node
+	HandleTransferLeadership(req *TransferLeadershipRequest) error
 }
 
 func (self *Controller) ListMembers() ([]*Member, error) {
@@ -35,12 +40,29 @@ func (self *Controller) ListMembers() ([]*Member, error) {
 	leaderAddr := self.GetRaft().Leader()
 
 	for _, srv := range configFuture.Configuration().Servers {
-		result = append(result, &Member{
+		member := &Member{
 			Id:     string(srv.ID),
 			Addr:   string(srv.Address),
 			Voter:  srv.Suffrage == raft.Voter,
 			Leader: srv.Address == leaderAddr,
-		})
+		}
+
+		if string(srv.ID) == self.tempId {
+			member.Connected = true
+			member.LastContact = time.Now()
+		} else if peer := self.GetMesh().GetPeer(srv.Address); peer != nil {
+			member.Connected = true
+			member.LastContact = peer.LastContact()
+		}
+
+		// followers only hear from the leader, which raft tracks for us
+		if member.Leader && !self.IsLeader() {
+			if lastContact := self.GetRaft().LastContact(); lastContact.After(member.LastContact) {
+				member.LastContact = lastContact
+			}
+		}
+
+		result = append(result, member)
 	}
 	return result, nil
 }
@@ -110,43 +132,80 @@ func (self *Controller) HandleJoin(req *JoinRequest) error {
 		return self.HandleJoinAsLeader(req)
 	}
 
-	peer, err := self.GetMesh().GetOrConnectPeer(self.GetLeaderAddr(), 5*time.Second)
+	msg, err := req.Encode()
 	if err != nil {
 		return err
 	}
 
-	msg, err := req.Encode()
-	if err != nil {
-		return err
+	return self.forwardToLeader(msg)
+}
+
+func (self *Controller) HandleRemove(req *RemoveRequest) error {
+	if self.IsLeader() {
+		return self.HandleRemoveAsLeader(req)
 	}
 
-	result, err := msg.WithTimeout(5 * time.Second).SendForReply(peer.Channel)
+	msg, err := req.Encode()
 	if err != nil {
 		return err
 	}
 
-	if result.ContentType == SuccessResponseType {
-		return nil
-	}
+	return self.forwardToLeader(msg)
+}
 
-	if result.ContentType == ErrorResponseType {
-		return errors.New(string(result.Body))
+func (self *Controller) HandleTransferLeadershipAsLeader(req *TransferLeadershipRequest) error {
+	r := self.GetRaft()
+
+	var future raft.Future
+	if req.Id == "" {
+		future = r.LeadershipTransfer()
+	} else {
+		configFuture := r.GetConfiguration()
+		if err := configFuture.Error(); err != nil {
+			return errors.Wrap(err, "failed to get raft configuration")
+		}
+
+		var target *raft.Server
+		for _, srv := range configFuture.Configuration().Servers {
+			if srv.ID == raft.ServerID(req.Id) {
+				target = &srv
+				break
+			}
+		}
+
+		if target == nil {
+			return errors.Errorf("no cluster member found with id %v", req.Id)
+		}
+
+		if target.Suffrage != raft.Voter {
+			return errors.Errorf("cluster member %v is not a voter and can't become leader", req.Id)
+		}
+
+		future = r.LeadershipTransferToServer(target.ID, target.Address)
 	}
 
-	return errors.Errorf("unexpected response type %v", result.ContentType)
+	if err := future.Error(); err != nil {
+		return errors.Wrap(err, "leadership transfer failed")
+	}
+	return nil
 }
 
-func (self *Controller) HandleRemove(req *RemoveRequest) error {
+func (self *Controller) HandleTransferLeadership(req *TransferLeadershipRequest) error {
 	if self.IsLeader() {
-		return self.HandleRemoveAsLeader(req)
+		return self.HandleTransferLeadershipAsLeader(req)
 	}
 
-	peer, err := self.GetMesh().GetOrConnectPeer(self.GetLeaderAddr(), 5*time.Second)
+	msg, err := req.Encode()
 	if err != nil {
 		return err
 	}
 
-	msg, err := req.Encode()
+	return self.forwardToLeader(msg)
+}
+
+// forwardToLeader sends the given request to the current leader and waits for the result
+func (self *Controller) forwardToLeader(msg *channel.Message) error {
+	peer, err := self.GetMesh().GetOrConnectPeer(self.GetLeaderAddr(), 5*time.Second)
 	if err != nil {
 		return err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -25,11 +26,25 @@ const (
 )
 
 type Peer struct {
-	mesh     *impl
-	Id       raft.ServerID
-	Address  string
-	Channel  channel.Channel
-	RaftConn *raftPeerConn
+	mesh        *impl
+	Id          raft.ServerID
+	Address     string
+	Channel     channel.Channel
+	RaftConn    *raftPeerConn
+	lastContact int64
+}
+
+// LastContact returns when raft data was last received from the peer. The zero time is returned if no
+// data has been received yet
+func (self *Peer) LastContact() time.Time {
+	if nanos := atomic.LoadInt64(&self.lastContact); nanos != 0 {
+		return time.Unix(0, nanos)
+	}
+	return time.Time{}
+}
+
+func (self *Peer) markContact() {
+	atomic.StoreInt64(&self.lastContact, time.Now().UnixNano())
 }
 
 func (self *Peer) HandleClose(channel.Channel) {
@@ -93,6 +108,9 @@ type Mesh interface {
 	// GetOrConnectPeer returns a peer for the given address. If a peer has already been established,
 	// it will be returned, otherwise a new connection will be established
 	GetOrConnectPeer(address string, timeout time.Duration) (*Peer, error)
+
+	// GetPeer returns the peer for the given address, or nil if there's no connection to the given address
+	GetPeer(addr raft.ServerAddress) *Peer
 }
 
 func New(id *identity.TokenId, raftId raft.ServerID, raftAddr raft.ServerAddress, bindHandler channel.BindHandler) Mesh {
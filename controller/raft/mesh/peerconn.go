@@ -38,6 +38,7 @@ func (self *raftPeerConn) ContentType() int32 {
 }
 
 func (self *raftPeerConn) HandleReceive(m *channel.Message, _ channel.Channel) {
+	self.peer.markContact()
 	select {
 	case self.readC <- m.Body:
 		//logrus.Infof("received %v bytes from raft peer %v", len(m.Body), self.peer.Id)
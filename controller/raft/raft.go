@@ -61,6 +61,22 @@ type Controller struct {
 	servers         []raft.Server
 	metricsRegistry metrics.Registry
 	closeNotify     <-chan struct{}
+
+	leadershipHandlers concurrenz.CopyOnWriteSlice[func(isLeader bool)]
+}
+
+// AddLeadershipChangeHandler registers a callback which is invoked whenever this node gains or loses leadership
+func (self *Controller) AddLeadershipChangeHandler(handler func(isLeader bool)) {
+	self.leadershipHandlers.Append(handler)
+}
+
+func (self *Controller) notifyLeadershipChanges(leaderNotifyCh <-chan bool) {
+	for isLeader := range leaderNotifyCh {
+		logrus.WithField("isLeader", isLeader).Info("raft leadership changed")
+		for _, handler := range self.leadershipHandlers.Value() {
+			handler(isLeader)
+		}
+	}
 }
 
 // GetRaft returns the managed raft instance
@@ -199,6 +215,11 @@ func (self *Controller) Init() error {
 	conf.NoSnapshotRestoreOnStart = false
 	conf.Logger = hclLogger
 
+	// raft blocks writing to the notify channel, so give it some room and drain it in a dedicated goroutine
+	leaderNotifyCh := make(chan bool, 8)
+	conf.NotifyCh = leaderNotifyCh
+	go self.notifyLeadershipChanges(leaderNotifyCh)
+
 	// Create the log store and stable store.
 	raftBoltFile := path.Join(raftConfig.DataDir, "raft.db")
 	boltDbStore, err := raftboltdb.NewBoltStore(raftBoltFile)
@@ -219,6 +240,7 @@ func (self *Controller) Init() error {
 		binding.AddTypedReceiveHandler(NewCommandHandler(self))
 		binding.AddTypedReceiveHandler(NewJoinHandler(self))
 		binding.AddTypedReceiveHandler(NewRemoveHandler(self))
+		binding.AddTypedReceiveHandler(NewTransferLeadershipHandler(self))
 		return nil
 	}
 
@@ -325,6 +347,16 @@ func (self *Controller) RemoveServer(id string) error {
 	return self.HandleRemove(req)
 }
 
+// TransferLeadership hands leadership of the raft cluster to the node specified by the given id. If the id is
+// blank, raft will pick the most up to date voter
+func (self *Controller) TransferLeadership(id string) error {
+	req := &TransferLeadershipRequest{
+		Id: id,
+	}
+
+	return self.HandleTransferLeadership(req)
+}
+
 func (self *Controller) initializeId() error {
 	idFile := path.Join(self.Config.DataDir, "id")
 	_, err := os.Stat(idFile)
@@ -0,0 +1,227 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package rbac
+
+import (
+	"crypto/x509"
+	"strings"
+
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/pkg/errors"
+)
+
+// Role is the level of access granted to a management API client. Roles are ordered, so a client holding a given
+// role may perform any operation which requires that role or a lesser one
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleReadOnly
+	RoleOperator
+	RoleAdmin
+)
+
+func (role Role) String() string {
+	switch role {
+	case RoleReadOnly:
+		return "read-only"
+	case RoleOperator:
+		return "operator"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "none"
+	}
+}
+
+// Allows returns true if the role is sufficient for an operation requiring the given role
+func (role Role) Allows(required Role) bool {
+	return role >= required
+}
+
+func ParseRole(val string) (Role, error) {
+	switch strings.ToLower(val) {
+	case "none":
+		return RoleNone, nil
+	case "read-only", "readonly":
+		return RoleReadOnly, nil
+	case "operator":
+		return RoleOperator, nil
+	case "admin":
+		return RoleAdmin, nil
+	}
+	return RoleNone, errors.Errorf("invalid role '%v', must be one of none, read-only, operator or admin", val)
+}
+
+// Mapping grants a role to client certificates matching all of the non-empty criteria. Subject matches either the
+// certificate common name or the full subject DN, San matches any DNS, email, URI or IP SAN and Fingerprint is the
+// hex encoded sha1 fingerprint of the certificate
+type Mapping struct {
+	Role        Role
+	Subject     string
+	San         string
+	Fingerprint string
+}
+
+func (self *Mapping) Matches(cert *x509.Certificate) bool {
+	if self.Subject != "" && self.Subject != cert.Subject.CommonName && self.Subject != cert.Subject.String() {
+		return false
+	}
+
+	if self.San != "" && !hasSan(cert, self.San) {
+		return false
+	}
+
+	if self.Fingerprint != "" && self.Fingerprint != nfpem.FingerprintFromCertificate(cert) {
+		return false
+	}
+
+	return true
+}
+
+func hasSan(cert *x509.Certificate, san string) bool {
+	for _, name := range cert.DNSNames {
+		if strings.EqualFold(name, san) {
+			return true
+		}
+	}
+	for _, email := range cert.EmailAddresses {
+		if email == san {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == san {
+			return true
+		}
+	}
+	for _, ip := range cert.IPAddresses {
+		if ip.String() == san {
+			return true
+		}
+	}
+	return false
+}
+
+// Config maps client certificates to roles. Mappings are checked in order and the first match wins. Certificates
+// which don't match any mapping get the default role
+type Config struct {
+	DefaultRole Role
+	Mappings    []*Mapping
+}
+
+// DefaultConfig grants admin to every client with a valid certificate, which matches the behavior of controllers
+// which predate role mappings
+func DefaultConfig() *Config {
+	return &Config{
+		DefaultRole: RoleAdmin,
+	}
+}
+
+// GetRole returns the role for the given client certificates. Only the leaf certificate is matched, since that's the
+// only one the client has proven it holds the key for
+func (self *Config) GetRole(certs []*x509.Certificate) Role {
+	if len(certs) == 0 {
+		return RoleNone
+	}
+
+	for _, mapping := range self.Mappings {
+		if mapping.Matches(certs[0]) {
+			return mapping.Role
+		}
+	}
+	return self.DefaultRole
+}
+
+func LoadConfig(src map[interface{}]interface{}) (*Config, error) {
+	result := &Config{
+		DefaultRole: RoleNone,
+	}
+
+	if value, found := src["defaultRole"]; found {
+		roleName, ok := value.(string)
+		if !ok {
+			return nil, errors.New("invalid defaultRole value, should be string")
+		}
+		role, err := ParseRole(roleName)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid defaultRole value")
+		}
+		result.DefaultRole = role
+	}
+
+	if value, found := src["mappings"]; found {
+		lst, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("invalid mappings value, should be array")
+		}
+
+		for idx, val := range lst {
+			submap, ok := val.(map[interface{}]interface{})
+			if !ok {
+				return nil, errors.Errorf("invalid mapping at index %v, should be map", idx)
+			}
+			mapping, err := loadMapping(submap)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid mapping at index %v", idx)
+			}
+			result.Mappings = append(result.Mappings, mapping)
+		}
+	}
+
+	return result, nil
+}
+
+func loadMapping(src map[interface{}]interface{}) (*Mapping, error) {
+	result := &Mapping{}
+
+	roleName, ok := src["role"].(string)
+	if !ok {
+		return nil, errors.New("role must be provided and must be a string")
+	}
+
+	role, err := ParseRole(roleName)
+	if err != nil {
+		return nil, err
+	}
+	result.Role = role
+
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"subject", &result.Subject},
+		{"san", &result.San},
+		{"fingerprint", &result.Fingerprint},
+	} {
+		if value, found := src[field.name]; found {
+			str, ok := value.(string)
+			if !ok {
+				return nil, errors.Errorf("%v must be a string", field.name)
+			}
+			*field.value = str
+		}
+	}
+
+	result.Fingerprint = strings.ToLower(strings.ReplaceAll(result.Fingerprint, ":", ""))
+
+	if result.Subject == "" && result.San == "" && result.Fingerprint == "" {
+		return nil, errors.New("at least one of subject, san or fingerprint must be provided")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,72 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package rbac
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	nfpem "github.com/openziti/foundation/v2/pem"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRole(t *testing.T) {
+	req := require.New(t)
+
+	adminCert := &x509.Certificate{Raw: []byte("admin"), Subject: pkix.Name{CommonName: "admin"}}
+	opsCert := &x509.Certificate{Raw: []byte("ops"), Subject: pkix.Name{CommonName: "ops"}, DNSNames: []string{"ops.example.com"}}
+	otherCert := &x509.Certificate{Raw: []byte("other"), Subject: pkix.Name{CommonName: "other"}}
+
+	config, err := LoadConfig(map[interface{}]interface{}{
+		"defaultRole": "read-only",
+		"mappings": []interface{}{
+			map[interface{}]interface{}{
+				"role":        "admin",
+				"fingerprint": nfpem.FingerprintFromCertificate(adminCert),
+			},
+			map[interface{}]interface{}{
+				"role": "operator",
+				"san":  "ops.example.com",
+			},
+		},
+	})
+	req.NoError(err)
+
+	req.Equal(RoleAdmin, config.GetRole([]*x509.Certificate{adminCert}))
+	req.Equal(RoleOperator, config.GetRole([]*x509.Certificate{opsCert}))
+	req.Equal(RoleReadOnly, config.GetRole([]*x509.Certificate{otherCert}))
+	req.Equal(RoleNone, config.GetRole(nil))
+
+	// only the leaf cert should be matched
+	req.Equal(RoleReadOnly, config.GetRole([]*x509.Certificate{otherCert, adminCert}))
+
+	req.True(RoleAdmin.Allows(RoleOperator))
+	req.False(RoleReadOnly.Allows(RoleOperator))
+
+	_, err = LoadConfig(map[interface{}]interface{}{
+		"mappings": []interface{}{
+			map[interface{}]interface{}{"role": "admin"},
+		},
+	})
+	req.Error(err)
+
+	_, err = LoadConfig(map[interface{}]interface{}{"defaultRole": "superuser"})
+	req.Error(err)
+
+	req.Equal(RoleAdmin, DefaultConfig().GetRole([]*x509.Certificate{otherCert}))
+}
@@ -18,9 +18,29 @@ package xt
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
+const (
+	// DefaultWeight is the weight used for terminators which don't have an explicit weight set
+	DefaultWeight = 1
+
+	// MinWeight and MaxWeight bound the weights which can be set on a terminator. A weight of 0 is taken to mean
+	// that no weight was given, so there's no weight which stops a terminator from receiving circuits. The failed
+	// precedence should be used for that instead
+	MinWeight = 1
+	MaxWeight = math.MaxUint16
+)
+
+// ValidateWeight returns an error if the given weight is outside the range which can be set on a terminator
+func ValidateWeight(weight uint32) error {
+	if weight < MinWeight || weight > MaxWeight {
+		return fmt.Errorf("invalid weight %v. weight must be between %v and %v inclusive", weight, MinWeight, MaxWeight)
+	}
+	return nil
+}
+
 type Registry interface {
 	RegisterFactory(factory Factory)
 	GetStrategy(name string) (Strategy, error)
@@ -35,6 +55,7 @@ type Terminator interface {
 	GetId() string
 	GetPrecedence() Precedence
 	GetCost() uint16
+	GetWeight() uint16
 	GetServiceId() string
 	GetInstanceId() string
 	GetRouterId() string
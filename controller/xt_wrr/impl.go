@@ -0,0 +1,103 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_wrr
+
+import (
+	"github.com/openziti/fabric/controller/xt"
+	"sync"
+)
+
+const (
+	Name = "wrr"
+)
+
+/**
+The wrr strategy does smooth weighted round-robin selection across available terminators, using the explicit terminator
+weight. So if terminator A has a weight of 80 and terminator B has a weight of 20, then of every 100 circuits, 80 will
+go to A and 20 to B, interleaved rather than in bursts. Selection is deterministic, which makes it suitable for canary
+rollouts. Like the random strategy, it only picks from terminators which match the precedence of the first terminator.
+Weights run from 1 to 65535, so every terminator gets some circuits. To take a terminator out of rotation, set its
+precedence to failed.
+*/
+
+func NewFactory() xt.Factory {
+	return &factory{}
+}
+
+type factory struct{}
+
+func (self *factory) GetStrategyName() string {
+	return Name
+}
+
+func (self *factory) NewStrategy() xt.Strategy {
+	return &strategy{
+		currentWeights: map[string]int64{},
+	}
+}
+
+type strategy struct {
+	currentWeights map[string]int64
+	lock           sync.Mutex
+}
+
+func (self *strategy) Select(terminators []xt.CostedTerminator) (xt.CostedTerminator, error) {
+	terminators = xt.GetRelatedTerminators(terminators)
+	if len(terminators) == 1 {
+		return terminators[0], nil
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var selected xt.CostedTerminator
+	var selectedWeight int64
+	totalWeight := int64(0)
+
+	for _, t := range terminators {
+		weight := int64(t.GetWeight())
+		if weight == 0 {
+			weight = xt.DefaultWeight
+		}
+		totalWeight += weight
+		current := self.currentWeights[t.GetId()] + weight
+		self.currentWeights[t.GetId()] = current
+		if selected == nil || current > selectedWeight {
+			selected = t
+			selectedWeight = current
+		}
+	}
+
+	self.currentWeights[selected.GetId()] = selectedWeight - totalWeight
+	return selected, nil
+}
+
+func (self *strategy) NotifyEvent(xt.TerminatorEvent) {}
+
+func (self *strategy) HandleTerminatorChange(event xt.StrategyChangeEvent) error {
+	if len(event.GetRemoved()) == 0 {
+		return nil
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for _, t := range event.GetRemoved() {
+		delete(self.currentWeights, t.GetId())
+	}
+	return nil
+}
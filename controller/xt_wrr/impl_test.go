@@ -0,0 +1,100 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xt_wrr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openziti/fabric/controller/xt"
+	"github.com/stretchr/testify/require"
+)
+
+type testTerminator struct {
+	id     string
+	weight uint16
+}
+
+func (t *testTerminator) GetId() string                { return t.id }
+func (t *testTerminator) GetPrecedence() xt.Precedence { return xt.Precedences.Default }
+func (t *testTerminator) GetCost() uint16              { return 0 }
+func (t *testTerminator) GetWeight() uint16            { return t.weight }
+func (t *testTerminator) GetServiceId() string         { return "svc" }
+func (t *testTerminator) GetInstanceId() string        { return "" }
+func (t *testTerminator) GetRouterId() string          { return "router" }
+func (t *testTerminator) GetBinding() string           { return "transport" }
+func (t *testTerminator) GetAddress() string           { return "tcp:localhost:1234" }
+func (t *testTerminator) GetPeerData() xt.PeerData     { return nil }
+func (t *testTerminator) GetCreatedAt() time.Time      { return time.Time{} }
+func (t *testTerminator) GetRouteCost() uint32         { return 0 }
+
+func TestWeightedDistribution(t *testing.T) {
+	req := require.New(t)
+
+	a := &testTerminator{id: "a", weight: 80}
+	b := &testTerminator{id: "b", weight: 20}
+	terminators := []xt.CostedTerminator{a, b}
+
+	strategy := NewFactory().NewStrategy()
+	counts := map[string]int{}
+	for i := 0; i < 100; i++ {
+		selected, err := strategy.Select(terminators)
+		req.NoError(err)
+		counts[selected.GetId()]++
+	}
+
+	req.Equal(80, counts["a"])
+	req.Equal(20, counts["b"])
+}
+
+func TestSmoothInterleaving(t *testing.T) {
+	req := require.New(t)
+
+	a := &testTerminator{id: "a", weight: 5}
+	b := &testTerminator{id: "b", weight: 1}
+	c := &testTerminator{id: "c", weight: 1}
+	terminators := []xt.CostedTerminator{a, b, c}
+
+	strategy := NewFactory().NewStrategy()
+	var selected []string
+	for i := 0; i < 7; i++ {
+		terminator, err := strategy.Select(terminators)
+		req.NoError(err)
+		selected = append(selected, terminator.GetId())
+	}
+
+	req.Equal([]string{"a", "a", "b", "a", "c", "a", "a"}, selected)
+}
+
+func TestDefaultWeight(t *testing.T) {
+	req := require.New(t)
+
+	a := &testTerminator{id: "a"}
+	b := &testTerminator{id: "b"}
+	terminators := []xt.CostedTerminator{a, b}
+
+	strategy := NewFactory().NewStrategy()
+	counts := map[string]int{}
+	for i := 0; i < 10; i++ {
+		selected, err := strategy.Select(terminators)
+		req.NoError(err)
+		counts[selected.GetId()]++
+	}
+
+	req.Equal(5, counts["a"])
+	req.Equal(5, counts["b"])
+}
@@ -0,0 +1,61 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package event
+
+import (
+	"fmt"
+	"time"
+)
+
+type AuditEventType string
+
+const (
+	AuditEventsNs = "fabric.audit"
+
+	AuditRestRequest AuditEventType = "rest"
+	AuditMgmtRequest AuditEventType = "mgmt"
+
+	AuditResultSuccess   = "success"
+	AuditResultFailure   = "failure"
+	AuditResultForbidden = "forbidden"
+	AuditResultAccepted  = "accepted"
+)
+
+// An AuditEvent records a mutating request made via the management API. For REST requests, the result reflects the
+// response status. Management channel requests are processed asynchronously, so their result is accepted, unless the
+// request was rejected for lack of permissions
+type AuditEvent struct {
+	Namespace     string         `json:"namespace"`
+	EventType     AuditEventType `json:"event_type"`
+	Timestamp     time.Time      `json:"timestamp"`
+	Fingerprint   string         `json:"fingerprint"`
+	Operation     string         `json:"operation"`
+	EntityId      string         `json:"entity_id,omitempty"`
+	ChangedFields []string       `json:"changed_fields,omitempty"`
+	Result        string         `json:"result"`
+	StatusCode    int            `json:"status_code,omitempty"`
+}
+
+func (event *AuditEvent) String() string {
+	return fmt.Sprintf("%v.%v time=%v fingerprint=%v operation=%v entityId=%v changedFields=%v result=%v statusCode=%v",
+		event.Namespace, event.EventType, event.Timestamp, event.Fingerprint, event.Operation, event.EntityId,
+		event.ChangedFields, event.Result, event.StatusCode)
+}
+
+type AuditEventHandler interface {
+	AcceptAuditEvent(event *AuditEvent)
+}
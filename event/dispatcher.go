@@ -36,6 +36,9 @@ type Dispatcher interface {
 
 	Dispatch(event Event)
 
+	AddAuditEventHandler(handler AuditEventHandler)
+	RemoveAuditEventHandler(handler AuditEventHandler)
+
 	AddCircuitEventHandler(handler CircuitEventHandler)
 	RemoveCircuitEventHandler(handler CircuitEventHandler)
 
@@ -63,6 +66,7 @@ type Dispatcher interface {
 	AddUsageEventHandler(handler UsageEventHandler)
 	RemoveUsageEventHandler(handler UsageEventHandler)
 
+	AuditEventHandler
 	CircuitEventHandler
 	LinkEventHandler
 	MetricsEventHandler
@@ -57,6 +57,10 @@ func (d DispatcherMock) AddRouterEventHandler(RouterEventHandler) {}
 
 func (d DispatcherMock) RemoveRouterEventHandler(RouterEventHandler) {}
 
+func (d DispatcherMock) AddAuditEventHandler(AuditEventHandler) {}
+
+func (d DispatcherMock) RemoveAuditEventHandler(AuditEventHandler) {}
+
 func (d DispatcherMock) AddServiceEventHandler(ServiceEventHandler) {}
 
 func (d DispatcherMock) RemoveServiceEventHandler(ServiceEventHandler) {}
@@ -69,6 +73,8 @@ func (d DispatcherMock) AddUsageEventHandler(UsageEventHandler) {}
 
 func (d DispatcherMock) RemoveUsageEventHandler(UsageEventHandler) {}
 
+func (d DispatcherMock) AcceptAuditEvent(*AuditEvent) {}
+
 func (d DispatcherMock) AcceptCircuitEvent(*CircuitEvent) {}
 
 func (d DispatcherMock) AcceptLinkEvent(*LinkEvent) {}
@@ -32,6 +32,7 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 		eventC:      make(chan event.Event, 25),
 	}
 
+	result.RegisterEventType(event.AuditEventsNs, result.registerAuditEventHandler)
 	result.RegisterEventType(event.CircuitEventsNs, result.registerCircuitEventHandler)
 	result.RegisterEventType(event.LinkEventsNs, result.registerLinkEventHandler)
 	result.RegisterEventType(event.MetricsEventsNs, result.registerMetricsEventHandler)
@@ -49,6 +50,7 @@ func NewDispatcher(closeNotify <-chan struct{}) *Dispatcher {
 }
 
 type Dispatcher struct {
+	auditEventHandlers      concurrenz.CopyOnWriteSlice[event.AuditEventHandler]
 	circuitEventHandlers    concurrenz.CopyOnWriteSlice[event.CircuitEventHandler]
 	linkEventHandlers       concurrenz.CopyOnWriteSlice[event.LinkEventHandler]
 	metricsEventHandlers    concurrenz.CopyOnWriteSlice[event.MetricsEventHandler]
@@ -0,0 +1,51 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package events
+
+import (
+	"github.com/openziti/fabric/event"
+	"github.com/pkg/errors"
+	"reflect"
+)
+
+func (self *Dispatcher) AddAuditEventHandler(handler event.AuditEventHandler) {
+	self.auditEventHandlers.Append(handler)
+}
+
+func (self *Dispatcher) RemoveAuditEventHandler(handler event.AuditEventHandler) {
+	self.auditEventHandlers.Delete(handler)
+}
+
+func (self *Dispatcher) AcceptAuditEvent(event *event.AuditEvent) {
+	go func() {
+		for _, handler := range self.auditEventHandlers.Value() {
+			handler.AcceptAuditEvent(event)
+		}
+	}()
+}
+
+func (self *Dispatcher) registerAuditEventHandler(val interface{}, _ map[interface{}]interface{}) error {
+	handler, ok := val.(event.AuditEventHandler)
+
+	if !ok {
+		return errors.Errorf("type %v doesn't implement github.com/openziti/fabric/event/AuditEventHandler interface.", reflect.TypeOf(val))
+	}
+
+	self.AddAuditEventHandler(handler)
+
+	return nil
+}
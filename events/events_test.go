@@ -17,6 +17,7 @@
 package events
 
 import (
+	"github.com/openziti/fabric/event"
 	"github.com/openziti/foundation/v2/cowslice"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -96,3 +97,50 @@ func TestDispatcherImpl_Dispatch(t *testing.T) {
 	default:
 	}
 }
+
+type auditCollector chan *event.AuditEvent
+
+func (self auditCollector) AcceptAuditEvent(evt *event.AuditEvent) {
+	self <- evt
+}
+
+func (self auditCollector) NewEventHandler(map[interface{}]interface{}) (interface{}, error) {
+	return self, nil
+}
+
+func TestAuditEventSubscription(t *testing.T) {
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+	dispatcher := NewDispatcher(closeNotify)
+
+	collector := make(auditCollector, 1)
+	dispatcher.RegisterEventHandlerFactory("test", collector)
+
+	req := require.New(t)
+	err := dispatcher.WireEventHandlers([]*EventHandlerConfig{{
+		Id: "audit",
+		Config: map[interface{}]interface{}{
+			"subscriptions": []interface{}{
+				map[interface{}]interface{}{"type": event.AuditEventsNs},
+			},
+			"handler": map[interface{}]interface{}{"type": "test"},
+		},
+	}})
+	req.NoError(err)
+
+	dispatcher.AcceptAuditEvent(&event.AuditEvent{
+		Namespace: event.AuditEventsNs,
+		EventType: event.AuditRestRequest,
+		Operation: "deleteService",
+		EntityId:  "svc1",
+		Result:    event.AuditResultSuccess,
+	})
+
+	select {
+	case evt := <-collector:
+		req.Equal("deleteService", evt.Operation)
+		req.Equal("svc1", evt.EntityId)
+	case <-time.After(time.Second):
+		req.Fail("timed out waiting for audit event")
+	}
+}
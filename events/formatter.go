@@ -63,6 +63,12 @@ func marshalJson(v interface{}, output io.WriteCloser) error {
 	return err
 }
 
+type JsonAuditEvent event.AuditEvent
+
+func (event *JsonAuditEvent) WriteTo(output io.WriteCloser) error {
+	return marshalJson(event, output)
+}
+
 type JsonCircuitEvent event.CircuitEvent
 
 func (event *JsonCircuitEvent) WriteTo(output io.WriteCloser) error {
@@ -118,6 +124,10 @@ type JsonFormatter struct {
 	BaseFormatter
 }
 
+func (formatter *JsonFormatter) AcceptAuditEvent(evt *event.AuditEvent) {
+	formatter.AcceptLoggingEvent((*JsonAuditEvent)(evt))
+}
+
 func (formatter *JsonFormatter) AcceptCircuitEvent(evt *event.CircuitEvent) {
 	formatter.AcceptLoggingEvent((*JsonCircuitEvent)(evt))
 }
@@ -146,6 +156,13 @@ func (formatter *JsonFormatter) AcceptUsageEvent(evt *event.UsageEvent) {
 	formatter.AcceptLoggingEvent((*JsonUsageEvent)(evt))
 }
 
+type PlainTextAuditEvent event.AuditEvent
+
+func (self *PlainTextAuditEvent) WriteTo(output io.WriteCloser) error {
+	_, err := output.Write([]byte((*event.AuditEvent)(self).String()))
+	return err
+}
+
 type PlainTextCircuitEvent event.CircuitEvent
 
 func (self *PlainTextCircuitEvent) WriteTo(output io.WriteCloser) error {
@@ -216,6 +233,10 @@ type PlainTextFormatter struct {
 	BaseFormatter
 }
 
+func (formatter *PlainTextFormatter) AcceptAuditEvent(evt *event.AuditEvent) {
+	formatter.AcceptLoggingEvent((*PlainTextAuditEvent)(evt))
+}
+
 func (formatter *PlainTextFormatter) AcceptCircuitEvent(evt *event.CircuitEvent) {
 	formatter.AcceptLoggingEvent((*PlainTextCircuitEvent)(evt))
 }
@@ -17,11 +17,12 @@
 package inspect
 
 type CircuitInspectDetail struct {
-	CircuitId         string                        `json:"circuitId"`
-	Forwards          map[string]string             `json:"forwards"`
-	XgressDetails     map[string]*XgressDetail      `json:"xgressDetails"`
-	LinkDetails       map[string]*LinkInspectDetail `json:"linkDetails"`
-	includeGoroutines bool
+	CircuitId             string                        `json:"circuitId"`
+	TimeSinceLastActivity string                        `json:"timeSinceLastActivity"`
+	Forwards              map[string]string             `json:"forwards"`
+	XgressDetails         map[string]*XgressDetail      `json:"xgressDetails"`
+	LinkDetails           map[string]*LinkInspectDetail `json:"linkDetails"`
+	includeGoroutines     bool
 }
 
 func (self *CircuitInspectDetail) SetIncludeGoroutines(includeGoroutines bool) {
@@ -43,6 +44,10 @@ type XgressDetail struct {
 	Goroutines            []string                `json:"goroutines"`
 	Sequence              int32                   `json:"sequence"`
 	Flags                 string                  `json:"flags"`
+	BytesReceived         uint64                  `json:"bytesReceived"`
+	BytesSent             uint64                  `json:"bytesSent"`
+	CircuitBandwidthLimit uint64                  `json:"circuitBandwidthLimit"`
+	ServiceBandwidthLimit uint64                  `json:"serviceBandwidthLimit"`
 }
 
 type XgressSendBufferDetail struct {
@@ -61,6 +66,11 @@ type XgressSendBufferDetail struct {
 	TimeSinceLastRetx     string  `json:"timeSinceLastRetx"`
 	CloseWhenEmpty        bool    `json:"closeWhenEmpty"`
 	AcquiredSafely        bool    `json:"acquiredSafely"`
+	CongestionControl     string  `json:"congestionControl"`
+	CongestionState       string  `json:"congestionState,omitempty"`
+	PacingRate            uint64  `json:"pacingRate"`
+	Rtt                   uint16  `json:"rtt"`
+	MinRtt                uint16  `json:"minRtt,omitempty"`
 }
 
 type XgressRecvBufferDetail struct {
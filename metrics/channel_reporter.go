@@ -25,7 +25,7 @@ import (
 )
 
 type channelReporter struct {
-	ch channel.Channel
+	chProvider func() channel.Channel
 }
 
 func (reporter *channelReporter) AcceptMetrics(message *metrics_pb.MetricsMessage) {
@@ -39,7 +39,7 @@ func (reporter *channelReporter) AcceptMetrics(message *metrics_pb.MetricsMessag
 
 	chMsg := channel.NewMessage(int32(metrics_pb.ContentType_MetricsType), bytes)
 
-	if err = reporter.ch.Send(chMsg); err != nil {
+	if err = reporter.chProvider().Send(chMsg); err != nil {
 		log.WithError(err).Error("failed to send metrics message")
 	} else {
 		log.Trace("reported metrics to fabric controller")
@@ -49,6 +49,16 @@ func (reporter *channelReporter) AcceptMetrics(message *metrics_pb.MetricsMessag
 // NewChannelReporter creates a metrics handler which sends metrics messages out on the given channel
 func NewChannelReporter(ch channel.Channel) metrics.Handler {
 	return &channelReporter{
-		ch: ch,
+		chProvider: func() channel.Channel {
+			return ch
+		},
+	}
+}
+
+// NewChannelProviderReporter creates a metrics handler which sends metrics messages out on the channel returned
+// by the provider at the time of reporting. This allows metrics to follow a router's current controller
+func NewChannelProviderReporter(chProvider func() channel.Channel) metrics.Handler {
+	return &channelReporter{
+		chProvider: chProvider,
 	}
 }
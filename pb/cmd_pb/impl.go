@@ -39,6 +39,10 @@ func (x *SyncSnapshotCommand) GetCommandType() int32 {
 	return int32(CommandType_SyncSnapshot)
 }
 
+func (x *BatchCommand) GetCommandType() int32 {
+	return int32(CommandType_Batch)
+}
+
 func EncodeTags(tags map[string]interface{}) (map[string]*TagValue, error) {
 	if len(tags) == 0 {
 		return nil, nil
@@ -149,6 +149,24 @@ func (d Decoder) Decode(msg *channel.Message) ([]byte, bool) {
 			return nil, true
 		}
 
+	case int32(ContentType_CtrlLeaderUpdateType):
+		update := &CtrlLeaderUpdate{}
+		if err := proto.Unmarshal(msg.Body, update); err == nil {
+			meta := channel.NewTraceMessageDecode(DECODER, "Ctrl Leader Update")
+			meta["isLeader"] = update.IsLeader
+			data, err := meta.MarshalTraceMessageDecode()
+			if err != nil {
+				pfxlog.Logger().Errorf("unexpected error (%s)", err)
+				return nil, true
+			}
+
+			return data, true
+
+		} else {
+			pfxlog.Logger().Errorf("unexpected error (%s)", err)
+			return nil, true
+		}
+
 	case int32(ctrl_msg.CircuitSuccessType):
 		meta := channel.NewTraceMessageDecode(DECODER, "Circuit Success Response")
 		meta["circuitId"] = string(msg.Body)
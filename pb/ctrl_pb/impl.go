@@ -22,6 +22,14 @@ func (request *CircuitConfirmation) GetContentType() int32 {
 	return int32(ContentType_CircuitConfirmationType)
 }
 
+func (request *CircuitReconciliation) GetContentType() int32 {
+	return int32(ContentType_CircuitReconciliationType)
+}
+
+func (response *CircuitReconciliationResult) GetContentType() int32 {
+	return int32(ContentType_CircuitReconciliationResultType)
+}
+
 func (request *LinkConnected) GetContentType() int32 {
 	return int32(ContentType_LinkConnectedType)
 }
@@ -38,6 +46,10 @@ func (request *VerifyRouter) GetContentType() int32 {
 	return int32(ContentType_VerifyRouterType)
 }
 
+func (request *CtrlLeaderUpdate) GetContentType() int32 {
+	return int32(ContentType_CtrlLeaderUpdateType)
+}
+
 func (request *Fault) GetContentType() int32 {
 	return int32(ContentType_FaultType)
 }
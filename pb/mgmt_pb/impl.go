@@ -11,3 +11,11 @@ func (request *InspectResponse) GetContentType() int32 {
 func (request *RaftMemberListResponse) GetContentType() int32 {
 	return int32(ContentType_RaftListMembersResponseType)
 }
+
+func (request *StreamChangesRequest) GetContentType() int32 {
+	return int32(ContentType_StreamChangesRequestType)
+}
+
+func (request *StreamChangesEvent) GetContentType() int32 {
+	return int32(ContentType_StreamChangesEventType)
+}
@@ -60,6 +60,8 @@ type ClientService interface {
 
 	ListCircuits(params *ListCircuitsParams, opts ...ClientOption) (*ListCircuitsOK, error)
 
+	TerminateCircuits(params *TerminateCircuitsParams, opts ...ClientOption) (*TerminateCircuitsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -184,6 +186,48 @@ func (a *Client) ListCircuits(params *ListCircuitsParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+  TerminateCircuits terminates all circuits matching a filter
+
+  Terminates all circuits matching every criterion given in the filter. At least one criterion must be given. If
+dryRun is set, the matching circuits are reported but not terminated. Requires admin access.
+
+*/
+func (a *Client) TerminateCircuits(params *TerminateCircuitsParams, opts ...ClientOption) (*TerminateCircuitsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTerminateCircuitsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "terminateCircuits",
+		Method:             "POST",
+		PathPattern:        "/circuits/terminate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TerminateCircuitsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TerminateCircuitsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for terminateCircuits: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -38,6 +38,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewDetailCircuitParams creates a new DetailCircuitParams object,
@@ -89,6 +90,12 @@ type DetailCircuitParams struct {
 	*/
 	ID string
 
+	/* IncludeRouterStats.
+
+	   If true, the routers on the circuit path are queried for their live statistics for the circuit
+	*/
+	IncludeRouterStats *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -153,6 +160,17 @@ func (o *DetailCircuitParams) SetID(id string) {
 	o.ID = id
 }
 
+// WithIncludeRouterStats adds the includeRouterStats to the detail circuit params
+func (o *DetailCircuitParams) WithIncludeRouterStats(includeRouterStats *bool) *DetailCircuitParams {
+	o.SetIncludeRouterStats(includeRouterStats)
+	return o
+}
+
+// SetIncludeRouterStats adds the includeRouterStats to the detail circuit params
+func (o *DetailCircuitParams) SetIncludeRouterStats(includeRouterStats *bool) {
+	o.IncludeRouterStats = includeRouterStats
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *DetailCircuitParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -166,6 +184,23 @@ func (o *DetailCircuitParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		return err
 	}
 
+	if o.IncludeRouterStats != nil {
+
+		// query param includeRouterStats
+		var qrIncludeRouterStats bool
+
+		if o.IncludeRouterStats != nil {
+			qrIncludeRouterStats = *o.IncludeRouterStats
+		}
+		qIncludeRouterStats := swag.FormatBool(qrIncludeRouterStats)
+		if qIncludeRouterStats != "" {
+
+			if err := r.SetQueryParam("includeRouterStats", qIncludeRouterStats); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
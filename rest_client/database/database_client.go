@@ -60,8 +60,14 @@ type ClientService interface {
 
 	DataIntegrityResults(params *DataIntegrityResultsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DataIntegrityResultsOK, error)
 
+	ExportDatabase(params *ExportDatabaseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ExportDatabaseOK, error)
+
 	FixDataIntegrity(params *FixDataIntegrityParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*FixDataIntegrityAccepted, error)
 
+	ImportDatabase(params *ImportDatabaseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportDatabaseOK, error)
+
+	ReconcileDatabase(params *ReconcileDatabaseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReconcileDatabaseOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -188,6 +194,49 @@ func (a *Client) DataIntegrityResults(params *DataIntegrityResultsParams, authIn
 	panic(msg)
 }
 
+/*
+  ExportDatabase exports services routers and terminators
+
+  Exports all services, routers and terminators, including their tags. The result can be passed to importDatabase
+as is. Returns JSON or YAML, depending on the Accept header. Requires admin access.
+
+*/
+func (a *Client) ExportDatabase(params *ExportDatabaseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ExportDatabaseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportDatabaseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "exportDatabase",
+		Method:             "GET",
+		PathPattern:        "/database/export",
+		ProducesMediaTypes: []string{"application/json", "application/x-yaml"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ExportDatabaseReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ExportDatabaseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for exportDatabase: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   FixDataIntegrity runs a data integrity scan on the datastore attempts to fix any issues it can and returns any found issues
 
@@ -229,6 +278,97 @@ func (a *Client) FixDataIntegrity(params *FixDataIntegrityParams, authInfo runti
 	panic(msg)
 }
 
+/*
+  ImportDatabase creates or updates services routers and terminators in a single transaction
+
+  Creates or updates the given services, routers and terminators in a single transaction. Entities are matched
+on id, or on name for services and routers given without an id. Entities which aren't part of the import are
+left unchanged. If dryRun is set, the changes are reported but not applied. Accepts JSON or YAML. Requires
+admin access.
+
+*/
+func (a *Client) ImportDatabase(params *ImportDatabaseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ImportDatabaseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportDatabaseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "importDatabase",
+		Method:             "POST",
+		PathPattern:        "/database/import",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/x-yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ImportDatabaseReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ImportDatabaseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for importDatabase: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ReconcileDatabase makes services routers and terminators match the given desired state
+
+  Makes the services, routers and terminators match the given desired state in a single transaction, returning
+the creates, updates and deletes required to do so. Works like importDatabase, except that services, routers
+and static terminators which aren't part of the desired state are deleted. Terminators with a host id or
+instance secret are managed by hosting applications and are only deleted along with their service or router.
+If dryRun is set, the plan is reported but not applied. Accepts JSON or YAML. Requires admin access.
+
+*/
+func (a *Client) ReconcileDatabase(params *ReconcileDatabaseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReconcileDatabaseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReconcileDatabaseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "reconcileDatabase",
+		Method:             "POST",
+		PathPattern:        "/database/reconcile",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/x-yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReconcileDatabaseReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReconcileDatabaseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for reconcileDatabase: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
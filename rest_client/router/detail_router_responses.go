@@ -80,6 +80,11 @@ func NewDetailRouterOK() *DetailRouterOK {
 A single router
 */
 type DetailRouterOK struct {
+
+	/* The current version of the router, for use with If-Match on updates
+	 */
+	ETag string
+
 	Payload *rest_model.DetailRouterEnvelope
 }
 
@@ -92,6 +97,13 @@ func (o *DetailRouterOK) GetPayload() *rest_model.DetailRouterEnvelope {
 
 func (o *DetailRouterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(rest_model.DetailRouterEnvelope)
 
 	// response payload
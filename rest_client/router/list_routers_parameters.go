@@ -84,6 +84,18 @@ func NewListRoutersParamsWithHTTPClient(client *http.Client) *ListRoutersParams
 */
 type ListRoutersParams struct {
 
+	/* Cursor.
+
+	   The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available
+	*/
+	Cursor *string
+
+	/* Fields.
+
+	   A comma separated list of the fields to include for each returned entity. Unknown fields are ignored
+	*/
+	Fields *string
+
 	// Filter.
 	Filter *string
 
@@ -146,6 +158,28 @@ func (o *ListRoutersParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithCursor adds the cursor to the list routers params
+func (o *ListRoutersParams) WithCursor(cursor *string) *ListRoutersParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list routers params
+func (o *ListRoutersParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithFields adds the fields to the list routers params
+func (o *ListRoutersParams) WithFields(fields *string) *ListRoutersParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the list routers params
+func (o *ListRoutersParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
 // WithFilter adds the filter to the list routers params
 func (o *ListRoutersParams) WithFilter(filter *string) *ListRoutersParams {
 	o.SetFilter(filter)
@@ -187,6 +221,40 @@ func (o *ListRoutersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Filter != nil {
 
 		// query param filter
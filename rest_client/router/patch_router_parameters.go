@@ -85,6 +85,12 @@ func NewPatchRouterParamsWithHTTPClient(client *http.Client) *PatchRouterParams
 */
 type PatchRouterParams struct {
 
+	/* IfMatch.
+
+	   The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since
+	*/
+	IfMatch *string
+
 	/* ID.
 
 	   The id of the requested resource
@@ -150,6 +156,17 @@ func (o *PatchRouterParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the patch router params
+func (o *PatchRouterParams) WithIfMatch(ifMatch *string) *PatchRouterParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the patch router params
+func (o *PatchRouterParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithID adds the id to the patch router params
 func (o *PatchRouterParams) WithID(id string) *PatchRouterParams {
 	o.SetID(id)
@@ -180,6 +197,14 @@ func (o *PatchRouterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID); err != nil {
 		return err
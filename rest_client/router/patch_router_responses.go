@@ -71,6 +71,12 @@ func (o *PatchRouterReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewPatchRouterPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
 	}
@@ -203,3 +209,35 @@ func (o *PatchRouterNotFound) readResponse(response runtime.ClientResponse, cons
 
 	return nil
 }
+
+// NewPatchRouterPreconditionFailed creates a PatchRouterPreconditionFailed with default headers values
+func NewPatchRouterPreconditionFailed() *PatchRouterPreconditionFailed {
+	return &PatchRouterPreconditionFailed{}
+}
+
+/* PatchRouterPreconditionFailed describes a response with status code 412, with default header values.
+
+The entity has been modified since the version given in the If-Match header
+*/
+type PatchRouterPreconditionFailed struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *PatchRouterPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /routers/{id}][%d] patchRouterPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *PatchRouterPreconditionFailed) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchRouterPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -85,6 +85,12 @@ func NewUpdateRouterParamsWithHTTPClient(client *http.Client) *UpdateRouterParam
 */
 type UpdateRouterParams struct {
 
+	/* IfMatch.
+
+	   The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since
+	*/
+	IfMatch *string
+
 	/* ID.
 
 	   The id of the requested resource
@@ -150,6 +156,17 @@ func (o *UpdateRouterParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the update router params
+func (o *UpdateRouterParams) WithIfMatch(ifMatch *string) *UpdateRouterParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update router params
+func (o *UpdateRouterParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithID adds the id to the update router params
 func (o *UpdateRouterParams) WithID(id string) *UpdateRouterParams {
 	o.SetID(id)
@@ -180,6 +197,14 @@ func (o *UpdateRouterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID); err != nil {
 		return err
@@ -71,6 +71,12 @@ func (o *UpdateRouterReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewUpdateRouterPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
 	}
@@ -203,3 +209,35 @@ func (o *UpdateRouterNotFound) readResponse(response runtime.ClientResponse, con
 
 	return nil
 }
+
+// NewUpdateRouterPreconditionFailed creates a UpdateRouterPreconditionFailed with default headers values
+func NewUpdateRouterPreconditionFailed() *UpdateRouterPreconditionFailed {
+	return &UpdateRouterPreconditionFailed{}
+}
+
+/* UpdateRouterPreconditionFailed describes a response with status code 412, with default header values.
+
+The entity has been modified since the version given in the If-Match header
+*/
+type UpdateRouterPreconditionFailed struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *UpdateRouterPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /routers/{id}][%d] updateRouterPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *UpdateRouterPreconditionFailed) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateRouterPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
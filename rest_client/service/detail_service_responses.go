@@ -80,6 +80,11 @@ func NewDetailServiceOK() *DetailServiceOK {
 A single service
 */
 type DetailServiceOK struct {
+
+	/* The current version of the service, for use with If-Match on updates
+	 */
+	ETag string
+
 	Payload *rest_model.DetailServiceEnvelope
 }
 
@@ -92,6 +97,13 @@ func (o *DetailServiceOK) GetPayload() *rest_model.DetailServiceEnvelope {
 
 func (o *DetailServiceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(rest_model.DetailServiceEnvelope)
 
 	// response payload
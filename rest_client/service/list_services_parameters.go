@@ -84,6 +84,18 @@ func NewListServicesParamsWithHTTPClient(client *http.Client) *ListServicesParam
 */
 type ListServicesParams struct {
 
+	/* Cursor.
+
+	   The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available
+	*/
+	Cursor *string
+
+	/* Fields.
+
+	   A comma separated list of the fields to include for each returned entity. Unknown fields are ignored
+	*/
+	Fields *string
+
 	// Filter.
 	Filter *string
 
@@ -146,6 +158,28 @@ func (o *ListServicesParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithCursor adds the cursor to the list services params
+func (o *ListServicesParams) WithCursor(cursor *string) *ListServicesParams {
+	o.SetCursor(cursor)
+	return o
+}
+
+// SetCursor adds the cursor to the list services params
+func (o *ListServicesParams) SetCursor(cursor *string) {
+	o.Cursor = cursor
+}
+
+// WithFields adds the fields to the list services params
+func (o *ListServicesParams) WithFields(fields *string) *ListServicesParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the list services params
+func (o *ListServicesParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
 // WithFilter adds the filter to the list services params
 func (o *ListServicesParams) WithFilter(filter *string) *ListServicesParams {
 	o.SetFilter(filter)
@@ -187,6 +221,40 @@ func (o *ListServicesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.Cursor != nil {
+
+		// query param cursor
+		var qrCursor string
+
+		if o.Cursor != nil {
+			qrCursor = *o.Cursor
+		}
+		qCursor := qrCursor
+		if qCursor != "" {
+
+			if err := r.SetQueryParam("cursor", qCursor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Filter != nil {
 
 		// query param filter
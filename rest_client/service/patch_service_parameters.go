@@ -85,6 +85,12 @@ func NewPatchServiceParamsWithHTTPClient(client *http.Client) *PatchServiceParam
 */
 type PatchServiceParams struct {
 
+	/* IfMatch.
+
+	   The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since
+	*/
+	IfMatch *string
+
 	/* ID.
 
 	   The id of the requested resource
@@ -150,6 +156,17 @@ func (o *PatchServiceParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithIfMatch adds the ifMatch to the patch service params
+func (o *PatchServiceParams) WithIfMatch(ifMatch *string) *PatchServiceParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the patch service params
+func (o *PatchServiceParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithID adds the id to the patch service params
 func (o *PatchServiceParams) WithID(id string) *PatchServiceParams {
 	o.SetID(id)
@@ -180,6 +197,14 @@ func (o *PatchServiceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param id
 	if err := r.SetPathParam("id", o.ID); err != nil {
 		return err
@@ -60,6 +60,10 @@ type ClientService interface {
 
 	DetailService(params *DetailServiceParams, opts ...ClientOption) (*DetailServiceOK, error)
 
+	DetailServiceStats(params *DetailServiceStatsParams, opts ...ClientOption) (*DetailServiceStatsOK, error)
+
+	ListServiceStats(params *ListServiceStatsParams, opts ...ClientOption) (*ListServiceStatsOK, error)
+
 	ListServiceTerminators(params *ListServiceTerminatorsParams, opts ...ClientOption) (*ListServiceTerminatorsOK, error)
 
 	ListServices(params *ListServicesParams, opts ...ClientOption) (*ListServicesOK, error)
@@ -191,6 +195,91 @@ func (a *Client) DetailService(params *DetailServiceParams, opts ...ClientOption
 	panic(msg)
 }
 
+/*
+  DetailServiceStats returns the rolling stats for a service
+
+  Returns dial successes and failures by cause, active circuits, bytes sent and received by clients and the
+distribution across terminators for a service, aggregated by the controller over the configured service stats
+window.
+
+*/
+func (a *Client) DetailServiceStats(params *DetailServiceStatsParams, opts ...ClientOption) (*DetailServiceStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDetailServiceStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "detailServiceStats",
+		Method:             "GET",
+		PathPattern:        "/services/{id}/stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DetailServiceStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DetailServiceStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for detailServiceStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  ListServiceStats lists the rolling stats for services
+
+  Returns the rolling stats for each service, as given by detailServiceStats; supports filtering, sorting, and
+pagination on the services.
+
+*/
+func (a *Client) ListServiceStats(params *ListServiceStatsParams, opts ...ClientOption) (*ListServiceStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListServiceStatsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listServiceStats",
+		Method:             "GET",
+		PathPattern:        "/service-stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListServiceStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListServiceStatsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listServiceStats: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   ListServiceTerminators lists of terminators assigned to a service
 
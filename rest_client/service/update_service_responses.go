@@ -71,6 +71,12 @@ func (o *UpdateServiceReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewUpdateServicePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
 	}
@@ -203,3 +209,35 @@ func (o *UpdateServiceNotFound) readResponse(response runtime.ClientResponse, co
 
 	return nil
 }
+
+// NewUpdateServicePreconditionFailed creates a UpdateServicePreconditionFailed with default headers values
+func NewUpdateServicePreconditionFailed() *UpdateServicePreconditionFailed {
+	return &UpdateServicePreconditionFailed{}
+}
+
+/* UpdateServicePreconditionFailed describes a response with status code 412, with default header values.
+
+The entity has been modified since the version given in the If-Match header
+*/
+type UpdateServicePreconditionFailed struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *UpdateServicePreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /services/{id}][%d] updateServicePreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *UpdateServicePreconditionFailed) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateServicePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -80,6 +80,11 @@ func NewDetailTerminatorOK() *DetailTerminatorOK {
 A single terminator
 */
 type DetailTerminatorOK struct {
+
+	/* The current version of the terminator, for use with If-Match on updates
+	 */
+	ETag string
+
 	Payload *rest_model.DetailTerminatorEnvelope
 }
 
@@ -92,6 +97,13 @@ func (o *DetailTerminatorOK) GetPayload() *rest_model.DetailTerminatorEnvelope {
 
 func (o *DetailTerminatorOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(rest_model.DetailTerminatorEnvelope)
 
 	// response payload
@@ -71,6 +71,12 @@ func (o *PatchTerminatorReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewPatchTerminatorPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
 	}
@@ -203,3 +209,35 @@ func (o *PatchTerminatorNotFound) readResponse(response runtime.ClientResponse,
 
 	return nil
 }
+
+// NewPatchTerminatorPreconditionFailed creates a PatchTerminatorPreconditionFailed with default headers values
+func NewPatchTerminatorPreconditionFailed() *PatchTerminatorPreconditionFailed {
+	return &PatchTerminatorPreconditionFailed{}
+}
+
+/* PatchTerminatorPreconditionFailed describes a response with status code 412, with default header values.
+
+The entity has been modified since the version given in the If-Match header
+*/
+type PatchTerminatorPreconditionFailed struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *PatchTerminatorPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PATCH /terminators/{id}][%d] patchTerminatorPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *PatchTerminatorPreconditionFailed) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *PatchTerminatorPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -71,6 +71,12 @@ func (o *UpdateTerminatorReader) ReadResponse(response runtime.ClientResponse, c
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewUpdateTerminatorPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
 	}
@@ -203,3 +209,35 @@ func (o *UpdateTerminatorNotFound) readResponse(response runtime.ClientResponse,
 
 	return nil
 }
+
+// NewUpdateTerminatorPreconditionFailed creates a UpdateTerminatorPreconditionFailed with default headers values
+func NewUpdateTerminatorPreconditionFailed() *UpdateTerminatorPreconditionFailed {
+	return &UpdateTerminatorPreconditionFailed{}
+}
+
+/* UpdateTerminatorPreconditionFailed describes a response with status code 412, with default header values.
+
+The entity has been modified since the version given in the If-Match header
+*/
+type UpdateTerminatorPreconditionFailed struct {
+	Payload *rest_model.APIErrorEnvelope
+}
+
+func (o *UpdateTerminatorPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /terminators/{id}][%d] updateTerminatorPreconditionFailed  %+v", 412, o.Payload)
+}
+func (o *UpdateTerminatorPreconditionFailed) GetPayload() *rest_model.APIErrorEnvelope {
+	return o.Payload
+}
+
+func (o *UpdateTerminatorPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(rest_model.APIErrorEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -60,6 +60,9 @@ type CircuitDetail struct {
 	// Required: true
 	Path *CircuitDetailPath `json:"path"`
 
+	// router stats
+	RouterStats []*CircuitRouterStats `json:"routerStats,omitempty"`
+
 	// service
 	// Required: true
 	Service *EntityRef `json:"service"`
@@ -85,6 +88,10 @@ func (m *CircuitDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRouterStats(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateService(formats); err != nil {
 		res = append(res, err)
 	}
@@ -141,6 +148,32 @@ func (m *CircuitDetail) validatePath(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CircuitDetail) validateRouterStats(formats strfmt.Registry) error {
+	if swag.IsZero(m.RouterStats) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RouterStats); i++ {
+		if swag.IsZero(m.RouterStats[i]) { // not required
+			continue
+		}
+
+		if m.RouterStats[i] != nil {
+			if err := m.RouterStats[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("routerStats" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("routerStats" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *CircuitDetail) validateService(formats strfmt.Registry) error {
 
 	if err := validate.Required("service", "body", m.Service); err != nil {
@@ -189,6 +222,10 @@ func (m *CircuitDetail) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRouterStats(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateService(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -219,6 +256,26 @@ func (m *CircuitDetail) contextValidatePath(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *CircuitDetail) contextValidateRouterStats(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.RouterStats); i++ {
+
+		if m.RouterStats[i] != nil {
+			if err := m.RouterStats[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("routerStats" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("routerStats" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *CircuitDetail) contextValidateService(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Service != nil {
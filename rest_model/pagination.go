@@ -47,6 +47,9 @@ type Pagination struct {
 	// Required: true
 	Limit *int64 `json:"limit"`
 
+	// Present when more results are available. Pass as the cursor parameter to read the next page
+	NextCursor string `json:"nextCursor,omitempty"`
+
 	// offset
 	// Required: true
 	Offset *int64 `json:"offset"`
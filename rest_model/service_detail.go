@@ -44,10 +44,34 @@ import (
 type ServiceDetail struct {
 	BaseEntity
 
+	// max circuit bandwidth
+	// Required: true
+	MaxCircuitBandwidth *BandwidthLimit `json:"maxCircuitBandwidth"`
+
+	// max circuits
+	// Required: true
+	MaxCircuits *ServiceLimit `json:"maxCircuits"`
+
+	// max circuits per client
+	// Required: true
+	MaxCircuitsPerClient *ServiceLimit `json:"maxCircuitsPerClient"`
+
+	// max dial rate
+	// Required: true
+	MaxDialRate *ServiceLimit `json:"maxDialRate"`
+
+	// max router bandwidth
+	// Required: true
+	MaxRouterBandwidth *RouterBandwidthLimit `json:"maxRouterBandwidth"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// priority
+	// Required: true
+	Priority *ServicePriority `json:"priority"`
+
 	// terminator strategy
 	// Required: true
 	TerminatorStrategy *string `json:"terminatorStrategy"`
@@ -64,16 +88,40 @@ func (m *ServiceDetail) UnmarshalJSON(raw []byte) error {
 
 	// AO1
 	var dataAO1 struct {
+		MaxCircuitBandwidth *BandwidthLimit `json:"maxCircuitBandwidth"`
+
+		MaxCircuits *ServiceLimit `json:"maxCircuits"`
+
+		MaxCircuitsPerClient *ServiceLimit `json:"maxCircuitsPerClient"`
+
+		MaxDialRate *ServiceLimit `json:"maxDialRate"`
+
+		MaxRouterBandwidth *RouterBandwidthLimit `json:"maxRouterBandwidth"`
+
 		Name *string `json:"name"`
 
+		Priority *ServicePriority `json:"priority"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
 		return err
 	}
 
+	m.MaxCircuitBandwidth = dataAO1.MaxCircuitBandwidth
+
+	m.MaxCircuits = dataAO1.MaxCircuits
+
+	m.MaxCircuitsPerClient = dataAO1.MaxCircuitsPerClient
+
+	m.MaxDialRate = dataAO1.MaxDialRate
+
+	m.MaxRouterBandwidth = dataAO1.MaxRouterBandwidth
+
 	m.Name = dataAO1.Name
 
+	m.Priority = dataAO1.Priority
+
 	m.TerminatorStrategy = dataAO1.TerminatorStrategy
 
 	return nil
@@ -89,13 +137,37 @@ func (m ServiceDetail) MarshalJSON() ([]byte, error) {
 	}
 	_parts = append(_parts, aO0)
 	var dataAO1 struct {
+		MaxCircuitBandwidth *BandwidthLimit `json:"maxCircuitBandwidth"`
+
+		MaxCircuits *ServiceLimit `json:"maxCircuits"`
+
+		MaxCircuitsPerClient *ServiceLimit `json:"maxCircuitsPerClient"`
+
+		MaxDialRate *ServiceLimit `json:"maxDialRate"`
+
+		MaxRouterBandwidth *RouterBandwidthLimit `json:"maxRouterBandwidth"`
+
 		Name *string `json:"name"`
 
+		Priority *ServicePriority `json:"priority"`
+
 		TerminatorStrategy *string `json:"terminatorStrategy"`
 	}
 
+	dataAO1.MaxCircuitBandwidth = m.MaxCircuitBandwidth
+
+	dataAO1.MaxCircuits = m.MaxCircuits
+
+	dataAO1.MaxCircuitsPerClient = m.MaxCircuitsPerClient
+
+	dataAO1.MaxDialRate = m.MaxDialRate
+
+	dataAO1.MaxRouterBandwidth = m.MaxRouterBandwidth
+
 	dataAO1.Name = m.Name
 
+	dataAO1.Priority = m.Priority
+
 	dataAO1.TerminatorStrategy = m.TerminatorStrategy
 
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
@@ -115,10 +187,34 @@ func (m *ServiceDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaxCircuitBandwidth(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxCircuits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxCircuitsPerClient(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxDialRate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxRouterBandwidth(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePriority(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTerminatorStrategy(formats); err != nil {
 		res = append(res, err)
 	}
@@ -129,6 +225,126 @@ func (m *ServiceDetail) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceDetail) validateMaxCircuitBandwidth(formats strfmt.Registry) error {
+
+	if err := validate.Required("maxCircuitBandwidth", "body", m.MaxCircuitBandwidth); err != nil {
+		return err
+	}
+
+	if err := validate.Required("maxCircuitBandwidth", "body", m.MaxCircuitBandwidth); err != nil {
+		return err
+	}
+
+	if m.MaxCircuitBandwidth != nil {
+		if err := m.MaxCircuitBandwidth.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) validateMaxCircuits(formats strfmt.Registry) error {
+
+	if err := validate.Required("maxCircuits", "body", m.MaxCircuits); err != nil {
+		return err
+	}
+
+	if err := validate.Required("maxCircuits", "body", m.MaxCircuits); err != nil {
+		return err
+	}
+
+	if m.MaxCircuits != nil {
+		if err := m.MaxCircuits.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuits")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuits")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) validateMaxCircuitsPerClient(formats strfmt.Registry) error {
+
+	if err := validate.Required("maxCircuitsPerClient", "body", m.MaxCircuitsPerClient); err != nil {
+		return err
+	}
+
+	if err := validate.Required("maxCircuitsPerClient", "body", m.MaxCircuitsPerClient); err != nil {
+		return err
+	}
+
+	if m.MaxCircuitsPerClient != nil {
+		if err := m.MaxCircuitsPerClient.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitsPerClient")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitsPerClient")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) validateMaxDialRate(formats strfmt.Registry) error {
+
+	if err := validate.Required("maxDialRate", "body", m.MaxDialRate); err != nil {
+		return err
+	}
+
+	if err := validate.Required("maxDialRate", "body", m.MaxDialRate); err != nil {
+		return err
+	}
+
+	if m.MaxDialRate != nil {
+		if err := m.MaxDialRate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxDialRate")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxDialRate")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) validateMaxRouterBandwidth(formats strfmt.Registry) error {
+
+	if err := validate.Required("maxRouterBandwidth", "body", m.MaxRouterBandwidth); err != nil {
+		return err
+	}
+
+	if err := validate.Required("maxRouterBandwidth", "body", m.MaxRouterBandwidth); err != nil {
+		return err
+	}
+
+	if m.MaxRouterBandwidth != nil {
+		if err := m.MaxRouterBandwidth.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxRouterBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxRouterBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceDetail) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -138,6 +354,30 @@ func (m *ServiceDetail) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceDetail) validatePriority(formats strfmt.Registry) error {
+
+	if err := validate.Required("priority", "body", m.Priority); err != nil {
+		return err
+	}
+
+	if err := validate.Required("priority", "body", m.Priority); err != nil {
+		return err
+	}
+
+	if m.Priority != nil {
+		if err := m.Priority.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("priority")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("priority")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceDetail) validateTerminatorStrategy(formats strfmt.Registry) error {
 
 	if err := validate.Required("terminatorStrategy", "body", m.TerminatorStrategy); err != nil {
@@ -156,12 +396,132 @@ func (m *ServiceDetail) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMaxCircuitBandwidth(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxCircuits(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxCircuitsPerClient(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxDialRate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxRouterBandwidth(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePriority(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ServiceDetail) contextValidateMaxCircuitBandwidth(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxCircuitBandwidth != nil {
+		if err := m.MaxCircuitBandwidth.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateMaxCircuits(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxCircuits != nil {
+		if err := m.MaxCircuits.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuits")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuits")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateMaxCircuitsPerClient(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxCircuitsPerClient != nil {
+		if err := m.MaxCircuitsPerClient.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitsPerClient")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitsPerClient")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateMaxDialRate(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxDialRate != nil {
+		if err := m.MaxDialRate.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxDialRate")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxDialRate")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidateMaxRouterBandwidth(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxRouterBandwidth != nil {
+		if err := m.MaxRouterBandwidth.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxRouterBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxRouterBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceDetail) contextValidatePriority(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Priority != nil {
+		if err := m.Priority.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("priority")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("priority")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServiceDetail) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -43,10 +43,28 @@ import (
 // swagger:model serviceUpdate
 type ServiceUpdate struct {
 
+	// max circuit bandwidth
+	MaxCircuitBandwidth *BandwidthLimit `json:"maxCircuitBandwidth,omitempty"`
+
+	// max circuits
+	MaxCircuits *ServiceLimit `json:"maxCircuits,omitempty"`
+
+	// max circuits per client
+	MaxCircuitsPerClient *ServiceLimit `json:"maxCircuitsPerClient,omitempty"`
+
+	// max dial rate
+	MaxDialRate *ServiceLimit `json:"maxDialRate,omitempty"`
+
+	// max router bandwidth
+	MaxRouterBandwidth *RouterBandwidthLimit `json:"maxRouterBandwidth,omitempty"`
+
 	// name
 	// Required: true
 	Name *string `json:"name"`
 
+	// priority
+	Priority ServicePriority `json:"priority,omitempty"`
+
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
 
@@ -58,10 +76,34 @@ type ServiceUpdate struct {
 func (m *ServiceUpdate) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateMaxCircuitBandwidth(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxCircuits(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxCircuitsPerClient(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxDialRate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxRouterBandwidth(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePriority(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTags(formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,6 +114,101 @@ func (m *ServiceUpdate) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceUpdate) validateMaxCircuitBandwidth(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxCircuitBandwidth) { // not required
+		return nil
+	}
+
+	if m.MaxCircuitBandwidth != nil {
+		if err := m.MaxCircuitBandwidth.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) validateMaxCircuits(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxCircuits) { // not required
+		return nil
+	}
+
+	if m.MaxCircuits != nil {
+		if err := m.MaxCircuits.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuits")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuits")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) validateMaxCircuitsPerClient(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxCircuitsPerClient) { // not required
+		return nil
+	}
+
+	if m.MaxCircuitsPerClient != nil {
+		if err := m.MaxCircuitsPerClient.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitsPerClient")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitsPerClient")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) validateMaxDialRate(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxDialRate) { // not required
+		return nil
+	}
+
+	if m.MaxDialRate != nil {
+		if err := m.MaxDialRate.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxDialRate")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxDialRate")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) validateMaxRouterBandwidth(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxRouterBandwidth) { // not required
+		return nil
+	}
+
+	if m.MaxRouterBandwidth != nil {
+		if err := m.MaxRouterBandwidth.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxRouterBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxRouterBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ServiceUpdate) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -81,6 +218,23 @@ func (m *ServiceUpdate) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceUpdate) validatePriority(formats strfmt.Registry) error {
+	if swag.IsZero(m.Priority) { // not required
+		return nil
+	}
+
+	if err := m.Priority.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("priority")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("priority")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceUpdate) validateTags(formats strfmt.Registry) error {
 	if swag.IsZero(m.Tags) { // not required
 		return nil
@@ -104,6 +258,30 @@ func (m *ServiceUpdate) validateTags(formats strfmt.Registry) error {
 func (m *ServiceUpdate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateMaxCircuitBandwidth(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxCircuits(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxCircuitsPerClient(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxDialRate(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMaxRouterBandwidth(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePriority(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -114,6 +292,100 @@ func (m *ServiceUpdate) ContextValidate(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *ServiceUpdate) contextValidateMaxCircuitBandwidth(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxCircuitBandwidth != nil {
+		if err := m.MaxCircuitBandwidth.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) contextValidateMaxCircuits(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxCircuits != nil {
+		if err := m.MaxCircuits.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuits")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuits")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) contextValidateMaxCircuitsPerClient(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxCircuitsPerClient != nil {
+		if err := m.MaxCircuitsPerClient.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxCircuitsPerClient")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxCircuitsPerClient")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) contextValidateMaxDialRate(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxDialRate != nil {
+		if err := m.MaxDialRate.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxDialRate")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxDialRate")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) contextValidateMaxRouterBandwidth(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.MaxRouterBandwidth != nil {
+		if err := m.MaxRouterBandwidth.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maxRouterBandwidth")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("maxRouterBandwidth")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ServiceUpdate) contextValidatePriority(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Priority.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("priority")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("priority")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ServiceUpdate) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Tags != nil {
@@ -77,6 +77,9 @@ type TerminatorCreate struct {
 
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
+
+	// weight
+	Weight TerminatorWeight `json:"weight,omitempty"`
 }
 
 // Validate validates this terminator create
@@ -111,6 +114,10 @@ func (m *TerminatorCreate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateWeight(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -208,6 +215,23 @@ func (m *TerminatorCreate) validateTags(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorCreate) validateWeight(formats strfmt.Registry) error {
+	if swag.IsZero(m.Weight) { // not required
+		return nil
+	}
+
+	if err := m.Weight.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("weight")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("weight")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this terminator create based on the context it is used
 func (m *TerminatorCreate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -224,6 +248,10 @@ func (m *TerminatorCreate) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateWeight(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -276,6 +304,20 @@ func (m *TerminatorCreate) contextValidateTags(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *TerminatorCreate) contextValidateWeight(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Weight.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("weight")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("weight")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *TerminatorCreate) MarshalBinary() ([]byte, error) {
 	if m == nil {
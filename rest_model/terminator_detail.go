@@ -87,6 +87,10 @@ type TerminatorDetail struct {
 	// service Id
 	// Required: true
 	ServiceID *string `json:"serviceId"`
+
+	// weight
+	// Required: true
+	Weight *TerminatorWeight `json:"weight"`
 }
 
 // UnmarshalJSON unmarshals this object from a JSON structure
@@ -121,6 +125,8 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 		Service *EntityRef `json:"service"`
 
 		ServiceID *string `json:"serviceId"`
+
+		Weight *TerminatorWeight `json:"weight"`
 	}
 	if err := swag.ReadJSON(raw, &dataAO1); err != nil {
 		return err
@@ -148,6 +154,8 @@ func (m *TerminatorDetail) UnmarshalJSON(raw []byte) error {
 
 	m.ServiceID = dataAO1.ServiceID
 
+	m.Weight = dataAO1.Weight
+
 	return nil
 }
 
@@ -182,6 +190,8 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 		Service *EntityRef `json:"service"`
 
 		ServiceID *string `json:"serviceId"`
+
+		Weight *TerminatorWeight `json:"weight"`
 	}
 
 	dataAO1.Address = m.Address
@@ -206,6 +216,8 @@ func (m TerminatorDetail) MarshalJSON() ([]byte, error) {
 
 	dataAO1.ServiceID = m.ServiceID
 
+	dataAO1.Weight = m.Weight
+
 	jsonDataAO1, errAO1 := swag.WriteJSON(dataAO1)
 	if errAO1 != nil {
 		return nil, errAO1
@@ -267,6 +279,10 @@ func (m *TerminatorDetail) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateWeight(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -439,6 +455,30 @@ func (m *TerminatorDetail) validateServiceID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorDetail) validateWeight(formats strfmt.Registry) error {
+
+	if err := validate.Required("weight", "body", m.Weight); err != nil {
+		return err
+	}
+
+	if err := validate.Required("weight", "body", m.Weight); err != nil {
+		return err
+	}
+
+	if m.Weight != nil {
+		if err := m.Weight.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("weight")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("weight")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this terminator detail based on the context it is used
 func (m *TerminatorDetail) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -468,6 +508,10 @@ func (m *TerminatorDetail) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateWeight(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -554,6 +598,22 @@ func (m *TerminatorDetail) contextValidateService(ctx context.Context, formats s
 	return nil
 }
 
+func (m *TerminatorDetail) contextValidateWeight(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Weight != nil {
+		if err := m.Weight.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("weight")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("weight")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *TerminatorDetail) MarshalBinary() ([]byte, error) {
 	if m == nil {
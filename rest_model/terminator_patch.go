@@ -65,6 +65,9 @@ type TerminatorPatch struct {
 
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
+
+	// weight
+	Weight TerminatorWeight `json:"weight,omitempty"`
 }
 
 // Validate validates this terminator patch
@@ -83,6 +86,10 @@ func (m *TerminatorPatch) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateWeight(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -144,6 +151,23 @@ func (m *TerminatorPatch) validateTags(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorPatch) validateWeight(formats strfmt.Registry) error {
+	if swag.IsZero(m.Weight) { // not required
+		return nil
+	}
+
+	if err := m.Weight.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("weight")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("weight")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this terminator patch based on the context it is used
 func (m *TerminatorPatch) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -160,6 +184,10 @@ func (m *TerminatorPatch) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateWeight(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -212,6 +240,20 @@ func (m *TerminatorPatch) contextValidateTags(ctx context.Context, formats strfm
 	return nil
 }
 
+func (m *TerminatorPatch) contextValidateWeight(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Weight.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("weight")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("weight")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *TerminatorPatch) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -70,6 +70,9 @@ type TerminatorUpdate struct {
 
 	// tags
 	Tags *Tags `json:"tags,omitempty"`
+
+	// weight
+	Weight TerminatorWeight `json:"weight,omitempty"`
 }
 
 // Validate validates this terminator update
@@ -104,6 +107,10 @@ func (m *TerminatorUpdate) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateWeight(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -201,6 +208,23 @@ func (m *TerminatorUpdate) validateTags(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *TerminatorUpdate) validateWeight(formats strfmt.Registry) error {
+	if swag.IsZero(m.Weight) { // not required
+		return nil
+	}
+
+	if err := m.Weight.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("weight")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("weight")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this terminator update based on the context it is used
 func (m *TerminatorUpdate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -217,6 +241,10 @@ func (m *TerminatorUpdate) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateWeight(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -269,6 +297,20 @@ func (m *TerminatorUpdate) contextValidateTags(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *TerminatorUpdate) contextValidateWeight(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Weight.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("weight")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("weight")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *TerminatorUpdate) MarshalBinary() ([]byte, error) {
 	if m == nil {
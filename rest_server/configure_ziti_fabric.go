@@ -28,20 +28,24 @@ package rest_server
 
 import (
 	"crypto/tls"
+	"io"
 	"net/http"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/yamlpc"
 
 	"github.com/openziti/fabric/rest_server/operations"
 	"github.com/openziti/fabric/rest_server/operations/circuit"
 	"github.com/openziti/fabric/rest_server/operations/database"
 	"github.com/openziti/fabric/rest_server/operations/inspect"
 	"github.com/openziti/fabric/rest_server/operations/link"
+	"github.com/openziti/fabric/rest_server/operations/raft"
 	"github.com/openziti/fabric/rest_server/operations/router"
 	"github.com/openziti/fabric/rest_server/operations/service"
 	"github.com/openziti/fabric/rest_server/operations/terminator"
+	"github.com/openziti/fabric/rest_server/operations/topology"
 )
 
 //go:generate swagger generate server --target ../../fabric --name ZitiFabric --spec ../specs/swagger.yml --model-package rest_model --server-package rest_server --principal interface{} --exclude-main
@@ -65,8 +69,13 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 	// api.UseRedoc()
 
 	api.JSONConsumer = runtime.JSONConsumer()
+	api.YamlConsumer = yamlpc.YAMLConsumer()
 
 	api.JSONProducer = runtime.JSONProducer()
+	api.TextVndGraphvizProducer = runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+		return errors.NotImplemented("textVndGraphviz producer has not yet been implemented")
+	})
+	api.YamlProducer = yamlpc.YAMLProducer()
 
 	if api.DatabaseCheckDataIntegrityHandler == nil {
 		api.DatabaseCheckDataIntegrityHandler = database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
@@ -143,16 +152,36 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation service.DetailService has not yet been implemented")
 		})
 	}
+	if api.ServiceDetailServiceStatsHandler == nil {
+		api.ServiceDetailServiceStatsHandler = service.DetailServiceStatsHandlerFunc(func(params service.DetailServiceStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.DetailServiceStats has not yet been implemented")
+		})
+	}
 	if api.TerminatorDetailTerminatorHandler == nil {
 		api.TerminatorDetailTerminatorHandler = terminator.DetailTerminatorHandlerFunc(func(params terminator.DetailTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.DetailTerminator has not yet been implemented")
 		})
 	}
+	if api.DatabaseExportDatabaseHandler == nil {
+		api.DatabaseExportDatabaseHandler = database.ExportDatabaseHandlerFunc(func(params database.ExportDatabaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ExportDatabase has not yet been implemented")
+		})
+	}
 	if api.DatabaseFixDataIntegrityHandler == nil {
 		api.DatabaseFixDataIntegrityHandler = database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.FixDataIntegrity has not yet been implemented")
 		})
 	}
+	if api.TopologyGetTopologyHandler == nil {
+		api.TopologyGetTopologyHandler = topology.GetTopologyHandlerFunc(func(params topology.GetTopologyParams) middleware.Responder {
+			return middleware.NotImplemented("operation topology.GetTopology has not yet been implemented")
+		})
+	}
+	if api.DatabaseImportDatabaseHandler == nil {
+		api.DatabaseImportDatabaseHandler = database.ImportDatabaseHandlerFunc(func(params database.ImportDatabaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ImportDatabase has not yet been implemented")
+		})
+	}
 	if api.InspectInspectHandler == nil {
 		api.InspectInspectHandler = inspect.InspectHandlerFunc(func(params inspect.InspectParams) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
@@ -178,6 +207,11 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation router.ListRouters has not yet been implemented")
 		})
 	}
+	if api.ServiceListServiceStatsHandler == nil {
+		api.ServiceListServiceStatsHandler = service.ListServiceStatsHandlerFunc(func(params service.ListServiceStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.ListServiceStats has not yet been implemented")
+		})
+	}
 	if api.ServiceListServiceTerminatorsHandler == nil {
 		api.ServiceListServiceTerminatorsHandler = service.ListServiceTerminatorsHandlerFunc(func(params service.ListServiceTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.ListServiceTerminators has not yet been implemented")
@@ -213,6 +247,41 @@ func configureAPI(api *operations.ZitiFabricAPI) http.Handler {
 			return middleware.NotImplemented("operation terminator.PatchTerminator has not yet been implemented")
 		})
 	}
+	if api.RaftRaftAddMemberHandler == nil {
+		api.RaftRaftAddMemberHandler = raft.RaftAddMemberHandlerFunc(func(params raft.RaftAddMemberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftAddMember has not yet been implemented")
+		})
+	}
+	if api.RaftRaftClusterStatusHandler == nil {
+		api.RaftRaftClusterStatusHandler = raft.RaftClusterStatusHandlerFunc(func(params raft.RaftClusterStatusParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftClusterStatus has not yet been implemented")
+		})
+	}
+	if api.RaftRaftListMembersHandler == nil {
+		api.RaftRaftListMembersHandler = raft.RaftListMembersHandlerFunc(func(params raft.RaftListMembersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftListMembers has not yet been implemented")
+		})
+	}
+	if api.RaftRaftRemoveMemberHandler == nil {
+		api.RaftRaftRemoveMemberHandler = raft.RaftRemoveMemberHandlerFunc(func(params raft.RaftRemoveMemberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftRemoveMember has not yet been implemented")
+		})
+	}
+	if api.RaftRaftTransferLeadershipHandler == nil {
+		api.RaftRaftTransferLeadershipHandler = raft.RaftTransferLeadershipHandlerFunc(func(params raft.RaftTransferLeadershipParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftTransferLeadership has not yet been implemented")
+		})
+	}
+	if api.DatabaseReconcileDatabaseHandler == nil {
+		api.DatabaseReconcileDatabaseHandler = database.ReconcileDatabaseHandlerFunc(func(params database.ReconcileDatabaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ReconcileDatabase has not yet been implemented")
+		})
+	}
+	if api.CircuitTerminateCircuitsHandler == nil {
+		api.CircuitTerminateCircuitsHandler = circuit.TerminateCircuitsHandlerFunc(func(params circuit.TerminateCircuitsParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit.TerminateCircuits has not yet been implemented")
+		})
+	}
 	if api.RouterUpdateRouterHandler == nil {
 		api.RouterUpdateRouterHandler = router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
@@ -34,9 +34,12 @@
 //
 //  Consumes:
 //    - application/json
+//    - application/x-yaml
 //
 //  Produces:
 //    - application/json
+//    - text/vnd.graphviz
+//    - application/x-yaml
 //
 // swagger:meta
 package rest_server
@@ -78,6 +78,44 @@ func init() {
         }
       }
     },
+    "/circuits/terminate": {
+      "post": {
+        "description": "Terminates all circuits matching every criterion given in the filter. At least one criterion must be given. If\ndryRun is set, the matching circuits are reported but not terminated. Requires admin access.\n",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Terminates all circuits matching a filter",
+        "operationId": "terminateCircuits",
+        "parameters": [
+          {
+            "description": "The criteria circuits must match to be terminated",
+            "name": "filter",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitTerminateFilter"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Report the circuits which match, without terminating them",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/terminateCircuits"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
     "/circuits/{id}": {
       "get": {
         "description": "Retrieves a single circuit by id. Requires admin access.",
@@ -86,6 +124,14 @@ func init() {
         ],
         "summary": "Retrieves a single circuit",
         "operationId": "detailCircuit",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "If true, the routers on the circuit path are queried for their live statistics for the circuit",
+            "name": "includeRouterStats",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "$ref": "#/responses/detailCircuit"
@@ -211,6 +257,33 @@ func init() {
         }
       }
     },
+    "/database/export": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Exports all services, routers and terminators, including their tags. The result can be passed to importDatabase\nas is. Returns JSON or YAML, depending on the Accept header. Requires admin access.\n",
+        "produces": [
+          "application/json",
+          "application/x-yaml"
+        ],
+        "tags": [
+          "Database"
+        ],
+        "summary": "Exports services, routers and terminators",
+        "operationId": "exportDatabase",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/databaseExport"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
     "/database/fix-data-integrity": {
       "post": {
         "security": [
@@ -237,6 +310,100 @@ func init() {
         }
       }
     },
+    "/database/import": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Creates or updates the given services, routers and terminators in a single transaction. Entities are matched\non id, or on name for services and routers given without an id. Entities which aren't part of the import are\nleft unchanged. If dryRun is set, the changes are reported but not applied. Accepts JSON or YAML. Requires\nadmin access.\n",
+        "consumes": [
+          "application/json",
+          "application/x-yaml"
+        ],
+        "tags": [
+          "Database"
+        ],
+        "summary": "Creates or updates services, routers and terminators in a single transaction",
+        "operationId": "importDatabase",
+        "parameters": [
+          {
+            "description": "The entities to import",
+            "name": "entities",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/databaseEntities"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Report the changes the import would make, without applying them",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/databaseImport"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/database/reconcile": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Makes the services, routers and terminators match the given desired state in a single transaction, returning\nthe creates, updates and deletes required to do so. Works like importDatabase, except that services, routers\nand static terminators which aren't part of the desired state are deleted. Terminators with a host id or\ninstance secret are managed by hosting applications and are only deleted along with their service or router.\nIf dryRun is set, the plan is reported but not applied. Accepts JSON or YAML. Requires admin access.\n",
+        "consumes": [
+          "application/json",
+          "application/x-yaml"
+        ],
+        "tags": [
+          "Database"
+        ],
+        "summary": "Makes services, routers and terminators match the given desired state",
+        "operationId": "reconcileDatabase",
+        "parameters": [
+          {
+            "description": "The desired set of entities",
+            "name": "entities",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/databaseEntities"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Report the plan, without applying it",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/databaseImport"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
     "/inspections": {
       "post": {
         "description": "Requests system information, such as stack dumps or information about capabilities. Requires admin access.\n",
@@ -362,6 +529,160 @@ func init() {
         }
       ]
     },
+    "/raft/members": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Lists the members of the raft cluster. Requires admin access.",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Lists the members of the raft cluster",
+        "operationId": "raftListMembers",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/raftMemberList"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Adds a controller to the raft cluster. If this controller isn't the leader, the request is forwarded to the\nleader. Requires admin access.\n",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Adds a member to the raft cluster",
+        "operationId": "raftAddMember",
+        "parameters": [
+          {
+            "description": "The member to add",
+            "name": "member",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/raftMemberAdd"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/raft/members/{id}": {
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Removes a controller from the raft cluster. If this controller isn't the leader, the request is forwarded to\nthe leader. Requires admin access.\n",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Removes a member from the raft cluster",
+        "operationId": "raftRemoveMember",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/deleteResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
+    "/raft/status": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Returns the raft state of this controller, the current leader, the commit and applied indexes, the latest\nsnapshot and the cluster members, including when each member was last heard from. Requires admin access.\n",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Returns the status of the raft cluster",
+        "operationId": "raftClusterStatus",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/raftClusterStatus"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/raft/transfer-leadership": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Transfers leadership of the raft cluster to the given member or, if no member is given, to the most up to date\nvoting member. If this controller isn't the leader, the request is forwarded to the leader. Requires admin\naccess.\n",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Transfers leadership of the raft cluster to another member",
+        "operationId": "raftTransferLeadership",
+        "parameters": [
+          {
+            "description": "The member which should become the leader",
+            "name": "transfer",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/raftTransferLeadership"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/emptyResponse"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
     "/routers": {
       "get": {
         "description": "Retrieves a list of router resources; supports filtering, sorting, and pagination. Requires admin access.\n",
@@ -379,6 +700,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/filter"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
           }
         ],
         "responses": {
@@ -449,6 +776,9 @@ func init() {
         "summary": "Update all fields on a router",
         "operationId": "updateRouter",
         "parameters": [
+          {
+            "$ref": "#/parameters/ifMatch"
+          },
           {
             "description": "A router update object",
             "name": "router",
@@ -471,6 +801,9 @@ func init() {
           },
           "404": {
             "$ref": "#/responses/notFoundResponse"
+          },
+          "412": {
+            "$ref": "#/responses/preconditionFailedResponse"
           }
         }
       },
@@ -504,6 +837,9 @@ func init() {
         "summary": "Update the supplied fields on a router",
         "operationId": "patchRouter",
         "parameters": [
+          {
+            "$ref": "#/parameters/ifMatch"
+          },
           {
             "description": "A router patch object",
             "name": "router",
@@ -526,6 +862,9 @@ func init() {
           },
           "404": {
             "$ref": "#/responses/notFoundResponse"
+          },
+          "412": {
+            "$ref": "#/responses/preconditionFailedResponse"
           }
         }
       },
@@ -552,6 +891,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/filter"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
           }
         ],
         "responses": {
@@ -572,9 +917,47 @@ func init() {
         }
       ]
     },
-    "/services": {
+    "/service-stats": {
       "get": {
-        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
+        "description": "Returns the rolling stats for each service, as given by detailServiceStats; supports filtering, sorting, and\npagination on the services.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Lists the rolling stats for services",
+        "operationId": "listServiceStats",
+        "parameters": [
+          {
+            "$ref": "#/parameters/limit"
+          },
+          {
+            "$ref": "#/parameters/offset"
+          },
+          {
+            "$ref": "#/parameters/filter"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/listServiceStats"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
+    },
+    "/services": {
+      "get": {
+        "description": "Retrieves a list of service resources; supports filtering, sorting, and pagination. Requires admin access.\n",
         "tags": [
           "Service"
         ],
@@ -589,6 +972,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/filter"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
           }
         ],
         "responses": {
@@ -659,6 +1048,9 @@ func init() {
         "summary": "Update all fields on a service",
         "operationId": "updateService",
         "parameters": [
+          {
+            "$ref": "#/parameters/ifMatch"
+          },
           {
             "description": "A service update object",
             "name": "service",
@@ -681,6 +1073,9 @@ func init() {
           },
           "404": {
             "$ref": "#/responses/notFoundResponse"
+          },
+          "412": {
+            "$ref": "#/responses/preconditionFailedResponse"
           }
         }
       },
@@ -714,6 +1109,9 @@ func init() {
         "summary": "Update the supplied fields on a service",
         "operationId": "patchService",
         "parameters": [
+          {
+            "$ref": "#/parameters/ifMatch"
+          },
           {
             "description": "A service patch object",
             "name": "service",
@@ -734,6 +1132,35 @@ func init() {
           "401": {
             "$ref": "#/responses/unauthorizedResponse"
           },
+          "404": {
+            "$ref": "#/responses/notFoundResponse"
+          },
+          "412": {
+            "$ref": "#/responses/preconditionFailedResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/id"
+        }
+      ]
+    },
+    "/services/{id}/stats": {
+      "get": {
+        "description": "Returns dial successes and failures by cause, active circuits, bytes sent and received by clients and the\ndistribution across terminators for a service, aggregated by the controller over the configured service stats\nwindow.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Returns the rolling stats for a service",
+        "operationId": "detailServiceStats",
+        "responses": {
+          "200": {
+            "$ref": "#/responses/detailServiceStats"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          },
           "404": {
             "$ref": "#/responses/notFoundResponse"
           }
@@ -762,6 +1189,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/filter"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
           }
         ],
         "responses": {
@@ -799,6 +1232,12 @@ func init() {
           },
           {
             "$ref": "#/parameters/filter"
+          },
+          {
+            "$ref": "#/parameters/cursor"
+          },
+          {
+            "$ref": "#/parameters/fields"
           }
         ],
         "responses": {
@@ -872,6 +1311,9 @@ func init() {
         "summary": "Update all fields on a terminator",
         "operationId": "updateTerminator",
         "parameters": [
+          {
+            "$ref": "#/parameters/ifMatch"
+          },
           {
             "description": "A terminator update object",
             "name": "terminator",
@@ -894,6 +1336,9 @@ func init() {
           },
           "404": {
             "$ref": "#/responses/notFoundResponse"
+          },
+          "412": {
+            "$ref": "#/responses/preconditionFailedResponse"
           }
         }
       },
@@ -927,6 +1372,9 @@ func init() {
         "summary": "Update the supplied fields on a terminator",
         "operationId": "patchTerminator",
         "parameters": [
+          {
+            "$ref": "#/parameters/ifMatch"
+          },
           {
             "description": "A terminator patch object",
             "name": "terminator",
@@ -949,6 +1397,9 @@ func init() {
           },
           "404": {
             "$ref": "#/responses/notFoundResponse"
+          },
+          "412": {
+            "$ref": "#/responses/preconditionFailedResponse"
           }
         }
       },
@@ -957,6 +1408,50 @@ func init() {
           "$ref": "#/parameters/id"
         }
       ]
+    },
+    "/topology": {
+      "get": {
+        "description": "Exports the connected routers and the links between them as a directed node-link graph, or in the Graphviz DOT\nlanguage if format is dot. Link sources are the dialing routers. If includeCircuits is set, each link includes\nthe number of circuits currently routed over it.\n",
+        "produces": [
+          "application/json",
+          "text/vnd.graphviz"
+        ],
+        "tags": [
+          "Topology"
+        ],
+        "summary": "Exports the network topology",
+        "operationId": "getTopology",
+        "parameters": [
+          {
+            "enum": [
+              "json",
+              "dot"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "The format to export the topology in",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, each link includes the number of circuits currently routed over it",
+            "name": "includeCircuits",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "$ref": "#/responses/topology"
+          },
+          "400": {
+            "$ref": "#/responses/badRequestResponse"
+          },
+          "401": {
+            "$ref": "#/responses/unauthorizedResponse"
+          }
+        }
+      }
     }
   },
   "definitions": {
@@ -1037,6 +1532,11 @@ func init() {
         }
       }
     },
+    "bandwidthLimit": {
+      "description": "A bandwidth limit in bytes per second. A value of 0 means unlimited",
+      "type": "integer",
+      "format": "int64"
+    },
     "baseEntity": {
       "description": "Fields shared by all Edge API entities",
       "type": "object",
@@ -1112,6 +1612,13 @@ func init() {
             }
           }
         },
+        "routerStats": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitRouterStats"
+          },
+          "x-omitempty": true
+        },
         "service": {
           "$ref": "#/definitions/entityRef"
         },
@@ -1126,6 +1633,158 @@ func init() {
         "$ref": "#/definitions/circuitDetail"
       }
     },
+    "circuitRouterStats": {
+      "type": "object",
+      "required": [
+        "router"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
+          "x-omitempty": true
+        },
+        "router": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "timeSinceLastActivity": {
+          "description": "How long ago the router last forwarded a message for the circuit",
+          "type": "string"
+        },
+        "xgress": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitXgressStats"
+          }
+        }
+      }
+    },
+    "circuitTerminateEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitTerminateResult"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "circuitTerminateFilter": {
+      "type": "object",
+      "properties": {
+        "clientId": {
+          "type": "string"
+        },
+        "immediate": {
+          "description": "If true, routers remove the circuits immediately rather than draining them",
+          "type": "boolean"
+        },
+        "minAge": {
+          "description": "Matches circuits which have existed for at least this long, given as a duration, such as 10m",
+          "type": "string"
+        },
+        "routerId": {
+          "description": "Matches circuits which have this router on their path",
+          "type": "string"
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "terminatorId": {
+          "type": "string"
+        }
+      }
+    },
+    "circuitTerminateResult": {
+      "type": "object",
+      "required": [
+        "dryRun",
+        "circuitIds"
+      ],
+      "properties": {
+        "circuitIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "count": {
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "dryRun": {
+          "type": "boolean"
+        }
+      }
+    },
+    "circuitXgressStats": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "blockedByLocalWindow": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "blockedByRemoteWindow": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "bytesReceived": {
+          "description": "Bytes read from the xgress peer and forwarded onto the circuit",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "bytesSent": {
+          "description": "Bytes received from the circuit and written to the xgress peer",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "duplicateAcks": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "originator": {
+          "type": "string"
+        },
+        "receiveBufferPayloads": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "receiveBufferSize": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "retransmits": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "sendBufferSize": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "sendWindowSize": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "timeSinceLastLinkRx": {
+          "type": "string"
+        }
+      }
+    },
     "createEnvelope": {
       "type": "object",
       "properties": {
@@ -1221,37 +1880,64 @@ func init() {
         }
       }
     },
-    "detailCircuitEnvelope": {
+    "databaseEntities": {
       "type": "object",
-      "required": [
-        "meta",
-        "data"
-      ],
       "properties": {
-        "data": {
-          "$ref": "#/definitions/circuitDetail"
+        "routers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routerExport"
+          }
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
+        "services": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceExport"
+          }
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/terminatorExport"
+          }
         }
       }
     },
-    "detailLinkEnvelope": {
+    "databaseImportChange": {
       "type": "object",
       "required": [
-        "meta",
-        "data"
+        "entityType",
+        "id",
+        "action"
       ],
       "properties": {
-        "data": {
-          "$ref": "#/definitions/linkDetail"
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "unchanged",
+            "delete"
+          ]
         },
-        "meta": {
-          "$ref": "#/definitions/meta"
+        "changedFields": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "detailRouterEnvelope": {
+    "databaseImportEnvelope": {
       "type": "object",
       "required": [
         "meta",
@@ -1259,24 +1945,102 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/routerDetail"
+          "$ref": "#/definitions/databaseImportResult"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "detailServiceEnvelope": {
+    "databaseImportResult": {
       "type": "object",
       "required": [
-        "meta",
-        "data"
+        "dryRun",
+        "changes"
       ],
       "properties": {
-        "data": {
-          "$ref": "#/definitions/serviceDetail"
-        },
-        "meta": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/databaseImportChange"
+          }
+        },
+        "dryRun": {
+          "type": "boolean"
+        }
+      }
+    },
+    "detailCircuitEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailLinkEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailRouterEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/routerDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailServiceEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceDetail"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "detailServiceStatsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceStats"
+        },
+        "meta": {
           "$ref": "#/definitions/meta"
         }
       }
@@ -1531,6 +2295,21 @@ func init() {
         }
       }
     },
+    "listServiceStatsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceStatsList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "listServicesEnvelope": {
       "type": "object",
       "required": [
@@ -1594,6 +2373,11 @@ func init() {
           "type": "number",
           "format": "int64"
         },
+        "nextCursor": {
+          "description": "Present when more results are available. Pass as the cursor parameter to read the next page",
+          "type": "string",
+          "x-omitempty": true
+        },
         "offset": {
           "type": "number",
           "format": "int64"
@@ -1604,6 +2388,184 @@ func init() {
         }
       }
     },
+    "raftClusterStatus": {
+      "type": "object",
+      "required": [
+        "id",
+        "address",
+        "state",
+        "term",
+        "commitIndex",
+        "appliedIndex",
+        "lastLogIndex",
+        "snapshot",
+        "members"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "appliedIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "commitIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string"
+        },
+        "lastLogIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "leaderAddress": {
+          "description": "The address of the current leader, blank if there is no leader",
+          "type": "string"
+        },
+        "leaderId": {
+          "description": "The id of the current leader, blank if there is no leader",
+          "type": "string"
+        },
+        "members": {
+          "$ref": "#/definitions/raftMemberList"
+        },
+        "snapshot": {
+          "$ref": "#/definitions/raftSnapshotStatus"
+        },
+        "state": {
+          "description": "The raft state of this controller, one of Follower, Candidate, Leader or Shutdown",
+          "type": "string"
+        },
+        "term": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "raftClusterStatusEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/raftClusterStatus"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "raftMember": {
+      "type": "object",
+      "required": [
+        "id",
+        "address",
+        "voter",
+        "leader",
+        "connected"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "connected": {
+          "description": "True if this controller has a connection to the member. Always true for this controller",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "lastContact": {
+          "description": "When data was last received from the member, if known",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "leader": {
+          "type": "boolean"
+        },
+        "voter": {
+          "type": "boolean"
+        }
+      }
+    },
+    "raftMemberAdd": {
+      "type": "object",
+      "required": [
+        "id",
+        "address"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "isVoter": {
+          "type": "boolean",
+          "default": true
+        }
+      }
+    },
+    "raftMemberList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/raftMember"
+      }
+    },
+    "raftMemberListEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/raftMemberList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "raftSnapshotStatus": {
+      "type": "object",
+      "required": [
+        "lastIndex",
+        "lastTerm"
+      ],
+      "properties": {
+        "lastIndex": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastTerm": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "raftTransferLeadership": {
+      "type": "object",
+      "properties": {
+        "newLeaderId": {
+          "description": "The id of the member which should become the leader. If blank, raft picks the most up to date voter",
+          "type": "string"
+        }
+      }
+    },
+    "routerBandwidthLimit": {
+      "description": "A bandwidth limit in bytes per second, shared by all of a service's circuits on a router. Each router enforces the limit separately, so the service's total bandwidth across the network can exceed it. A value of 0 means unlimited",
+      "type": "integer",
+      "format": "int64"
+    },
     "routerCreate": {
       "type": "object",
       "required": [
@@ -1680,6 +2642,34 @@ func init() {
         }
       ]
     },
+    "routerExport": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "cost": {
+          "type": "integer",
+          "maximum": 65535
+        },
+        "fingerprint": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
     "routerList": {
       "type": "array",
       "items": {
@@ -1758,9 +2748,27 @@ func init() {
         "name"
       ],
       "properties": {
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -1779,12 +2787,36 @@ func init() {
           "type": "object",
           "required": [
             "name",
-            "terminatorStrategy"
+            "terminatorStrategy",
+            "maxCircuits",
+            "maxCircuitsPerClient",
+            "maxDialRate",
+            "priority",
+            "maxRouterBandwidth",
+            "maxCircuitBandwidth"
           ],
           "properties": {
+            "maxCircuitBandwidth": {
+              "$ref": "#/definitions/bandwidthLimit"
+            },
+            "maxCircuits": {
+              "$ref": "#/definitions/serviceLimit"
+            },
+            "maxCircuitsPerClient": {
+              "$ref": "#/definitions/serviceLimit"
+            },
+            "maxDialRate": {
+              "$ref": "#/definitions/serviceLimit"
+            },
+            "maxRouterBandwidth": {
+              "$ref": "#/definitions/routerBandwidthLimit"
+            },
             "name": {
               "type": "string"
             },
+            "priority": {
+              "$ref": "#/definitions/servicePriority"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
@@ -1792,8 +2824,51 @@ func init() {
         }
       ]
     },
-    "serviceList": {
-      "type": "array",
+    "serviceExport": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
+        "name": {
+          "type": "string"
+        },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceLimit": {
+      "description": "A limit on circuit creation for a service. A value of 0 means unlimited",
+      "type": "integer",
+      "format": "int32"
+    },
+    "serviceList": {
+      "type": "array",
       "items": {
         "$ref": "#/definitions/serviceDetail"
       }
@@ -1801,9 +2876,27 @@ func init() {
     "servicePatch": {
       "type": "object",
       "properties": {
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -1812,15 +2905,144 @@ func init() {
         }
       }
     },
+    "servicePriority": {
+      "description": "The priority class used when scheduling a service's traffic on router links",
+      "type": "string",
+      "enum": [
+        "standard",
+        "interactive",
+        "bulk"
+      ]
+    },
+    "serviceStats": {
+      "description": "Aggregates for a service over the window from windowStart to windowEnd. As with circuit failed events, dial\nfailures are counted per circuit creation attempt. Byte counts are as reported by ingress routers.\n",
+      "type": "object",
+      "required": [
+        "serviceId",
+        "windowStart",
+        "windowEnd",
+        "dials",
+        "dialSuccesses",
+        "dialFailures",
+        "failuresByCause",
+        "activeCircuits",
+        "rxBytes",
+        "txBytes",
+        "terminators"
+      ],
+      "properties": {
+        "activeCircuits": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dialFailures": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dialSuccesses": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dials": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failuresByCause": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "rxBytes": {
+          "description": "Bytes received from clients",
+          "type": "integer",
+          "format": "int64"
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceTerminatorStats"
+          }
+        },
+        "txBytes": {
+          "description": "Bytes sent to clients",
+          "type": "integer",
+          "format": "int64"
+        },
+        "windowEnd": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "windowStart": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "serviceStatsList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/serviceStats"
+      }
+    },
+    "serviceTerminatorStats": {
+      "description": "Dial attempts and active circuits for a single terminator. Retried dials count once per terminator tried",
+      "type": "object",
+      "required": [
+        "terminatorId",
+        "dialSuccesses",
+        "dialFailures",
+        "activeCircuits"
+      ],
+      "properties": {
+        "activeCircuits": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dialFailures": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dialSuccesses": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "terminatorId": {
+          "type": "string"
+        }
+      }
+    },
     "serviceUpdate": {
       "type": "object",
       "required": [
         "name"
       ],
       "properties": {
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -1893,6 +3115,9 @@ func init() {
         },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
         }
       }
     },
@@ -1915,7 +3140,8 @@ func init() {
             "cost",
             "precedence",
             "dynamicCost",
-            "hostId"
+            "hostId",
+            "weight"
           ],
           "properties": {
             "address": {
@@ -1950,11 +3176,69 @@ func init() {
             },
             "serviceId": {
               "type": "string"
+            },
+            "weight": {
+              "$ref": "#/definitions/terminatorWeight"
             }
           }
         }
       ]
     },
+    "terminatorExport": {
+      "type": "object",
+      "required": [
+        "id",
+        "service",
+        "router",
+        "address"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "cost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "hostId": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "instanceSecret": {
+          "type": "string",
+          "format": "byte"
+        },
+        "peerData": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string",
+            "format": "byte"
+          }
+        },
+        "precedence": {
+          "$ref": "#/definitions/terminatorPrecedence"
+        },
+        "router": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
+        }
+      }
+    },
     "terminatorList": {
       "type": "array",
       "items": {
@@ -1987,6 +3271,9 @@ func init() {
         },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
         }
       }
     },
@@ -2036,57 +3323,201 @@ func init() {
         },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
         }
       }
     },
-    "versionInfo": {
-      "description": "Application build information",
+    "terminatorWeight": {
+      "type": "integer",
+      "maximum": 65535,
+      "minimum": 1
+    },
+    "topology": {
       "type": "object",
+      "required": [
+        "directed",
+        "nodes",
+        "links"
+      ],
       "properties": {
-        "arch": {
+        "directed": {
+          "type": "boolean"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyLink"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyNode"
+          }
+        }
+      }
+    },
+    "topologyEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/topology"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "topologyLink": {
+      "type": "object",
+      "required": [
+        "id",
+        "source",
+        "target",
+        "protocol",
+        "state",
+        "down",
+        "cost",
+        "staticCost",
+        "sourceLatency",
+        "targetLatency"
+      ],
+      "properties": {
+        "circuitCount": {
+          "description": "The number of circuits routed over the link. Only present if includeCircuits was set",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "down": {
+          "type": "boolean"
+        },
+        "id": {
           "type": "string"
         },
-        "buildDate": {
+        "protocol": {
           "type": "string"
         },
-        "os": {
+        "source": {
+          "description": "The id of the dialing router",
           "type": "string"
         },
-        "revision": {
+        "sourceLatency": {
+          "type": "integer"
+        },
+        "state": {
           "type": "string"
         },
-        "version": {
+        "staticCost": {
+          "type": "integer"
+        },
+        "target": {
+          "description": "The id of the dialed router",
           "type": "string"
+        },
+        "targetLatency": {
+          "type": "integer"
         }
       }
-    }
-  },
-  "parameters": {
-    "filter": {
-      "type": "string",
-      "name": "filter",
-      "in": "query"
-    },
-    "id": {
-      "type": "string",
-      "description": "The id of the requested resource",
-      "name": "id",
-      "in": "path",
-      "required": true
-    },
-    "limit": {
-      "type": "integer",
-      "name": "limit",
-      "in": "query"
     },
-    "offset": {
-      "type": "integer",
-      "name": "offset",
-      "in": "query"
-    }
-  },
-  "responses": {
-    "badRequestResponse": {
+    "topologyNode": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "cost",
+        "noTraversal"
+      ],
+      "properties": {
+        "cost": {
+          "type": "integer",
+          "maximum": 65535
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
+        }
+      }
+    },
+    "versionInfo": {
+      "description": "Application build information",
+      "type": "object",
+      "properties": {
+        "arch": {
+          "type": "string"
+        },
+        "buildDate": {
+          "type": "string"
+        },
+        "os": {
+          "type": "string"
+        },
+        "revision": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "cursor": {
+      "type": "string",
+      "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+      "name": "cursor",
+      "in": "query"
+    },
+    "fields": {
+      "type": "string",
+      "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+      "name": "fields",
+      "in": "query"
+    },
+    "filter": {
+      "type": "string",
+      "name": "filter",
+      "in": "query"
+    },
+    "id": {
+      "type": "string",
+      "description": "The id of the requested resource",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "ifMatch": {
+      "type": "string",
+      "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "limit": {
+      "type": "integer",
+      "name": "limit",
+      "in": "query"
+    },
+    "offset": {
+      "type": "integer",
+      "name": "offset",
+      "in": "query"
+    }
+  },
+  "responses": {
+    "badRequestResponse": {
       "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
       "schema": {
         "$ref": "#/definitions/apiErrorEnvelope"
@@ -2160,6 +3591,18 @@ func init() {
         "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
       }
     },
+    "databaseExport": {
+      "description": "The exported services, routers and terminators",
+      "schema": {
+        "$ref": "#/definitions/databaseEntities"
+      }
+    },
+    "databaseImport": {
+      "description": "The changes made, or which would be made, by an import or reconcile",
+      "schema": {
+        "$ref": "#/definitions/databaseImportEnvelope"
+      }
+    },
     "deleteResponse": {
       "description": "The delete request was successful and the resource has been removed",
       "schema": {
@@ -2182,18 +3625,42 @@ func init() {
       "description": "A single router",
       "schema": {
         "$ref": "#/definitions/detailRouterEnvelope"
+      },
+      "headers": {
+        "ETag": {
+          "type": "string",
+          "description": "The current version of the router, for use with If-Match on updates"
+        }
       }
     },
     "detailService": {
       "description": "A single service",
       "schema": {
         "$ref": "#/definitions/detailServiceEnvelope"
+      },
+      "headers": {
+        "ETag": {
+          "type": "string",
+          "description": "The current version of the service, for use with If-Match on updates"
+        }
+      }
+    },
+    "detailServiceStats": {
+      "description": "The stats for a single service",
+      "schema": {
+        "$ref": "#/definitions/detailServiceStatsEnvelope"
       }
     },
     "detailTerminator": {
       "description": "A single terminator",
       "schema": {
         "$ref": "#/definitions/detailTerminatorEnvelope"
+      },
+      "headers": {
+        "ETag": {
+          "type": "string",
+          "description": "The current version of the terminator, for use with If-Match on updates"
+        }
       }
     },
     "emptyResponse": {
@@ -2250,6 +3717,12 @@ func init() {
         "$ref": "#/definitions/listRoutersEnvelope"
       }
     },
+    "listServiceStats": {
+      "description": "A list of service stats",
+      "schema": {
+        "$ref": "#/definitions/listServiceStatsEnvelope"
+      }
+    },
     "listServices": {
       "description": "A list of services",
       "schema": {
@@ -2294,6 +3767,42 @@ func init() {
         "$ref": "#/definitions/empty"
       }
     },
+    "preconditionFailedResponse": {
+      "description": "The entity has been modified since the version given in the If-Match header",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "cause": "",
+            "causeMessage": "",
+            "code": "ENTITY_VERSION_MISMATCH",
+            "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+            "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "raftClusterStatus": {
+      "description": "The status of the raft cluster",
+      "schema": {
+        "$ref": "#/definitions/raftClusterStatusEnvelope"
+      }
+    },
+    "raftMemberList": {
+      "description": "The members of the raft cluster",
+      "schema": {
+        "$ref": "#/definitions/raftMemberListEnvelope"
+      }
+    },
     "rateLimitedResponse": {
       "description": "The resource requested is rate limited and the rate limit has been exceeded",
       "schema": {
@@ -2317,6 +3826,18 @@ func init() {
         }
       }
     },
+    "terminateCircuits": {
+      "description": "The circuits terminated, or which would be terminated",
+      "schema": {
+        "$ref": "#/definitions/circuitTerminateEnvelope"
+      }
+    },
+    "topology": {
+      "description": "The network topology, as a node-link graph or in the DOT language",
+      "schema": {
+        "$ref": "#/definitions/topologyEnvelope"
+      }
+    },
     "unauthorizedResponse": {
       "description": "The currently supplied session does not have the correct access rights to request this resource",
       "schema": {
@@ -2410,6 +3931,102 @@ func init() {
         }
       }
     },
+    "/circuits/terminate": {
+      "post": {
+        "description": "Terminates all circuits matching every criterion given in the filter. At least one criterion must be given. If\ndryRun is set, the matching circuits are reported but not terminated. Requires admin access.\n",
+        "tags": [
+          "Circuit"
+        ],
+        "summary": "Terminates all circuits matching a filter",
+        "operationId": "terminateCircuits",
+        "parameters": [
+          {
+            "description": "The criteria circuits must match to be terminated",
+            "name": "filter",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/circuitTerminateFilter"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Report the circuits which match, without terminating them",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The circuits terminated, or which would be terminated",
+            "schema": {
+              "$ref": "#/definitions/circuitTerminateEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
     "/circuits/{id}": {
       "get": {
         "description": "Retrieves a single circuit by id. Requires admin access.",
@@ -2418,6 +4035,14 @@ func init() {
         ],
         "summary": "Retrieves a single circuit",
         "operationId": "detailCircuit",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "If true, the routers on the circuit path are queried for their live statistics for the circuit",
+            "name": "includeRouterStats",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "A single circuit",
@@ -2786,24 +4411,308 @@ func init() {
         }
       }
     },
-    "/database/fix-data-integrity": {
-      "post": {
+    "/database/export": {
+      "get": {
         "security": [
           {
             "ztSession": []
           }
         ],
-        "description": "Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.",
+        "description": "Exports all services, routers and terminators, including their tags. The result can be passed to importDatabase\nas is. Returns JSON or YAML, depending on the Accept header. Requires admin access.\n",
+        "produces": [
+          "application/json",
+          "application/x-yaml"
+        ],
         "tags": [
           "Database"
         ],
-        "summary": "Runs a data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
-        "operationId": "fixDataIntegrity",
+        "summary": "Exports services, routers and terminators",
+        "operationId": "exportDatabase",
         "responses": {
-          "202": {
-            "description": "Base empty response",
+          "200": {
+            "description": "The exported services, routers and terminators",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/databaseEntities"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/fix-data-integrity": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Runs a data integrity scan on the datastore, attempts to fix any issues it can, and returns any found issues. Requires admin access. Only once instance may run at a time, including runs of checkDataIntegrity.",
+        "tags": [
+          "Database"
+        ],
+        "summary": "Runs a data integrity scan on the datastore, attempts to fix any issues it can and returns any found issues",
+        "operationId": "fixDataIntegrity",
+        "responses": {
+          "202": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "429": {
+            "description": "The resource requested is rate limited and the rate limit has been exceeded",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "causeMessage": "you have hit a rate limit in the requested operation",
+                  "code": "RATE_LIMITED",
+                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/import": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Creates or updates the given services, routers and terminators in a single transaction. Entities are matched\non id, or on name for services and routers given without an id. Entities which aren't part of the import are\nleft unchanged. If dryRun is set, the changes are reported but not applied. Accepts JSON or YAML. Requires\nadmin access.\n",
+        "consumes": [
+          "application/json",
+          "application/x-yaml"
+        ],
+        "tags": [
+          "Database"
+        ],
+        "summary": "Creates or updates services, routers and terminators in a single transaction",
+        "operationId": "importDatabase",
+        "parameters": [
+          {
+            "description": "The entities to import",
+            "name": "entities",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/databaseEntities"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Report the changes the import would make, without applying them",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The changes made, or which would be made, by an import or reconcile",
+            "schema": {
+              "$ref": "#/definitions/databaseImportEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/database/reconcile": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Makes the services, routers and terminators match the given desired state in a single transaction, returning\nthe creates, updates and deletes required to do so. Works like importDatabase, except that services, routers\nand static terminators which aren't part of the desired state are deleted. Terminators with a host id or\ninstance secret are managed by hosting applications and are only deleted along with their service or router.\nIf dryRun is set, the plan is reported but not applied. Accepts JSON or YAML. Requires admin access.\n",
+        "consumes": [
+          "application/json",
+          "application/x-yaml"
+        ],
+        "tags": [
+          "Database"
+        ],
+        "summary": "Makes services, routers and terminators match the given desired state",
+        "operationId": "reconcileDatabase",
+        "parameters": [
+          {
+            "description": "The desired set of entities",
+            "name": "entities",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/databaseEntities"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Report the plan, without applying it",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The changes made, or which would be made, by an import or reconcile",
+            "schema": {
+              "$ref": "#/definitions/databaseImportEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
             }
           },
           "401": {
@@ -2829,29 +4738,6 @@ func init() {
                 }
               }
             }
-          },
-          "429": {
-            "description": "The resource requested is rate limited and the rate limit has been exceeded",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {}
-                  },
-                  "causeMessage": "you have hit a rate limit in the requested operation",
-                  "code": "RATE_LIMITED",
-                  "message": "The resource is rate limited and the rate limit has been exceeded. Please try again later",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
           }
         }
       }
@@ -3003,11 +4889,472 @@ func init() {
                       "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
                     }
                   },
-                  "cause": null,
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete a link by id. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Delete a link",
+        "operationId": "deleteLink",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a link. Requires admin access.",
+        "tags": [
+          "Link"
+        ],
+        "summary": "Update the supplied fields on a link",
+        "operationId": "patchLink",
+        "parameters": [
+          {
+            "description": "A link patch object",
+            "name": "link",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/linkPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/raft/members": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Lists the members of the raft cluster. Requires admin access.",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Lists the members of the raft cluster",
+        "operationId": "raftListMembers",
+        "responses": {
+          "200": {
+            "description": "The members of the raft cluster",
+            "schema": {
+              "$ref": "#/definitions/raftMemberListEnvelope"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Adds a controller to the raft cluster. If this controller isn't the leader, the request is forwarded to the\nleader. Requires admin access.\n",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Adds a member to the raft cluster",
+        "operationId": "raftAddMember",
+        "parameters": [
+          {
+            "description": "The member to add",
+            "name": "member",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/raftMemberAdd"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Base empty response",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/raft/members/{id}": {
+      "delete": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Removes a controller from the raft cluster. If this controller isn't the leader, the request is forwarded to\nthe leader. Requires admin access.\n",
+        "tags": [
+          "Raft"
+        ],
+        "summary": "Removes a member from the raft cluster",
+        "operationId": "raftRemoveMember",
+        "responses": {
+          "200": {
+            "description": "The delete request was successful and the resource has been removed",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
                   "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -3018,18 +5365,34 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Delete a link by id. Requires admin access.",
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/raft/status": {
+      "get": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Returns the raft state of this controller, the current leader, the commit and applied indexes, the latest\nsnapshot and the cluster members, including when each member was last heard from. Requires admin access.\n",
         "tags": [
-          "Link"
+          "Raft"
         ],
-        "summary": "Delete a link",
-        "operationId": "deleteLink",
+        "summary": "Returns the status of the raft cluster",
+        "operationId": "raftClusterStatus",
         "responses": {
           "200": {
-            "description": "The delete request was successful and the resource has been removed",
+            "description": "The status of the raft cluster",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/raftClusterStatusEnvelope"
             }
           },
           "400": {
@@ -3094,28 +5457,34 @@ func init() {
             }
           }
         }
-      },
-      "patch": {
-        "description": "Update the supplied fields on a link. Requires admin access.",
+      }
+    },
+    "/raft/transfer-leadership": {
+      "post": {
+        "security": [
+          {
+            "ztSession": []
+          }
+        ],
+        "description": "Transfers leadership of the raft cluster to the given member or, if no member is given, to the most up to date\nvoting member. If this controller isn't the leader, the request is forwarded to the leader. Requires admin\naccess.\n",
         "tags": [
-          "Link"
+          "Raft"
         ],
-        "summary": "Update the supplied fields on a link",
-        "operationId": "patchLink",
+        "summary": "Transfers leadership of the raft cluster to another member",
+        "operationId": "raftTransferLeadership",
         "parameters": [
           {
-            "description": "A link patch object",
-            "name": "link",
+            "description": "The member which should become the leader",
+            "name": "transfer",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/linkPatch"
+              "$ref": "#/definitions/raftTransferLeadership"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
+            "description": "Base empty response",
             "schema": {
               "$ref": "#/definitions/empty"
             }
@@ -3180,44 +5549,9 @@ func init() {
                 }
               }
             }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     },
     "/routers": {
       "get": {
@@ -3242,6 +5576,18 @@ func init() {
             "type": "string",
             "name": "filter",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -3379,6 +5725,12 @@ func init() {
             "description": "A single router",
             "schema": {
               "$ref": "#/definitions/detailRouterEnvelope"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The current version of the router, for use with If-Match on updates"
+              }
             }
           },
           "401": {
@@ -3441,6 +5793,12 @@ func init() {
         "summary": "Update all fields on a router",
         "operationId": "updateRouter",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+            "name": "If-Match",
+            "in": "header"
+          },
           {
             "description": "A router update object",
             "name": "router",
@@ -3544,6 +5902,30 @@ func init() {
                 }
               }
             }
+          },
+          "412": {
+            "description": "The entity has been modified since the version given in the If-Match header",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "ENTITY_VERSION_MISMATCH",
+                  "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       },
@@ -3649,29 +6031,202 @@ func init() {
           }
         }
       },
-      "patch": {
-        "description": "Update the supplied fields on a router. Requires admin access.",
+      "patch": {
+        "description": "Update the supplied fields on a router. Requires admin access.",
+        "tags": [
+          "Router"
+        ],
+        "summary": "Update the supplied fields on a router",
+        "operationId": "patchRouter",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "A router patch object",
+            "name": "router",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/routerPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "412": {
+            "description": "The entity has been modified since the version given in the If-Match header",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "ENTITY_VERSION_MISMATCH",
+                  "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/routers/{id}/terminators": {
+      "get": {
+        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
         "tags": [
           "Router"
         ],
-        "summary": "Update the supplied fields on a router",
-        "operationId": "patchRouter",
+        "summary": "List of terminators assigned to a router",
+        "operationId": "listRouterTerminators",
         "parameters": [
           {
-            "description": "A router patch object",
-            "name": "router",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/routerPatch"
-            }
+            "type": "integer",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "filter",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
+            "description": "A list of terminators",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/listTerminatorsEnvelope"
             }
           },
           "400": {
@@ -3734,32 +6289,6 @@ func init() {
                 }
               }
             }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
           }
         }
       },
@@ -3773,14 +6302,14 @@ func init() {
         }
       ]
     },
-    "/routers/{id}/terminators": {
+    "/service-stats": {
       "get": {
-        "description": "Retrieves a list of terminator resources that are assigned specific router; supports filtering, sorting, and pagination.\n",
+        "description": "Returns the rolling stats for each service, as given by detailServiceStats; supports filtering, sorting, and\npagination on the services.\n",
         "tags": [
-          "Router"
+          "Service"
         ],
-        "summary": "List of terminators assigned to a router",
-        "operationId": "listRouterTerminators",
+        "summary": "Lists the rolling stats for services",
+        "operationId": "listServiceStats",
         "parameters": [
           {
             "type": "integer",
@@ -3796,13 +6325,25 @@ func init() {
             "type": "string",
             "name": "filter",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A list of terminators",
+            "description": "A list of service stats",
             "schema": {
-              "$ref": "#/definitions/listTerminatorsEnvelope"
+              "$ref": "#/definitions/listServiceStatsEnvelope"
             }
           },
           "400": {
@@ -3867,16 +6408,7 @@ func init() {
             }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     },
     "/services": {
       "get": {
@@ -3901,6 +6433,18 @@ func init() {
             "type": "string",
             "name": "filter",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -4038,6 +6582,12 @@ func init() {
             "description": "A single service",
             "schema": {
               "$ref": "#/definitions/detailServiceEnvelope"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The current version of the service, for use with If-Match on updates"
+              }
             }
           },
           "401": {
@@ -4100,6 +6650,12 @@ func init() {
         "summary": "Update all fields on a service",
         "operationId": "updateService",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+            "name": "If-Match",
+            "in": "header"
+          },
           {
             "description": "A service update object",
             "name": "service",
@@ -4203,6 +6759,30 @@ func init() {
                 }
               }
             }
+          },
+          "412": {
+            "description": "The entity has been modified since the version given in the If-Match header",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "ENTITY_VERSION_MISMATCH",
+                  "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       },
@@ -4305,36 +6885,129 @@ func init() {
                 }
               }
             }
-          }
-        }
-      },
-      "patch": {
-        "description": "Update the supplied fields on a service. Requires admin access.",
-        "tags": [
-          "Service"
-        ],
-        "summary": "Update the supplied fields on a service",
-        "operationId": "patchService",
-        "parameters": [
-          {
-            "description": "A service patch object",
-            "name": "service",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/servicePatch"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "The patch request was successful and the resource has been altered",
-            "schema": {
-              "$ref": "#/definitions/empty"
-            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Update the supplied fields on a service. Requires admin access.",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Update the supplied fields on a service",
+        "operationId": "patchService",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "A service patch object",
+            "name": "service",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/servicePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           },
-          "400": {
-            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+          "412": {
+            "description": "The entity has been modified since the version given in the If-Match header",
             "schema": {
               "$ref": "#/definitions/apiErrorEnvelope"
             },
@@ -4344,24 +7017,11 @@ func init() {
                   "args": {
                     "urlVars": {}
                   },
-                  "cause": {
-                    "details": {
-                      "context": "(root)",
-                      "field": "(root)",
-                      "property": "fooField3"
-                    },
-                    "field": "(root)",
-                    "message": "(root): fooField3 is required",
-                    "type": "required",
-                    "value": {
-                      "fooField": "abc",
-                      "fooField2": "def"
-                    }
-                  },
-                  "causeMessage": "schema validation failed",
-                  "code": "COULD_NOT_VALIDATE",
-                  "message": "The supplied request contains an invalid document",
-                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "ENTITY_VERSION_MISMATCH",
+                  "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
                 },
                 "meta": {
                   "apiEnrollmentVersion": "0.0.1",
@@ -4369,6 +7029,33 @@ func init() {
                 }
               }
             }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/services/{id}/stats": {
+      "get": {
+        "description": "Returns dial successes and failures by cause, active circuits, bytes sent and received by clients and the\ndistribution across terminators for a service, aggregated by the controller over the configured service stats\nwindow.\n",
+        "tags": [
+          "Service"
+        ],
+        "summary": "Returns the rolling stats for a service",
+        "operationId": "detailServiceStats",
+        "responses": {
+          "200": {
+            "description": "The stats for a single service",
+            "schema": {
+              "$ref": "#/definitions/detailServiceStatsEnvelope"
+            }
           },
           "401": {
             "description": "The currently supplied session does not have the correct access rights to request this resource",
@@ -4455,6 +7142,18 @@ func init() {
             "type": "string",
             "name": "filter",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -4560,6 +7259,18 @@ func init() {
             "type": "string",
             "name": "filter",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+            "name": "fields",
+            "in": "query"
           }
         ],
         "responses": {
@@ -4734,6 +7445,12 @@ func init() {
             "description": "A single terminator",
             "schema": {
               "$ref": "#/definitions/detailTerminatorEnvelope"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The current version of the terminator, for use with If-Match on updates"
+              }
             }
           },
           "401": {
@@ -4796,6 +7513,12 @@ func init() {
         "summary": "Update all fields on a terminator",
         "operationId": "updateTerminator",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+            "name": "If-Match",
+            "in": "header"
+          },
           {
             "description": "A terminator update object",
             "name": "terminator",
@@ -4899,6 +7622,30 @@ func init() {
                 }
               }
             }
+          },
+          "412": {
+            "description": "The entity has been modified since the version given in the If-Match header",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "ENTITY_VERSION_MISMATCH",
+                  "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
           }
         }
       },
@@ -5007,26 +7754,193 @@ func init() {
       "patch": {
         "description": "Update the supplied fields on a terminator. Requires admin access.",
         "tags": [
-          "Terminator"
+          "Terminator"
+        ],
+        "summary": "Update the supplied fields on a terminator",
+        "operationId": "patchTerminator",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "description": "A terminator patch object",
+            "name": "terminator",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/terminatorPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The patch request was successful and the resource has been altered",
+            "schema": {
+              "$ref": "#/definitions/empty"
+            }
+          },
+          "400": {
+            "description": "The supplied request contains invalid fields or could not be parsed (json and non-json bodies). The error's code, message, and cause fields can be inspected for further information",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": {
+                    "details": {
+                      "context": "(root)",
+                      "field": "(root)",
+                      "property": "fooField3"
+                    },
+                    "field": "(root)",
+                    "message": "(root): fooField3 is required",
+                    "type": "required",
+                    "value": {
+                      "fooField": "abc",
+                      "fooField2": "def"
+                    }
+                  },
+                  "causeMessage": "schema validation failed",
+                  "code": "COULD_NOT_VALIDATE",
+                  "message": "The supplied request contains an invalid document",
+                  "requestId": "ac6766d6-3a09-44b3-8d8a-1b541d97fdd9"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "The currently supplied session does not have the correct access rights to request this resource",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "UNAUTHORIZED",
+                  "message": "The request could not be completed. The session is not authorized or the credentials are invalid",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "The requested resource does not exist",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {
+                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
+                    }
+                  },
+                  "cause": null,
+                  "causeMessage": "",
+                  "code": "NOT_FOUND",
+                  "message": "The resource requested was not found or is no longer available",
+                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          },
+          "412": {
+            "description": "The entity has been modified since the version given in the If-Match header",
+            "schema": {
+              "$ref": "#/definitions/apiErrorEnvelope"
+            },
+            "examples": {
+              "application/json": {
+                "error": {
+                  "args": {
+                    "urlVars": {}
+                  },
+                  "cause": "",
+                  "causeMessage": "",
+                  "code": "ENTITY_VERSION_MISMATCH",
+                  "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+                  "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+                },
+                "meta": {
+                  "apiEnrollmentVersion": "0.0.1",
+                  "apiVersion": "0.0.1"
+                }
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The id of the requested resource",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/topology": {
+      "get": {
+        "description": "Exports the connected routers and the links between them as a directed node-link graph, or in the Graphviz DOT\nlanguage if format is dot. Link sources are the dialing routers. If includeCircuits is set, each link includes\nthe number of circuits currently routed over it.\n",
+        "produces": [
+          "application/json",
+          "text/vnd.graphviz"
+        ],
+        "tags": [
+          "Topology"
         ],
-        "summary": "Update the supplied fields on a terminator",
-        "operationId": "patchTerminator",
+        "summary": "Exports the network topology",
+        "operationId": "getTopology",
         "parameters": [
           {
-            "description": "A terminator patch object",
-            "name": "terminator",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/terminatorPatch"
-            }
+            "enum": [
+              "json",
+              "dot"
+            ],
+            "type": "string",
+            "default": "json",
+            "description": "The format to export the topology in",
+            "name": "format",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "If true, each link includes the number of circuits currently routed over it",
+            "name": "includeCircuits",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "The patch request was successful and the resource has been altered",
+            "description": "The network topology, as a node-link graph or in the DOT language",
             "schema": {
-              "$ref": "#/definitions/empty"
+              "$ref": "#/definitions/topology"
             }
           },
           "400": {
@@ -5089,44 +8003,9 @@ func init() {
                 }
               }
             }
-          },
-          "404": {
-            "description": "The requested resource does not exist",
-            "schema": {
-              "$ref": "#/definitions/apiErrorEnvelope"
-            },
-            "examples": {
-              "application/json": {
-                "error": {
-                  "args": {
-                    "urlVars": {
-                      "id": "71a3000f-7dda-491a-9b90-a19f4ee6c406"
-                    }
-                  },
-                  "cause": null,
-                  "causeMessage": "",
-                  "code": "NOT_FOUND",
-                  "message": "The resource requested was not found or is no longer available",
-                  "requestId": "270908d6-f2ef-4577-b973-67bec18ae376"
-                },
-                "meta": {
-                  "apiEnrollmentVersion": "0.0.1",
-                  "apiVersion": "0.0.1"
-                }
-              }
-            }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The id of the requested resource",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     }
   },
   "definitions": {
@@ -5224,6 +8103,12 @@ func init() {
         }
       }
     },
+    "bandwidthLimit": {
+      "description": "A bandwidth limit in bytes per second. A value of 0 means unlimited",
+      "type": "integer",
+      "format": "int64",
+      "minimum": 0
+    },
     "baseEntity": {
       "description": "Fields shared by all Edge API entities",
       "type": "object",
@@ -5299,6 +8184,13 @@ func init() {
             }
           }
         },
+        "routerStats": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitRouterStats"
+          },
+          "x-omitempty": true
+        },
         "service": {
           "$ref": "#/definitions/entityRef"
         },
@@ -5313,6 +8205,158 @@ func init() {
         "$ref": "#/definitions/circuitDetail"
       }
     },
+    "circuitRouterStats": {
+      "type": "object",
+      "required": [
+        "router"
+      ],
+      "properties": {
+        "error": {
+          "type": "string",
+          "x-omitempty": true
+        },
+        "router": {
+          "$ref": "#/definitions/entityRef"
+        },
+        "timeSinceLastActivity": {
+          "description": "How long ago the router last forwarded a message for the circuit",
+          "type": "string"
+        },
+        "xgress": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/circuitXgressStats"
+          }
+        }
+      }
+    },
+    "circuitTerminateEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/circuitTerminateResult"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "circuitTerminateFilter": {
+      "type": "object",
+      "properties": {
+        "clientId": {
+          "type": "string"
+        },
+        "immediate": {
+          "description": "If true, routers remove the circuits immediately rather than draining them",
+          "type": "boolean"
+        },
+        "minAge": {
+          "description": "Matches circuits which have existed for at least this long, given as a duration, such as 10m",
+          "type": "string"
+        },
+        "routerId": {
+          "description": "Matches circuits which have this router on their path",
+          "type": "string"
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "terminatorId": {
+          "type": "string"
+        }
+      }
+    },
+    "circuitTerminateResult": {
+      "type": "object",
+      "required": [
+        "dryRun",
+        "circuitIds"
+      ],
+      "properties": {
+        "circuitIds": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "count": {
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "dryRun": {
+          "type": "boolean"
+        }
+      }
+    },
+    "circuitXgressStats": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "blockedByLocalWindow": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "blockedByRemoteWindow": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "bytesReceived": {
+          "description": "Bytes read from the xgress peer and forwarded onto the circuit",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "bytesSent": {
+          "description": "Bytes received from the circuit and written to the xgress peer",
+          "type": "integer",
+          "format": "uint64",
+          "x-omitempty": false
+        },
+        "duplicateAcks": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "originator": {
+          "type": "string"
+        },
+        "receiveBufferPayloads": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "receiveBufferSize": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "retransmits": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "sendBufferSize": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "sendWindowSize": {
+          "type": "integer",
+          "format": "uint32",
+          "x-omitempty": false
+        },
+        "timeSinceLastLinkRx": {
+          "type": "string"
+        }
+      }
+    },
     "createEnvelope": {
       "type": "object",
       "properties": {
@@ -5408,6 +8452,96 @@ func init() {
         }
       }
     },
+    "databaseEntities": {
+      "type": "object",
+      "properties": {
+        "routers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routerExport"
+          }
+        },
+        "services": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceExport"
+          }
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/terminatorExport"
+          }
+        }
+      }
+    },
+    "databaseImportChange": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "id",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "unchanged",
+            "delete"
+          ]
+        },
+        "changedFields": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "databaseImportEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/databaseImportResult"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "databaseImportResult": {
+      "type": "object",
+      "required": [
+        "dryRun",
+        "changes"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/databaseImportChange"
+          }
+        },
+        "dryRun": {
+          "type": "boolean"
+        }
+      }
+    },
     "detailCircuitEnvelope": {
       "type": "object",
       "required": [
@@ -5468,6 +8602,21 @@ func init() {
         }
       }
     },
+    "detailServiceStatsEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/serviceStats"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
     "detailTerminatorEnvelope": {
       "type": "object",
       "required": [
@@ -5681,14 +8830,29 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/circuitList"
+          "$ref": "#/definitions/circuitList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "listLinksEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/linkList"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "listLinksEnvelope": {
+    "listRoutersEnvelope": {
       "type": "object",
       "required": [
         "meta",
@@ -5696,14 +8860,14 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/linkList"
+          "$ref": "#/definitions/routerList"
         },
         "meta": {
           "$ref": "#/definitions/meta"
         }
       }
     },
-    "listRoutersEnvelope": {
+    "listServiceStatsEnvelope": {
       "type": "object",
       "required": [
         "meta",
@@ -5711,7 +8875,7 @@ func init() {
       ],
       "properties": {
         "data": {
-          "$ref": "#/definitions/routerList"
+          "$ref": "#/definitions/serviceStatsList"
         },
         "meta": {
           "$ref": "#/definitions/meta"
@@ -5781,6 +8945,11 @@ func init() {
           "type": "number",
           "format": "int64"
         },
+        "nextCursor": {
+          "description": "Present when more results are available. Pass as the cursor parameter to read the next page",
+          "type": "string",
+          "x-omitempty": true
+        },
         "offset": {
           "type": "number",
           "format": "int64"
@@ -5791,6 +8960,185 @@ func init() {
         }
       }
     },
+    "raftClusterStatus": {
+      "type": "object",
+      "required": [
+        "id",
+        "address",
+        "state",
+        "term",
+        "commitIndex",
+        "appliedIndex",
+        "lastLogIndex",
+        "snapshot",
+        "members"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "appliedIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "commitIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string"
+        },
+        "lastLogIndex": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "leaderAddress": {
+          "description": "The address of the current leader, blank if there is no leader",
+          "type": "string"
+        },
+        "leaderId": {
+          "description": "The id of the current leader, blank if there is no leader",
+          "type": "string"
+        },
+        "members": {
+          "$ref": "#/definitions/raftMemberList"
+        },
+        "snapshot": {
+          "$ref": "#/definitions/raftSnapshotStatus"
+        },
+        "state": {
+          "description": "The raft state of this controller, one of Follower, Candidate, Leader or Shutdown",
+          "type": "string"
+        },
+        "term": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "raftClusterStatusEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/raftClusterStatus"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "raftMember": {
+      "type": "object",
+      "required": [
+        "id",
+        "address",
+        "voter",
+        "leader",
+        "connected"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "connected": {
+          "description": "True if this controller has a connection to the member. Always true for this controller",
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "lastContact": {
+          "description": "When data was last received from the member, if known",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "leader": {
+          "type": "boolean"
+        },
+        "voter": {
+          "type": "boolean"
+        }
+      }
+    },
+    "raftMemberAdd": {
+      "type": "object",
+      "required": [
+        "id",
+        "address"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "isVoter": {
+          "type": "boolean",
+          "default": true
+        }
+      }
+    },
+    "raftMemberList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/raftMember"
+      }
+    },
+    "raftMemberListEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/raftMemberList"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "raftSnapshotStatus": {
+      "type": "object",
+      "required": [
+        "lastIndex",
+        "lastTerm"
+      ],
+      "properties": {
+        "lastIndex": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastTerm": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "raftTransferLeadership": {
+      "type": "object",
+      "properties": {
+        "newLeaderId": {
+          "description": "The id of the member which should become the leader. If blank, raft picks the most up to date voter",
+          "type": "string"
+        }
+      }
+    },
+    "routerBandwidthLimit": {
+      "description": "A bandwidth limit in bytes per second, shared by all of a service's circuits on a router. Each router enforces the limit separately, so the service's total bandwidth across the network can exceed it. A value of 0 means unlimited",
+      "type": "integer",
+      "format": "int64",
+      "minimum": 0
+    },
     "routerCreate": {
       "type": "object",
       "required": [
@@ -5869,6 +9217,35 @@ func init() {
         }
       ]
     },
+    "routerExport": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "cost": {
+          "type": "integer",
+          "maximum": 65535,
+          "minimum": 0
+        },
+        "fingerprint": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        }
+      }
+    },
     "routerList": {
       "type": "array",
       "items": {
@@ -5949,9 +9326,27 @@ func init() {
         "name"
       ],
       "properties": {
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -5970,35 +9365,232 @@ func init() {
           "type": "object",
           "required": [
             "name",
-            "terminatorStrategy"
+            "terminatorStrategy",
+            "maxCircuits",
+            "maxCircuitsPerClient",
+            "maxDialRate",
+            "priority",
+            "maxRouterBandwidth",
+            "maxCircuitBandwidth"
           ],
           "properties": {
+            "maxCircuitBandwidth": {
+              "$ref": "#/definitions/bandwidthLimit"
+            },
+            "maxCircuits": {
+              "$ref": "#/definitions/serviceLimit"
+            },
+            "maxCircuitsPerClient": {
+              "$ref": "#/definitions/serviceLimit"
+            },
+            "maxDialRate": {
+              "$ref": "#/definitions/serviceLimit"
+            },
+            "maxRouterBandwidth": {
+              "$ref": "#/definitions/routerBandwidthLimit"
+            },
             "name": {
               "type": "string"
             },
+            "priority": {
+              "$ref": "#/definitions/servicePriority"
+            },
             "terminatorStrategy": {
               "type": "string"
             }
           }
         }
-      ]
+      ]
+    },
+    "serviceExport": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
+        "name": {
+          "type": "string"
+        },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceLimit": {
+      "description": "A limit on circuit creation for a service. A value of 0 means unlimited",
+      "type": "integer",
+      "format": "int32",
+      "minimum": 0
+    },
+    "serviceList": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/serviceDetail"
+      }
+    },
+    "servicePatch": {
+      "type": "object",
+      "properties": {
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
+        "name": {
+          "type": "string"
+        },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "terminatorStrategy": {
+          "type": "string"
+        }
+      }
+    },
+    "servicePriority": {
+      "description": "The priority class used when scheduling a service's traffic on router links",
+      "type": "string",
+      "enum": [
+        "standard",
+        "interactive",
+        "bulk"
+      ]
+    },
+    "serviceStats": {
+      "description": "Aggregates for a service over the window from windowStart to windowEnd. As with circuit failed events, dial\nfailures are counted per circuit creation attempt. Byte counts are as reported by ingress routers.\n",
+      "type": "object",
+      "required": [
+        "serviceId",
+        "windowStart",
+        "windowEnd",
+        "dials",
+        "dialSuccesses",
+        "dialFailures",
+        "failuresByCause",
+        "activeCircuits",
+        "rxBytes",
+        "txBytes",
+        "terminators"
+      ],
+      "properties": {
+        "activeCircuits": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dialFailures": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dialSuccesses": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dials": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failuresByCause": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "rxBytes": {
+          "description": "Bytes received from clients",
+          "type": "integer",
+          "format": "int64"
+        },
+        "serviceId": {
+          "type": "string"
+        },
+        "terminators": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceTerminatorStats"
+          }
+        },
+        "txBytes": {
+          "description": "Bytes sent to clients",
+          "type": "integer",
+          "format": "int64"
+        },
+        "windowEnd": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "windowStart": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     },
-    "serviceList": {
+    "serviceStatsList": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/serviceDetail"
+        "$ref": "#/definitions/serviceStats"
       }
     },
-    "servicePatch": {
+    "serviceTerminatorStats": {
+      "description": "Dial attempts and active circuits for a single terminator. Retried dials count once per terminator tried",
       "type": "object",
+      "required": [
+        "terminatorId",
+        "dialSuccesses",
+        "dialFailures",
+        "activeCircuits"
+      ],
       "properties": {
-        "name": {
-          "type": "string"
+        "activeCircuits": {
+          "type": "integer",
+          "format": "int64"
         },
-        "tags": {
-          "$ref": "#/definitions/tags"
+        "dialFailures": {
+          "type": "integer",
+          "format": "int64"
         },
-        "terminatorStrategy": {
+        "dialSuccesses": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "terminatorId": {
           "type": "string"
         }
       }
@@ -6009,9 +9601,27 @@ func init() {
         "name"
       ],
       "properties": {
+        "maxCircuitBandwidth": {
+          "$ref": "#/definitions/bandwidthLimit"
+        },
+        "maxCircuits": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxCircuitsPerClient": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxDialRate": {
+          "$ref": "#/definitions/serviceLimit"
+        },
+        "maxRouterBandwidth": {
+          "$ref": "#/definitions/routerBandwidthLimit"
+        },
         "name": {
           "type": "string"
         },
+        "priority": {
+          "$ref": "#/definitions/servicePriority"
+        },
         "tags": {
           "$ref": "#/definitions/tags"
         },
@@ -6085,6 +9695,9 @@ func init() {
         },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
         }
       }
     },
@@ -6107,7 +9720,8 @@ func init() {
             "cost",
             "precedence",
             "dynamicCost",
-            "hostId"
+            "hostId",
+            "weight"
           ],
           "properties": {
             "address": {
@@ -6142,11 +9756,69 @@ func init() {
             },
             "serviceId": {
               "type": "string"
+            },
+            "weight": {
+              "$ref": "#/definitions/terminatorWeight"
             }
           }
         }
       ]
     },
+    "terminatorExport": {
+      "type": "object",
+      "required": [
+        "id",
+        "service",
+        "router",
+        "address"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "binding": {
+          "type": "string"
+        },
+        "cost": {
+          "$ref": "#/definitions/terminatorCost"
+        },
+        "hostId": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "instanceId": {
+          "type": "string"
+        },
+        "instanceSecret": {
+          "type": "string",
+          "format": "byte"
+        },
+        "peerData": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string",
+            "format": "byte"
+          }
+        },
+        "precedence": {
+          "$ref": "#/definitions/terminatorPrecedence"
+        },
+        "router": {
+          "type": "string"
+        },
+        "service": {
+          "type": "string"
+        },
+        "tags": {
+          "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
+        }
+      }
+    },
     "terminatorList": {
       "type": "array",
       "items": {
@@ -6179,6 +9851,9 @@ func init() {
         },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
         }
       }
     },
@@ -6228,6 +9903,133 @@ func init() {
         },
         "tags": {
           "$ref": "#/definitions/tags"
+        },
+        "weight": {
+          "$ref": "#/definitions/terminatorWeight"
+        }
+      }
+    },
+    "terminatorWeight": {
+      "type": "integer",
+      "maximum": 65535,
+      "minimum": 1
+    },
+    "topology": {
+      "type": "object",
+      "required": [
+        "directed",
+        "nodes",
+        "links"
+      ],
+      "properties": {
+        "directed": {
+          "type": "boolean"
+        },
+        "links": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyLink"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyNode"
+          }
+        }
+      }
+    },
+    "topologyEnvelope": {
+      "type": "object",
+      "required": [
+        "meta",
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/topology"
+        },
+        "meta": {
+          "$ref": "#/definitions/meta"
+        }
+      }
+    },
+    "topologyLink": {
+      "type": "object",
+      "required": [
+        "id",
+        "source",
+        "target",
+        "protocol",
+        "state",
+        "down",
+        "cost",
+        "staticCost",
+        "sourceLatency",
+        "targetLatency"
+      ],
+      "properties": {
+        "circuitCount": {
+          "description": "The number of circuits routed over the link. Only present if includeCircuits was set",
+          "type": "integer",
+          "x-nullable": true
+        },
+        "cost": {
+          "type": "integer"
+        },
+        "down": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "protocol": {
+          "type": "string"
+        },
+        "source": {
+          "description": "The id of the dialing router",
+          "type": "string"
+        },
+        "sourceLatency": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string"
+        },
+        "staticCost": {
+          "type": "integer"
+        },
+        "target": {
+          "description": "The id of the dialed router",
+          "type": "string"
+        },
+        "targetLatency": {
+          "type": "integer"
+        }
+      }
+    },
+    "topologyNode": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "cost",
+        "noTraversal"
+      ],
+      "properties": {
+        "cost": {
+          "type": "integer",
+          "maximum": 65535,
+          "minimum": 0
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "noTraversal": {
+          "type": "boolean"
         }
       }
     },
@@ -6254,6 +10056,18 @@ func init() {
     }
   },
   "parameters": {
+    "cursor": {
+      "type": "string",
+      "description": "The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available",
+      "name": "cursor",
+      "in": "query"
+    },
+    "fields": {
+      "type": "string",
+      "description": "A comma separated list of the fields to include for each returned entity. Unknown fields are ignored",
+      "name": "fields",
+      "in": "query"
+    },
     "filter": {
       "type": "string",
       "name": "filter",
@@ -6266,6 +10080,12 @@ func init() {
       "in": "path",
       "required": true
     },
+    "ifMatch": {
+      "type": "string",
+      "description": "The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since",
+      "name": "If-Match",
+      "in": "header"
+    },
     "limit": {
       "type": "integer",
       "name": "limit",
@@ -6352,6 +10172,18 @@ func init() {
         "$ref": "#/definitions/dataIntegrityCheckResultEnvelope"
       }
     },
+    "databaseExport": {
+      "description": "The exported services, routers and terminators",
+      "schema": {
+        "$ref": "#/definitions/databaseEntities"
+      }
+    },
+    "databaseImport": {
+      "description": "The changes made, or which would be made, by an import or reconcile",
+      "schema": {
+        "$ref": "#/definitions/databaseImportEnvelope"
+      }
+    },
     "deleteResponse": {
       "description": "The delete request was successful and the resource has been removed",
       "schema": {
@@ -6374,18 +10206,42 @@ func init() {
       "description": "A single router",
       "schema": {
         "$ref": "#/definitions/detailRouterEnvelope"
+      },
+      "headers": {
+        "ETag": {
+          "type": "string",
+          "description": "The current version of the router, for use with If-Match on updates"
+        }
       }
     },
     "detailService": {
       "description": "A single service",
       "schema": {
         "$ref": "#/definitions/detailServiceEnvelope"
+      },
+      "headers": {
+        "ETag": {
+          "type": "string",
+          "description": "The current version of the service, for use with If-Match on updates"
+        }
+      }
+    },
+    "detailServiceStats": {
+      "description": "The stats for a single service",
+      "schema": {
+        "$ref": "#/definitions/detailServiceStatsEnvelope"
       }
     },
     "detailTerminator": {
       "description": "A single terminator",
       "schema": {
         "$ref": "#/definitions/detailTerminatorEnvelope"
+      },
+      "headers": {
+        "ETag": {
+          "type": "string",
+          "description": "The current version of the terminator, for use with If-Match on updates"
+        }
       }
     },
     "emptyResponse": {
@@ -6442,6 +10298,12 @@ func init() {
         "$ref": "#/definitions/listRoutersEnvelope"
       }
     },
+    "listServiceStats": {
+      "description": "A list of service stats",
+      "schema": {
+        "$ref": "#/definitions/listServiceStatsEnvelope"
+      }
+    },
     "listServices": {
       "description": "A list of services",
       "schema": {
@@ -6486,6 +10348,42 @@ func init() {
         "$ref": "#/definitions/empty"
       }
     },
+    "preconditionFailedResponse": {
+      "description": "The entity has been modified since the version given in the If-Match header",
+      "schema": {
+        "$ref": "#/definitions/apiErrorEnvelope"
+      },
+      "examples": {
+        "application/json": {
+          "error": {
+            "args": {
+              "urlVars": {}
+            },
+            "cause": "",
+            "causeMessage": "",
+            "code": "ENTITY_VERSION_MISMATCH",
+            "message": "The entity has been modified since the version given in the If-Match header: expected version 3, current version is 4",
+            "requestId": "0bfe7a04-9229-4b7a-812c-9eb3cc0eac0f"
+          },
+          "meta": {
+            "apiEnrollmentVersion": "0.0.1",
+            "apiVersion": "0.0.1"
+          }
+        }
+      }
+    },
+    "raftClusterStatus": {
+      "description": "The status of the raft cluster",
+      "schema": {
+        "$ref": "#/definitions/raftClusterStatusEnvelope"
+      }
+    },
+    "raftMemberList": {
+      "description": "The members of the raft cluster",
+      "schema": {
+        "$ref": "#/definitions/raftMemberListEnvelope"
+      }
+    },
     "rateLimitedResponse": {
       "description": "The resource requested is rate limited and the rate limit has been exceeded",
       "schema": {
@@ -6509,6 +10407,18 @@ func init() {
         }
       }
     },
+    "terminateCircuits": {
+      "description": "The circuits terminated, or which would be terminated",
+      "schema": {
+        "$ref": "#/definitions/circuitTerminateEnvelope"
+      }
+    },
+    "topology": {
+      "description": "The network topology, as a node-link graph or in the DOT language",
+      "schema": {
+        "$ref": "#/definitions/topologyEnvelope"
+      }
+    },
     "unauthorizedResponse": {
       "description": "The currently supplied session does not have the correct access rights to request this resource",
       "schema": {
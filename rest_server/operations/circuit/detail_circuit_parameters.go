@@ -33,8 +33,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewDetailCircuitParams creates a new DetailCircuitParams object
@@ -59,6 +61,10 @@ type DetailCircuitParams struct {
 	  In: path
 	*/
 	ID string
+	/*If true, the routers on the circuit path are queried for their live statistics for the circuit
+	  In: query
+	*/
+	IncludeRouterStats *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -70,10 +76,17 @@ func (o *DetailCircuitParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qIncludeRouterStats, qhkIncludeRouterStats, _ := qs.GetOK("includeRouterStats")
+	if err := o.bindIncludeRouterStats(qIncludeRouterStats, qhkIncludeRouterStats, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -93,3 +106,26 @@ func (o *DetailCircuitParams) bindID(rawData []string, hasKey bool, formats strf
 
 	return nil
 }
+
+// bindIncludeRouterStats binds and validates parameter IncludeRouterStats from query.
+func (o *DetailCircuitParams) bindIncludeRouterStats(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("includeRouterStats", "query", "bool", raw)
+	}
+	o.IncludeRouterStats = &value
+
+	return nil
+}
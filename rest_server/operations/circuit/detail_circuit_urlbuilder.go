@@ -34,12 +34,16 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // DetailCircuitURL generates an URL for the detail circuit operation
 type DetailCircuitURL struct {
 	ID string
 
+	IncludeRouterStats *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -79,6 +83,18 @@ func (o *DetailCircuitURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var includeRouterStatsQ string
+	if o.IncludeRouterStats != nil {
+		includeRouterStatsQ = swag.FormatBool(*o.IncludeRouterStats)
+	}
+	if includeRouterStatsQ != "" {
+		qs.Set("includeRouterStats", includeRouterStatsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
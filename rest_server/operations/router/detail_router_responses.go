@@ -45,6 +45,10 @@ const DetailRouterOKCode int = 200
 swagger:response detailRouterOK
 */
 type DetailRouterOK struct {
+	/*The current version of the router, for use with If-Match on updates
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -58,6 +62,17 @@ func NewDetailRouterOK() *DetailRouterOK {
 	return &DetailRouterOK{}
 }
 
+// WithETag adds the eTag to the detail router o k response
+func (o *DetailRouterOK) WithETag(eTag string) *DetailRouterOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the detail router o k response
+func (o *DetailRouterOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the detail router o k response
 func (o *DetailRouterOK) WithPayload(payload *rest_model.DetailRouterEnvelope) *DetailRouterOK {
 	o.Payload = payload
@@ -72,6 +87,13 @@ func (o *DetailRouterOK) SetPayload(payload *rest_model.DetailRouterEnvelope) {
 // WriteResponse to the client
 func (o *DetailRouterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -212,3 +212,47 @@ func (o *PatchRouterNotFound) WriteResponse(rw http.ResponseWriter, producer run
 		}
 	}
 }
+
+// PatchRouterPreconditionFailedCode is the HTTP code returned for type PatchRouterPreconditionFailed
+const PatchRouterPreconditionFailedCode int = 412
+
+/*PatchRouterPreconditionFailed The entity has been modified since the version given in the If-Match header
+
+swagger:response patchRouterPreconditionFailed
+*/
+type PatchRouterPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchRouterPreconditionFailed creates PatchRouterPreconditionFailed with default headers values
+func NewPatchRouterPreconditionFailed() *PatchRouterPreconditionFailed {
+
+	return &PatchRouterPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the patch router precondition failed response
+func (o *PatchRouterPreconditionFailed) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchRouterPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch router precondition failed response
+func (o *PatchRouterPreconditionFailed) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchRouterPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
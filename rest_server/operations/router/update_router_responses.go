@@ -212,3 +212,47 @@ func (o *UpdateRouterNotFound) WriteResponse(rw http.ResponseWriter, producer ru
 		}
 	}
 }
+
+// UpdateRouterPreconditionFailedCode is the HTTP code returned for type UpdateRouterPreconditionFailed
+const UpdateRouterPreconditionFailedCode int = 412
+
+/*UpdateRouterPreconditionFailed The entity has been modified since the version given in the If-Match header
+
+swagger:response updateRouterPreconditionFailed
+*/
+type UpdateRouterPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateRouterPreconditionFailed creates UpdateRouterPreconditionFailed with default headers values
+func NewUpdateRouterPreconditionFailed() *UpdateRouterPreconditionFailed {
+
+	return &UpdateRouterPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the update router precondition failed response
+func (o *UpdateRouterPreconditionFailed) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateRouterPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update router precondition failed response
+func (o *UpdateRouterPreconditionFailed) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateRouterPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -45,6 +45,10 @@ const DetailServiceOKCode int = 200
 swagger:response detailServiceOK
 */
 type DetailServiceOK struct {
+	/*The current version of the service, for use with If-Match on updates
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -58,6 +62,17 @@ func NewDetailServiceOK() *DetailServiceOK {
 	return &DetailServiceOK{}
 }
 
+// WithETag adds the eTag to the detail service o k response
+func (o *DetailServiceOK) WithETag(eTag string) *DetailServiceOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the detail service o k response
+func (o *DetailServiceOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the detail service o k response
 func (o *DetailServiceOK) WithPayload(payload *rest_model.DetailServiceEnvelope) *DetailServiceOK {
 	o.Payload = payload
@@ -72,6 +87,13 @@ func (o *DetailServiceOK) SetPayload(payload *rest_model.DetailServiceEnvelope)
 // WriteResponse to the client
 func (o *DetailServiceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -42,6 +42,8 @@ import (
 type ListServiceTerminatorsURL struct {
 	ID string
 
+	Cursor *string
+	Fields *string
 	Filter *string
 	Limit  *int64
 	Offset *int64
@@ -87,6 +89,22 @@ func (o *ListServiceTerminatorsURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var fieldsQ string
+	if o.Fields != nil {
+		fieldsQ = *o.Fields
+	}
+	if fieldsQ != "" {
+		qs.Set("fields", fieldsQ)
+	}
+
 	var filterQ string
 	if o.Filter != nil {
 		filterQ = *o.Filter
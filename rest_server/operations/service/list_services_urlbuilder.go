@@ -39,6 +39,8 @@ import (
 
 // ListServicesURL generates an URL for the list services operation
 type ListServicesURL struct {
+	Cursor *string
+	Fields *string
 	Filter *string
 	Limit  *int64
 	Offset *int64
@@ -77,6 +79,22 @@ func (o *ListServicesURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var fieldsQ string
+	if o.Fields != nil {
+		fieldsQ = *o.Fields
+	}
+	if fieldsQ != "" {
+		qs.Set("fields", fieldsQ)
+	}
+
 	var filterQ string
 	if o.Filter != nil {
 		filterQ = *o.Filter
@@ -60,6 +60,10 @@ type PatchServiceParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The ETag returned when the entity was retrieved. If given, the update is only applied if the entity hasn't been modified since
+	  In: header
+	*/
+	IfMatch *string
 	/*The id of the requested resource
 	  Required: true
 	  In: path
@@ -81,6 +85,10 @@ func (o *PatchServiceParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	o.HTTPRequest = r
 
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
@@ -119,6 +127,23 @@ func (o *PatchServiceParams) BindRequest(r *http.Request, route *middleware.Matc
 	return nil
 }
 
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *PatchServiceParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfMatch = &raw
+
+	return nil
+}
+
 // bindID binds and validates parameter ID from path.
 func (o *PatchServiceParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
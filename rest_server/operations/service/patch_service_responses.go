@@ -212,3 +212,47 @@ func (o *PatchServiceNotFound) WriteResponse(rw http.ResponseWriter, producer ru
 		}
 	}
 }
+
+// PatchServicePreconditionFailedCode is the HTTP code returned for type PatchServicePreconditionFailed
+const PatchServicePreconditionFailedCode int = 412
+
+/*PatchServicePreconditionFailed The entity has been modified since the version given in the If-Match header
+
+swagger:response patchServicePreconditionFailed
+*/
+type PatchServicePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchServicePreconditionFailed creates PatchServicePreconditionFailed with default headers values
+func NewPatchServicePreconditionFailed() *PatchServicePreconditionFailed {
+
+	return &PatchServicePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the patch service precondition failed response
+func (o *PatchServicePreconditionFailed) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchServicePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch service precondition failed response
+func (o *PatchServicePreconditionFailed) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchServicePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -212,3 +212,47 @@ func (o *UpdateServiceNotFound) WriteResponse(rw http.ResponseWriter, producer r
 		}
 	}
 }
+
+// UpdateServicePreconditionFailedCode is the HTTP code returned for type UpdateServicePreconditionFailed
+const UpdateServicePreconditionFailedCode int = 412
+
+/*UpdateServicePreconditionFailed The entity has been modified since the version given in the If-Match header
+
+swagger:response updateServicePreconditionFailed
+*/
+type UpdateServicePreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateServicePreconditionFailed creates UpdateServicePreconditionFailed with default headers values
+func NewUpdateServicePreconditionFailed() *UpdateServicePreconditionFailed {
+
+	return &UpdateServicePreconditionFailed{}
+}
+
+// WithPayload adds the payload to the update service precondition failed response
+func (o *UpdateServicePreconditionFailed) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateServicePreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update service precondition failed response
+func (o *UpdateServicePreconditionFailed) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateServicePreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -45,6 +45,10 @@ const DetailTerminatorOKCode int = 200
 swagger:response detailTerminatorOK
 */
 type DetailTerminatorOK struct {
+	/*The current version of the terminator, for use with If-Match on updates
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -58,6 +62,17 @@ func NewDetailTerminatorOK() *DetailTerminatorOK {
 	return &DetailTerminatorOK{}
 }
 
+// WithETag adds the eTag to the detail terminator o k response
+func (o *DetailTerminatorOK) WithETag(eTag string) *DetailTerminatorOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the detail terminator o k response
+func (o *DetailTerminatorOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the detail terminator o k response
 func (o *DetailTerminatorOK) WithPayload(payload *rest_model.DetailTerminatorEnvelope) *DetailTerminatorOK {
 	o.Payload = payload
@@ -72,6 +87,13 @@ func (o *DetailTerminatorOK) SetPayload(payload *rest_model.DetailTerminatorEnve
 // WriteResponse to the client
 func (o *DetailTerminatorOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
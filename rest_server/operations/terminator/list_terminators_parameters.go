@@ -56,6 +56,14 @@ type ListTerminatorsParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The nextCursor value from the previous page of results. Lists read with a cursor are ordered by id and can't be combined with an offset or another sort order. The total isn't counted for pages read with a cursor, so totalCount is the number of results returned, plus one if more are available
+	  In: query
+	*/
+	Cursor *string
+	/*A comma separated list of the fields to include for each returned entity. Unknown fields are ignored
+	  In: query
+	*/
+	Fields *string
 	/*
 	  In: query
 	*/
@@ -81,6 +89,16 @@ func (o *ListTerminatorsParams) BindRequest(r *http.Request, route *middleware.M
 
 	qs := runtime.Values(r.URL.Query())
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qFilter, qhkFilter, _ := qs.GetOK("filter")
 	if err := o.bindFilter(qFilter, qhkFilter, route.Formats); err != nil {
 		res = append(res, err)
@@ -101,6 +119,42 @@ func (o *ListTerminatorsParams) BindRequest(r *http.Request, route *middleware.M
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *ListTerminatorsParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindFields binds and validates parameter Fields from query.
+func (o *ListTerminatorsParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Fields = &raw
+
+	return nil
+}
+
 // bindFilter binds and validates parameter Filter from query.
 func (o *ListTerminatorsParams) bindFilter(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
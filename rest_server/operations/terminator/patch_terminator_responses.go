@@ -212,3 +212,47 @@ func (o *PatchTerminatorNotFound) WriteResponse(rw http.ResponseWriter, producer
 		}
 	}
 }
+
+// PatchTerminatorPreconditionFailedCode is the HTTP code returned for type PatchTerminatorPreconditionFailed
+const PatchTerminatorPreconditionFailedCode int = 412
+
+/*PatchTerminatorPreconditionFailed The entity has been modified since the version given in the If-Match header
+
+swagger:response patchTerminatorPreconditionFailed
+*/
+type PatchTerminatorPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewPatchTerminatorPreconditionFailed creates PatchTerminatorPreconditionFailed with default headers values
+func NewPatchTerminatorPreconditionFailed() *PatchTerminatorPreconditionFailed {
+
+	return &PatchTerminatorPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the patch terminator precondition failed response
+func (o *PatchTerminatorPreconditionFailed) WithPayload(payload *rest_model.APIErrorEnvelope) *PatchTerminatorPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the patch terminator precondition failed response
+func (o *PatchTerminatorPreconditionFailed) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PatchTerminatorPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -212,3 +212,47 @@ func (o *UpdateTerminatorNotFound) WriteResponse(rw http.ResponseWriter, produce
 		}
 	}
 }
+
+// UpdateTerminatorPreconditionFailedCode is the HTTP code returned for type UpdateTerminatorPreconditionFailed
+const UpdateTerminatorPreconditionFailedCode int = 412
+
+/*UpdateTerminatorPreconditionFailed The entity has been modified since the version given in the If-Match header
+
+swagger:response updateTerminatorPreconditionFailed
+*/
+type UpdateTerminatorPreconditionFailed struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *rest_model.APIErrorEnvelope `json:"body,omitempty"`
+}
+
+// NewUpdateTerminatorPreconditionFailed creates UpdateTerminatorPreconditionFailed with default headers values
+func NewUpdateTerminatorPreconditionFailed() *UpdateTerminatorPreconditionFailed {
+
+	return &UpdateTerminatorPreconditionFailed{}
+}
+
+// WithPayload adds the payload to the update terminator precondition failed response
+func (o *UpdateTerminatorPreconditionFailed) WithPayload(payload *rest_model.APIErrorEnvelope) *UpdateTerminatorPreconditionFailed {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update terminator precondition failed response
+func (o *UpdateTerminatorPreconditionFailed) SetPayload(payload *rest_model.APIErrorEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateTerminatorPreconditionFailed) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(412)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
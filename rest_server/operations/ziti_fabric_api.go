@@ -31,6 +31,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/runtime/security"
+	"github.com/go-openapi/runtime/yamlpc"
 	"github.com/go-openapi/spec"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -47,9 +49,11 @@ import (
 	"github.com/openziti/fabric/rest_server/operations/database"
 	"github.com/openziti/fabric/rest_server/operations/inspect"
 	"github.com/openziti/fabric/rest_server/operations/link"
+	"github.com/openziti/fabric/rest_server/operations/raft"
 	"github.com/openziti/fabric/rest_server/operations/router"
 	"github.com/openziti/fabric/rest_server/operations/service"
 	"github.com/openziti/fabric/rest_server/operations/terminator"
+	"github.com/openziti/fabric/rest_server/operations/topology"
 )
 
 // NewZitiFabricAPI creates a new ZitiFabric instance
@@ -71,8 +75,13 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		BearerAuthenticator: security.BearerAuth,
 
 		JSONConsumer: runtime.JSONConsumer(),
+		YamlConsumer: yamlpc.YAMLConsumer(),
 
 		JSONProducer: runtime.JSONProducer(),
+		TextVndGraphvizProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textVndGraphviz producer has not yet been implemented")
+		}),
+		YamlProducer: yamlpc.YAMLProducer(),
 
 		DatabaseCheckDataIntegrityHandler: database.CheckDataIntegrityHandlerFunc(func(params database.CheckDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.CheckDataIntegrity has not yet been implemented")
@@ -119,12 +128,24 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		ServiceDetailServiceHandler: service.DetailServiceHandlerFunc(func(params service.DetailServiceParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.DetailService has not yet been implemented")
 		}),
+		ServiceDetailServiceStatsHandler: service.DetailServiceStatsHandlerFunc(func(params service.DetailServiceStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.DetailServiceStats has not yet been implemented")
+		}),
 		TerminatorDetailTerminatorHandler: terminator.DetailTerminatorHandlerFunc(func(params terminator.DetailTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.DetailTerminator has not yet been implemented")
 		}),
+		DatabaseExportDatabaseHandler: database.ExportDatabaseHandlerFunc(func(params database.ExportDatabaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ExportDatabase has not yet been implemented")
+		}),
 		DatabaseFixDataIntegrityHandler: database.FixDataIntegrityHandlerFunc(func(params database.FixDataIntegrityParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation database.FixDataIntegrity has not yet been implemented")
 		}),
+		TopologyGetTopologyHandler: topology.GetTopologyHandlerFunc(func(params topology.GetTopologyParams) middleware.Responder {
+			return middleware.NotImplemented("operation topology.GetTopology has not yet been implemented")
+		}),
+		DatabaseImportDatabaseHandler: database.ImportDatabaseHandlerFunc(func(params database.ImportDatabaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ImportDatabase has not yet been implemented")
+		}),
 		InspectInspectHandler: inspect.InspectHandlerFunc(func(params inspect.InspectParams) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		}),
@@ -140,6 +161,9 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		RouterListRoutersHandler: router.ListRoutersHandlerFunc(func(params router.ListRoutersParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.ListRouters has not yet been implemented")
 		}),
+		ServiceListServiceStatsHandler: service.ListServiceStatsHandlerFunc(func(params service.ListServiceStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation service.ListServiceStats has not yet been implemented")
+		}),
 		ServiceListServiceTerminatorsHandler: service.ListServiceTerminatorsHandlerFunc(func(params service.ListServiceTerminatorsParams) middleware.Responder {
 			return middleware.NotImplemented("operation service.ListServiceTerminators has not yet been implemented")
 		}),
@@ -161,6 +185,27 @@ func NewZitiFabricAPI(spec *loads.Document) *ZitiFabricAPI {
 		TerminatorPatchTerminatorHandler: terminator.PatchTerminatorHandlerFunc(func(params terminator.PatchTerminatorParams) middleware.Responder {
 			return middleware.NotImplemented("operation terminator.PatchTerminator has not yet been implemented")
 		}),
+		RaftRaftAddMemberHandler: raft.RaftAddMemberHandlerFunc(func(params raft.RaftAddMemberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftAddMember has not yet been implemented")
+		}),
+		RaftRaftClusterStatusHandler: raft.RaftClusterStatusHandlerFunc(func(params raft.RaftClusterStatusParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftClusterStatus has not yet been implemented")
+		}),
+		RaftRaftListMembersHandler: raft.RaftListMembersHandlerFunc(func(params raft.RaftListMembersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftListMembers has not yet been implemented")
+		}),
+		RaftRaftRemoveMemberHandler: raft.RaftRemoveMemberHandlerFunc(func(params raft.RaftRemoveMemberParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftRemoveMember has not yet been implemented")
+		}),
+		RaftRaftTransferLeadershipHandler: raft.RaftTransferLeadershipHandlerFunc(func(params raft.RaftTransferLeadershipParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation raft.RaftTransferLeadership has not yet been implemented")
+		}),
+		DatabaseReconcileDatabaseHandler: database.ReconcileDatabaseHandlerFunc(func(params database.ReconcileDatabaseParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation database.ReconcileDatabase has not yet been implemented")
+		}),
+		CircuitTerminateCircuitsHandler: circuit.TerminateCircuitsHandlerFunc(func(params circuit.TerminateCircuitsParams) middleware.Responder {
+			return middleware.NotImplemented("operation circuit.TerminateCircuits has not yet been implemented")
+		}),
 		RouterUpdateRouterHandler: router.UpdateRouterHandlerFunc(func(params router.UpdateRouterParams) middleware.Responder {
 			return middleware.NotImplemented("operation router.UpdateRouter has not yet been implemented")
 		}),
@@ -201,10 +246,19 @@ type ZitiFabricAPI struct {
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// YamlConsumer registers a consumer for the following mime types:
+	//   - application/x-yaml
+	YamlConsumer runtime.Consumer
 
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextVndGraphvizProducer registers a producer for the following mime types:
+	//   - text/vnd.graphviz
+	TextVndGraphvizProducer runtime.Producer
+	// YamlProducer registers a producer for the following mime types:
+	//   - application/x-yaml
+	YamlProducer runtime.Producer
 
 	// DatabaseCheckDataIntegrityHandler sets the operation handler for the check data integrity operation
 	DatabaseCheckDataIntegrityHandler database.CheckDataIntegrityHandler
@@ -236,10 +290,18 @@ type ZitiFabricAPI struct {
 	RouterDetailRouterHandler router.DetailRouterHandler
 	// ServiceDetailServiceHandler sets the operation handler for the detail service operation
 	ServiceDetailServiceHandler service.DetailServiceHandler
+	// ServiceDetailServiceStatsHandler sets the operation handler for the detail service stats operation
+	ServiceDetailServiceStatsHandler service.DetailServiceStatsHandler
 	// TerminatorDetailTerminatorHandler sets the operation handler for the detail terminator operation
 	TerminatorDetailTerminatorHandler terminator.DetailTerminatorHandler
+	// DatabaseExportDatabaseHandler sets the operation handler for the export database operation
+	DatabaseExportDatabaseHandler database.ExportDatabaseHandler
 	// DatabaseFixDataIntegrityHandler sets the operation handler for the fix data integrity operation
 	DatabaseFixDataIntegrityHandler database.FixDataIntegrityHandler
+	// TopologyGetTopologyHandler sets the operation handler for the get topology operation
+	TopologyGetTopologyHandler topology.GetTopologyHandler
+	// DatabaseImportDatabaseHandler sets the operation handler for the import database operation
+	DatabaseImportDatabaseHandler database.ImportDatabaseHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
 	// CircuitListCircuitsHandler sets the operation handler for the list circuits operation
@@ -250,6 +312,8 @@ type ZitiFabricAPI struct {
 	RouterListRouterTerminatorsHandler router.ListRouterTerminatorsHandler
 	// RouterListRoutersHandler sets the operation handler for the list routers operation
 	RouterListRoutersHandler router.ListRoutersHandler
+	// ServiceListServiceStatsHandler sets the operation handler for the list service stats operation
+	ServiceListServiceStatsHandler service.ListServiceStatsHandler
 	// ServiceListServiceTerminatorsHandler sets the operation handler for the list service terminators operation
 	ServiceListServiceTerminatorsHandler service.ListServiceTerminatorsHandler
 	// ServiceListServicesHandler sets the operation handler for the list services operation
@@ -264,6 +328,20 @@ type ZitiFabricAPI struct {
 	ServicePatchServiceHandler service.PatchServiceHandler
 	// TerminatorPatchTerminatorHandler sets the operation handler for the patch terminator operation
 	TerminatorPatchTerminatorHandler terminator.PatchTerminatorHandler
+	// RaftRaftAddMemberHandler sets the operation handler for the raft add member operation
+	RaftRaftAddMemberHandler raft.RaftAddMemberHandler
+	// RaftRaftClusterStatusHandler sets the operation handler for the raft cluster status operation
+	RaftRaftClusterStatusHandler raft.RaftClusterStatusHandler
+	// RaftRaftListMembersHandler sets the operation handler for the raft list members operation
+	RaftRaftListMembersHandler raft.RaftListMembersHandler
+	// RaftRaftRemoveMemberHandler sets the operation handler for the raft remove member operation
+	RaftRaftRemoveMemberHandler raft.RaftRemoveMemberHandler
+	// RaftRaftTransferLeadershipHandler sets the operation handler for the raft transfer leadership operation
+	RaftRaftTransferLeadershipHandler raft.RaftTransferLeadershipHandler
+	// DatabaseReconcileDatabaseHandler sets the operation handler for the reconcile database operation
+	DatabaseReconcileDatabaseHandler database.ReconcileDatabaseHandler
+	// CircuitTerminateCircuitsHandler sets the operation handler for the terminate circuits operation
+	CircuitTerminateCircuitsHandler circuit.TerminateCircuitsHandler
 	// RouterUpdateRouterHandler sets the operation handler for the update router operation
 	RouterUpdateRouterHandler router.UpdateRouterHandler
 	// ServiceUpdateServiceHandler sets the operation handler for the update service operation
@@ -342,10 +420,19 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.YamlConsumer == nil {
+		unregistered = append(unregistered, "YamlConsumer")
+	}
 
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextVndGraphvizProducer == nil {
+		unregistered = append(unregistered, "TextVndGraphvizProducer")
+	}
+	if o.YamlProducer == nil {
+		unregistered = append(unregistered, "YamlProducer")
+	}
 
 	if o.DatabaseCheckDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.CheckDataIntegrityHandler")
@@ -392,12 +479,24 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.ServiceDetailServiceHandler == nil {
 		unregistered = append(unregistered, "service.DetailServiceHandler")
 	}
+	if o.ServiceDetailServiceStatsHandler == nil {
+		unregistered = append(unregistered, "service.DetailServiceStatsHandler")
+	}
 	if o.TerminatorDetailTerminatorHandler == nil {
 		unregistered = append(unregistered, "terminator.DetailTerminatorHandler")
 	}
+	if o.DatabaseExportDatabaseHandler == nil {
+		unregistered = append(unregistered, "database.ExportDatabaseHandler")
+	}
 	if o.DatabaseFixDataIntegrityHandler == nil {
 		unregistered = append(unregistered, "database.FixDataIntegrityHandler")
 	}
+	if o.TopologyGetTopologyHandler == nil {
+		unregistered = append(unregistered, "topology.GetTopologyHandler")
+	}
+	if o.DatabaseImportDatabaseHandler == nil {
+		unregistered = append(unregistered, "database.ImportDatabaseHandler")
+	}
 	if o.InspectInspectHandler == nil {
 		unregistered = append(unregistered, "inspect.InspectHandler")
 	}
@@ -413,6 +512,9 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.RouterListRoutersHandler == nil {
 		unregistered = append(unregistered, "router.ListRoutersHandler")
 	}
+	if o.ServiceListServiceStatsHandler == nil {
+		unregistered = append(unregistered, "service.ListServiceStatsHandler")
+	}
 	if o.ServiceListServiceTerminatorsHandler == nil {
 		unregistered = append(unregistered, "service.ListServiceTerminatorsHandler")
 	}
@@ -434,6 +536,27 @@ func (o *ZitiFabricAPI) Validate() error {
 	if o.TerminatorPatchTerminatorHandler == nil {
 		unregistered = append(unregistered, "terminator.PatchTerminatorHandler")
 	}
+	if o.RaftRaftAddMemberHandler == nil {
+		unregistered = append(unregistered, "raft.RaftAddMemberHandler")
+	}
+	if o.RaftRaftClusterStatusHandler == nil {
+		unregistered = append(unregistered, "raft.RaftClusterStatusHandler")
+	}
+	if o.RaftRaftListMembersHandler == nil {
+		unregistered = append(unregistered, "raft.RaftListMembersHandler")
+	}
+	if o.RaftRaftRemoveMemberHandler == nil {
+		unregistered = append(unregistered, "raft.RaftRemoveMemberHandler")
+	}
+	if o.RaftRaftTransferLeadershipHandler == nil {
+		unregistered = append(unregistered, "raft.RaftTransferLeadershipHandler")
+	}
+	if o.DatabaseReconcileDatabaseHandler == nil {
+		unregistered = append(unregistered, "database.ReconcileDatabaseHandler")
+	}
+	if o.CircuitTerminateCircuitsHandler == nil {
+		unregistered = append(unregistered, "circuit.TerminateCircuitsHandler")
+	}
 	if o.RouterUpdateRouterHandler == nil {
 		unregistered = append(unregistered, "router.UpdateRouterHandler")
 	}
@@ -474,6 +597,8 @@ func (o *ZitiFabricAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Con
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "application/x-yaml":
+			result["application/x-yaml"] = o.YamlConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -491,6 +616,10 @@ func (o *ZitiFabricAPI) ProducersFor(mediaTypes []string) map[string]runtime.Pro
 		switch mt {
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/vnd.graphviz":
+			result["text/vnd.graphviz"] = o.TextVndGraphvizProducer
+		case "application/x-yaml":
+			result["application/x-yaml"] = o.YamlProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -594,11 +723,27 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/services/{id}/stats"] = service.NewDetailServiceStats(o.context, o.ServiceDetailServiceStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/terminators/{id}"] = terminator.NewDetailTerminator(o.context, o.TerminatorDetailTerminatorHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/database/export"] = database.NewExportDatabase(o.context, o.DatabaseExportDatabaseHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/database/fix-data-integrity"] = database.NewFixDataIntegrity(o.context, o.DatabaseFixDataIntegrityHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/topology"] = topology.NewGetTopology(o.context, o.TopologyGetTopologyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/database/import"] = database.NewImportDatabase(o.context, o.DatabaseImportDatabaseHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -622,6 +767,10 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/service-stats"] = service.NewListServiceStats(o.context, o.ServiceListServiceStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/services/{id}/terminators"] = service.NewListServiceTerminators(o.context, o.ServiceListServiceTerminatorsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -647,6 +796,34 @@ func (o *ZitiFabricAPI) initHandlerCache() {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
 	o.handlers["PATCH"]["/terminators/{id}"] = terminator.NewPatchTerminator(o.context, o.TerminatorPatchTerminatorHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/raft/members"] = raft.NewRaftAddMember(o.context, o.RaftRaftAddMemberHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/raft/status"] = raft.NewRaftClusterStatus(o.context, o.RaftRaftClusterStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/raft/members"] = raft.NewRaftListMembers(o.context, o.RaftRaftListMembersHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/raft/members/{id}"] = raft.NewRaftRemoveMember(o.context, o.RaftRaftRemoveMemberHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/raft/transfer-leadership"] = raft.NewRaftTransferLeadership(o.context, o.RaftRaftTransferLeadershipHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/database/reconcile"] = database.NewReconcileDatabase(o.context, o.DatabaseReconcileDatabaseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/circuits/terminate"] = circuit.NewTerminateCircuits(o.context, o.CircuitTerminateCircuitsHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -143,7 +143,8 @@ func (self *Router) debugOpUpdateRouter(c *bufio.ReadWriter) error {
 	logrus.Errorf("updating with route: %+v", route)
 	logrus.Errorf("updating with route: %v", route)
 
-	self.forwarder.Route(route)
+	// debug routes aren't owned by any particular controller, so circuit notifications will go to the leader
+	self.forwarder.Route("", route)
 	_, _ = c.WriteString("route added")
 	return nil
 }
@@ -151,7 +152,13 @@ func (self *Router) debugOpUpdateRouter(c *bufio.ReadWriter) error {
 func (self *Router) debugOpCloseControlChannel(c *bufio.ReadWriter) error {
 	logrus.Warn("control channel: closing")
 	_, _ = c.WriteString("control channel: closing\n")
-	if toggleable, ok := self.Channel().Underlay().(connectionToggle); ok {
+	ch := self.ctrls.LeaderCtrlChannel()
+	if ch == nil {
+		logrus.Warn("control channel: not connected")
+		_, _ = c.WriteString("control channel: not connected")
+		return nil
+	}
+	if toggleable, ok := ch.Underlay().(connectionToggle); ok {
 		if err := toggleable.Disconnect(); err != nil {
 			logrus.WithError(err).Error("control channel: failed to close")
 			_, _ = c.WriteString(fmt.Sprintf("control channel: failed to close (%v)\n", err))
@@ -168,7 +175,13 @@ func (self *Router) debugOpCloseControlChannel(c *bufio.ReadWriter) error {
 
 func (self *Router) debugOpOpenControlChannel(c *bufio.ReadWriter) error {
 	logrus.Warn("control channel: reconnecting")
-	if togglable, ok := self.Channel().Underlay().(connectionToggle); ok {
+	ch := self.ctrls.LeaderCtrlChannel()
+	if ch == nil {
+		logrus.Warn("control channel: not connected")
+		_, _ = c.WriteString("control channel: not connected")
+		return nil
+	}
+	if togglable, ok := ch.Underlay().(connectionToggle); ok {
 		if err := togglable.Reconnect(); err != nil {
 			logrus.WithError(err).Error("control channel: failed to reconnect")
 			_, _ = c.WriteString(fmt.Sprintf("control channel: failed to reconnect (%v)\n", err))
@@ -33,6 +33,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strings"
 	"sync/atomic"
 	"time"
 )
@@ -52,6 +53,9 @@ const (
 	// CtrlEndpointMapKey is the string key for the ctrl.endpoint section
 	CtrlEndpointMapKey = "endpoint"
 
+	// CtrlEndpointsMapKey is the string key for the ctrl.endpoints section
+	CtrlEndpointsMapKey = "endpoints"
+
 	// CtrlEndpointBindMapKey is the string key for the ctrl.bind section
 	CtrlEndpointBindMapKey = "bind"
 )
@@ -101,7 +105,10 @@ type Config struct {
 		}
 	}
 	Ctrl struct {
-		Endpoint              *UpdatableAddress
+		// Endpoint is the primary controller endpoint, which is also the first entry in Endpoints
+		Endpoint *UpdatableAddress
+		// Endpoints are all the controller endpoints the router should connect to
+		Endpoints             []*UpdatableAddress
 		LocalBinding          string
 		DefaultRequestTimeout time.Duration
 		Options               *channel.Options
@@ -230,15 +237,18 @@ func (config *Config) Save() error {
 	return err
 }
 
-// UpdateControllerEndpoint updates the runtime configuration address of the controller and the
-// internal map configuration.
-func (config *Config) UpdateControllerEndpoint(address string) error {
+// UpdateControllerEndpoint updates the runtime address of the given controller endpoint and the matching entry,
+// under either ctrl.endpoint or ctrl.endpoints, in the internal map configuration.
+func (config *Config) UpdateControllerEndpoint(endpoint *UpdatableAddress, address string) error {
 	if parsedAddress, err := transport.ParseAddress(address); parsedAddress != nil && err == nil {
-		if config.Ctrl.Endpoint.String() != address {
+		currentAddress := endpoint.String()
+		if currentAddress != address {
 			//config file update
 			if ctrlVal, ok := config.src[CtrlMapKey]; ok {
 				if ctrlMap, ok := ctrlVal.(map[interface{}]interface{}); ok {
-					ctrlMap[CtrlEndpointMapKey] = address
+					if !updateCtrlEndpointSrc(ctrlMap, currentAddress, address) {
+						return fmt.Errorf("source ctrl endpoint %v not found", currentAddress)
+					}
 				} else {
 					return errors.New("source ctrl found but not map[interface{}]interface{}")
 				}
@@ -247,7 +257,7 @@ func (config *Config) UpdateControllerEndpoint(address string) error {
 			}
 
 			//runtime update
-			config.Ctrl.Endpoint.Store(parsedAddress)
+			endpoint.Store(parsedAddress)
 
 		}
 	} else {
@@ -257,6 +267,39 @@ func (config *Config) UpdateControllerEndpoint(address string) error {
 	return nil
 }
 
+// updateCtrlEndpointSrc replaces the current address wherever it's configured in the ctrl section. Returns false
+// if the address wasn't found
+func updateCtrlEndpointSrc(ctrlMap map[interface{}]interface{}, currentAddress, address string) bool {
+	updated := false
+	if value, ok := ctrlMap[CtrlEndpointMapKey].(string); ok && strings.TrimSpace(value) == currentAddress {
+		ctrlMap[CtrlEndpointMapKey] = address
+		updated = true
+	}
+	if endpoints, ok := ctrlMap[CtrlEndpointsMapKey].([]interface{}); ok {
+		for i, value := range endpoints {
+			if endpointStr, ok := value.(string); ok && strings.TrimSpace(endpointStr) == currentAddress {
+				endpoints[i] = address
+				updated = true
+			}
+		}
+	}
+	return updated
+}
+
+// ctrlEndpointChanger lets the control channel to a single controller update the address of that controller
+type ctrlEndpointChanger struct {
+	*Config
+	endpoint *UpdatableAddress
+}
+
+func (self *ctrlEndpointChanger) CurrentCtrlAddress() string {
+	return self.endpoint.String()
+}
+
+func (self *ctrlEndpointChanger) UpdateControllerEndpoint(address string) error {
+	return self.Config.UpdateControllerEndpoint(self.endpoint, address)
+}
+
 // UpdatableAddress allows a single address to be passed to multiple channel implementations and be centrally updated
 // in a thread safe manner.
 type UpdatableAddress struct {
@@ -413,6 +456,30 @@ func LoadConfig(path string) (*Config, error) {
 					return nil, fmt.Errorf("cannot parse [ctrl/endpoint] (%s)", err)
 				}
 				cfg.Ctrl.Endpoint = NewUpdatableAddress(address)
+				cfg.Ctrl.Endpoints = append(cfg.Ctrl.Endpoints, cfg.Ctrl.Endpoint)
+			}
+			if value, found := submap[CtrlEndpointsMapKey]; found {
+				endpoints, ok := value.([]interface{})
+				if !ok {
+					return nil, fmt.Errorf("[ctrl/endpoints] must be a list of addresses")
+				}
+				for _, endpoint := range endpoints {
+					endpointStr, ok := endpoint.(string)
+					if !ok {
+						return nil, fmt.Errorf("[ctrl/endpoints] must be a list of addresses")
+					}
+					address, err := transport.ParseAddress(endpointStr)
+					if err != nil {
+						return nil, fmt.Errorf("cannot parse [ctrl/endpoints] value %v (%s)", endpointStr, err)
+					}
+					if cfg.Ctrl.Endpoint != nil && cfg.Ctrl.Endpoint.String() == address.String() {
+						continue
+					}
+					cfg.Ctrl.Endpoints = append(cfg.Ctrl.Endpoints, NewUpdatableAddress(address))
+				}
+				if cfg.Ctrl.Endpoint == nil && len(cfg.Ctrl.Endpoints) > 0 {
+					cfg.Ctrl.Endpoint = cfg.Ctrl.Endpoints[0]
+				}
 			}
 			if value, found := submap[CtrlEndpointBindMapKey]; found {
 				_, err := transport.ResolveInterface(value.(string))
@@ -0,0 +1,49 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package router
+
+import (
+	"github.com/openziti/transport/v2"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestUpdateControllerEndpoint(t *testing.T) {
+	req := require.New(t)
+	transport.AddAddressParser(tcp.AddressParser{})
+
+	ctrlMap := map[interface{}]interface{}{
+		CtrlEndpointsMapKey: []interface{}{"tcp:ctrl-a:6262", "tcp:ctrl-b:6262"},
+	}
+	config := &Config{src: map[interface{}]interface{}{CtrlMapKey: ctrlMap}}
+	for _, endpoint := range []string{"tcp:ctrl-a:6262", "tcp:ctrl-b:6262"} {
+		address, err := transport.ParseAddress(endpoint)
+		req.NoError(err)
+		config.Ctrl.Endpoints = append(config.Ctrl.Endpoints, NewUpdatableAddress(address))
+	}
+	config.Ctrl.Endpoint = config.Ctrl.Endpoints[0]
+
+	// a new address announced by the second controller only changes that controller's endpoint
+	changer := &ctrlEndpointChanger{Config: config, endpoint: config.Ctrl.Endpoints[1]}
+	req.NoError(changer.UpdateControllerEndpoint("tcp:ctrl-b2:6262"))
+
+	req.Equal("tcp:ctrl-a:6262", config.Ctrl.Endpoint.String())
+	req.Equal("tcp:ctrl-b2:6262", config.Ctrl.Endpoints[1].String())
+	req.Equal([]interface{}{"tcp:ctrl-a:6262", "tcp:ctrl-b2:6262"}, ctrlMap[CtrlEndpointsMapKey])
+	req.NotContains(ctrlMap, CtrlEndpointMapKey)
+}
@@ -0,0 +1,263 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/foundation/v2/concurrenz"
+	"github.com/openziti/foundation/v2/versions"
+	"sync/atomic"
+	"time"
+)
+
+// UnresponsiveThreshold is how long a controller may go without any heartbeat traffic before it is passed over
+// in favor of another controller
+const UnresponsiveThreshold = 30 * time.Second
+
+// CircuitReconciliationMinCtrlVersion is the first controller version which handles circuit reconciliation requests.
+// Older controllers are sent circuit confirmations instead
+const CircuitReconciliationMinCtrlVersion = "0.19.1"
+
+// NetworkController is a router's control channel to a single controller
+type NetworkController interface {
+	// Address is the configured endpoint of the controller. It is used as the controller identifier
+	Address() string
+	Channel() channel.Channel
+	// IsLeader returns true if the controller has reported that it is the cluster leader
+	IsLeader() bool
+	// IsResponsive returns false if the channel is closed or no heartbeats have been received recently
+	IsResponsive() bool
+	HeartbeatCallback() channel.HeartbeatCallback
+	// MarkContact records that the controller was heard from, for example on reconnect
+	MarkContact()
+	// SupportsCircuitReconciliation returns true if the controller reported a version which handles circuit
+	// reconciliation requests
+	SupportsCircuitReconciliation() bool
+}
+
+// NetworkControllers tracks the control channels of a router, which may be connected to several controllers
+// when the controllers are clustered.
+//
+// Requests which create cluster state, such as circuits and terminators, should go to the leader, via
+// LeaderCtrlChannel. State which every controller tracks independently, such as links, should be sent to
+// every controller, via ForEach. Responses should go back on the channel the request arrived on.
+type NetworkControllers interface {
+	// Add registers the channel for the controller at the given address, replacing any previous channel
+	Add(address string, ch channel.Channel) NetworkController
+	// Get returns the controller at the given address, if there is one
+	Get(address string) (NetworkController, bool)
+	// UpdateLeader records the leadership state reported by the controller at the given address. A controller
+	// claiming leadership supersedes any earlier claim from other controllers
+	UpdateLeader(address string, isLeader bool)
+	// LeaderCtrl returns the leader, if known and responsive, otherwise falls back to any controller, in the same
+	// way as LeaderCtrlChannel. Returns false if no controller channels have been established yet
+	LeaderCtrl() (NetworkController, bool)
+	// LeaderCtrlChannel returns the channel to the leader, if known and responsive, otherwise falls back to
+	// AnyCtrlChannel
+	LeaderCtrlChannel() channel.Channel
+	// AnyCtrlChannel returns a channel to a responsive controller, or any controller if none are responsive.
+	// Returns nil if no controller channels have been established yet
+	AnyCtrlChannel() channel.Channel
+	// GetCtrlChannel returns the channel for the given controller, if it's known and responsive. Otherwise
+	// returns LeaderCtrlChannel
+	GetCtrlChannel(address string) channel.Channel
+	// ForEach invokes the callback for every known controller
+	ForEach(f func(ctrl NetworkController))
+	// Close closes all controller channels
+	Close() error
+}
+
+func NewNetworkControllers() NetworkControllers {
+	return &networkControllers{}
+}
+
+type networkControllers struct {
+	ctrls concurrenz.CopyOnWriteMap[string, *networkCtrl]
+}
+
+func (self *networkControllers) Add(address string, ch channel.Channel) NetworkController {
+	ctrl := &networkCtrl{
+		address: address,
+		ch:      ch,
+	}
+	ctrl.MarkContact()
+	self.ctrls.Put(address, ctrl)
+	pfxlog.Logger().WithField("ctrlAddress", address).Info("added network controller")
+	return ctrl
+}
+
+func (self *networkControllers) Get(address string) (NetworkController, bool) {
+	if ctrl := self.ctrls.Get(address); ctrl != nil {
+		return ctrl, true
+	}
+	return nil, false
+}
+
+func (self *networkControllers) UpdateLeader(address string, isLeader bool) {
+	log := pfxlog.Logger().WithField("ctrlAddress", address).WithField("isLeader", isLeader)
+	ctrl := self.ctrls.Get(address)
+	if ctrl == nil {
+		log.Warn("leadership update from unknown controller")
+		return
+	}
+
+	if isLeader {
+		for _, other := range self.ctrls.AsMap() {
+			if other != ctrl {
+				other.isLeader.Set(false)
+			}
+		}
+	}
+	ctrl.isLeader.Set(isLeader)
+	log.Info("controller leadership updated")
+}
+
+func (self *networkControllers) LeaderCtrl() (NetworkController, bool) {
+	if ctrl := self.leaderCtrl(); ctrl != nil {
+		return ctrl, true
+	}
+	return nil, false
+}
+
+func (self *networkControllers) LeaderCtrlChannel() channel.Channel {
+	if ctrl := self.leaderCtrl(); ctrl != nil {
+		return ctrl.ch
+	}
+	return nil
+}
+
+func (self *networkControllers) AnyCtrlChannel() channel.Channel {
+	if ctrl := self.anyCtrl(); ctrl != nil {
+		return ctrl.ch
+	}
+	return nil
+}
+
+func (self *networkControllers) leaderCtrl() *networkCtrl {
+	for _, ctrl := range self.ctrls.AsMap() {
+		if ctrl.IsLeader() && ctrl.IsResponsive() {
+			return ctrl
+		}
+	}
+	return self.anyCtrl()
+}
+
+func (self *networkControllers) anyCtrl() *networkCtrl {
+	var fallback *networkCtrl
+	for _, ctrl := range self.ctrls.AsMap() {
+		if ctrl.IsResponsive() {
+			return ctrl
+		}
+		if fallback == nil || ctrl.lastContact() > fallback.lastContact() {
+			fallback = ctrl
+		}
+	}
+	return fallback
+}
+
+func (self *networkControllers) GetCtrlChannel(address string) channel.Channel {
+	if ctrl := self.ctrls.Get(address); ctrl != nil && ctrl.IsResponsive() {
+		return ctrl.ch
+	}
+	return self.LeaderCtrlChannel()
+}
+
+func (self *networkControllers) ForEach(f func(ctrl NetworkController)) {
+	for _, ctrl := range self.ctrls.AsMap() {
+		f(ctrl)
+	}
+}
+
+func (self *networkControllers) Close() error {
+	var err error
+	for _, ctrl := range self.ctrls.AsMap() {
+		if closeErr := ctrl.ch.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+type networkCtrl struct {
+	address      string
+	ch           channel.Channel
+	isLeader     concurrenz.AtomicBoolean
+	lastContactV int64
+}
+
+func (self *networkCtrl) Address() string {
+	return self.address
+}
+
+func (self *networkCtrl) Channel() channel.Channel {
+	return self.ch
+}
+
+func (self *networkCtrl) IsLeader() bool {
+	return self.isLeader.Get()
+}
+
+func (self *networkCtrl) IsResponsive() bool {
+	if self.ch == nil || self.ch.IsClosed() {
+		return false
+	}
+	return time.Since(time.UnixMilli(self.lastContact())) < UnresponsiveThreshold
+}
+
+func (self *networkCtrl) HeartbeatCallback() channel.HeartbeatCallback {
+	return self
+}
+
+func (self *networkCtrl) MarkContact() {
+	atomic.StoreInt64(&self.lastContactV, time.Now().UnixMilli())
+}
+
+func (self *networkCtrl) SupportsCircuitReconciliation() bool {
+	// checked on each call, as the controller may have been upgraded when the channel reconnects
+	if self.ch == nil || self.ch.Underlay() == nil {
+		return false
+	}
+	versionValue, found := self.ch.Underlay().Headers()[channel.HelloVersionHeader]
+	if !found {
+		return false
+	}
+	versionInfo, err := versions.StdVersionEncDec.Decode(versionValue)
+	if err != nil {
+		return false
+	}
+	supported, err := versionInfo.HasMinimumVersion(CircuitReconciliationMinCtrlVersion)
+	return err == nil && supported
+}
+
+func (self *networkCtrl) lastContact() int64 {
+	return atomic.LoadInt64(&self.lastContactV)
+}
+
+func (self *networkCtrl) HeartbeatTx(int64) {}
+
+func (self *networkCtrl) HeartbeatRx(int64) {
+	self.MarkContact()
+}
+
+func (self *networkCtrl) HeartbeatRespTx(int64) {}
+
+func (self *networkCtrl) HeartbeatRespRx(int64) {
+	self.MarkContact()
+}
+
+func (self *networkCtrl) CheckHeartBeat() {}
@@ -0,0 +1,100 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"github.com/openziti/channel"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testChannel struct {
+	channel.Channel
+	closed bool
+}
+
+func (self *testChannel) IsClosed() bool {
+	return self.closed
+}
+
+func (self *testChannel) Close() error {
+	self.closed = true
+	return nil
+}
+
+func TestNetworkControllers(t *testing.T) {
+	req := require.New(t)
+
+	ctrls := NewNetworkControllers()
+	req.Nil(ctrls.AnyCtrlChannel())
+	req.Nil(ctrls.LeaderCtrlChannel())
+	_, found := ctrls.LeaderCtrl()
+	req.False(found)
+
+	ch1 := &testChannel{}
+	ch2 := &testChannel{}
+	ctrls.Add("tls:ctrl1:6262", ch1)
+	ctrl2 := ctrls.Add("tls:ctrl2:6262", ch2)
+
+	// with no leader known, any responsive controller will do
+	req.NotNil(ctrls.LeaderCtrlChannel())
+
+	ctrls.UpdateLeader("tls:ctrl2:6262", true)
+	req.True(ctrl2.IsLeader())
+	req.Equal(ch2, ctrls.LeaderCtrlChannel())
+
+	// leadership moves when another controller claims it
+	ctrls.UpdateLeader("tls:ctrl1:6262", true)
+	req.False(ctrl2.IsLeader())
+	req.Equal(ch1, ctrls.LeaderCtrlChannel())
+
+	leader, found := ctrls.LeaderCtrl()
+	req.True(found)
+	req.Equal("tls:ctrl1:6262", leader.Address())
+
+	// circuit owners are used if responsive, otherwise the leader
+	req.Equal(ch2, ctrls.GetCtrlChannel("tls:ctrl2:6262"))
+	req.Equal(ch1, ctrls.GetCtrlChannel("tls:unknown:6262"))
+	req.Equal(ch1, ctrls.GetCtrlChannel(""))
+
+	// an unresponsive leader is passed over
+	ctrl1, found := ctrls.Get("tls:ctrl1:6262")
+	req.True(found)
+	atomic.StoreInt64(&ctrl1.(*networkCtrl).lastContactV, time.Now().Add(-2*UnresponsiveThreshold).UnixMilli())
+	req.False(ctrl1.IsResponsive())
+	req.Equal(ch2, ctrls.LeaderCtrlChannel())
+
+	// heartbeats mark the controller responsive again
+	ctrl1.HeartbeatCallback().HeartbeatRespRx(0)
+	req.True(ctrl1.IsResponsive())
+	req.Equal(ch1, ctrls.LeaderCtrlChannel())
+
+	count := 0
+	ctrls.ForEach(func(NetworkController) {
+		count++
+	})
+	req.Equal(2, count)
+
+	req.NoError(ctrls.Close())
+	req.True(ch1.IsClosed())
+	req.True(ch2.IsClosed())
+
+	// closed channels are only returned as a last resort
+	req.NotNil(ctrls.AnyCtrlChannel())
+}
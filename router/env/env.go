@@ -18,7 +18,6 @@ package env
 
 import (
 	"github.com/openziti/channel"
-	"github.com/openziti/fabric/controller/xctrl"
 	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xlink"
 	"github.com/openziti/identity"
@@ -30,9 +29,10 @@ type RouterEnv interface {
 	GetRouterId() *identity.TokenId
 	GetDialerCfg() map[string]xgress.OptionsData
 	GetXlinkDialer() []xlink.Dialer
-	GetXtrls() []xctrl.Xctrl
+	GetXrctrls() []Xrctrl
 	GetTraceHandler() *channel.TraceHandler
 	GetXlinkRegistry() xlink.Registry
 	GetCloseNotify() <-chan struct{}
 	GetMetricsRegistry() metrics.UsageRegistry
+	GetNetworkControllers() NetworkControllers
 }
@@ -0,0 +1,35 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package env
+
+import (
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/config"
+)
+
+// Xrctrl is the router side counterpart of the controller's xctrl.Xctrl. Since a router may be connected to several
+// controllers, plugins are given the router environment, rather than a single control channel. They can use
+// GetNetworkControllers to pick the leader, a specific controller, or all of them, as appropriate.
+type Xrctrl interface {
+	config.Subconfig
+	channel.BindHandler
+	Enabled() bool
+	Run(env RouterEnv) error
+	// NotifyOfReconnect is called with the control channel of a controller which has reconnected
+	NotifyOfReconnect(ch channel.Channel)
+	GetTraceDecoders() []channel.TraceMessageDecoder
+}
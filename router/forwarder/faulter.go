@@ -18,9 +18,9 @@ package forwarder
 
 import (
 	"github.com/michaelquigley/pfxlog"
-	"github.com/openziti/channel"
 	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
 	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/sirupsen/logrus"
 	"strings"
@@ -28,36 +28,36 @@ import (
 )
 
 type Faulter struct {
-	ctrl        channel.Channel
+	ctrls       env.NetworkControllers
 	interval    time.Duration
 	circuitIds  cmap.ConcurrentMap[struct{}]
 	closeNotify chan struct{}
 }
 
-func NewFaulter(interval time.Duration, closeNotify chan struct{}) *Faulter {
-	f := &Faulter{interval: interval, circuitIds: cmap.New[struct{}](), closeNotify: closeNotify}
+func NewFaulter(ctrls env.NetworkControllers, interval time.Duration, closeNotify chan struct{}) *Faulter {
+	f := &Faulter{ctrls: ctrls, interval: interval, circuitIds: cmap.New[struct{}](), closeNotify: closeNotify}
 	if interval > 0 {
 		go f.run()
 	}
 	return f
 }
 
-func (self *Faulter) SetCtrl(ch channel.Channel) {
-	self.ctrl = ch
-}
-
 func (self *Faulter) report(circuitId string) {
 	if self.interval > 0 {
 		self.circuitIds.Set(circuitId, struct{}{})
 	}
 }
 
+// notifyInvalidLink reports the link fault to all controllers, as each controller tracks links independently
 func (self *Faulter) notifyInvalidLink(linkId string) {
 	log := pfxlog.Logger()
 	fault := &ctrl_pb.Fault{Subject: ctrl_pb.FaultSubject_LinkFault, Id: linkId}
-	if err := protobufs.MarshalTyped(fault).Send(self.ctrl); err != nil {
-		log.WithError(err).WithField("linkId", linkId).Error("failed to notify of invalid link")
-	}
+	self.ctrls.ForEach(func(ctrl env.NetworkController) {
+		if err := protobufs.MarshalTyped(fault).Send(ctrl.Channel()); err != nil {
+			log.WithError(err).WithField("linkId", linkId).WithField("ctrlAddress", ctrl.Address()).
+				Error("failed to notify of invalid link")
+		}
+	})
 }
 
 func (self *Faulter) run() {
@@ -74,9 +74,11 @@ func (self *Faulter) run() {
 					self.circuitIds.Remove(circuitId)
 				}
 
+				// forwarding faults are for circuits we no longer have state for, so we don't know which
+				// controller owns them. The leader is where new circuits are created, so report there
 				circuitIds := strings.Join(workload, " ")
 				fault := &ctrl_pb.Fault{Subject: ctrl_pb.FaultSubject_ForwardFault, Id: circuitIds}
-				if err := protobufs.MarshalTyped(fault).Send(self.ctrl); err == nil {
+				if err := protobufs.MarshalTyped(fault).Send(self.ctrls.LeaderCtrlChannel()); err == nil {
 					logrus.WithField("circuitCount", len(workload)).Warn("reported forwarding faults")
 				} else {
 					logrus.WithError(err).Error("error sending fault report")
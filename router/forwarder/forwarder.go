@@ -26,20 +26,33 @@ import (
 	"github.com/openziti/metrics"
 	"github.com/openziti/foundation/v2/errorz"
 	"github.com/openziti/foundation/v2/info"
+	"github.com/orcaman/concurrent-map/v2"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"sync"
 	"time"
 )
 
 type Forwarder struct {
-	circuits        *circuitTable
-	destinations    *destinationTable
-	faulter         *Faulter
-	scanner         *Scanner
-	metricsRegistry metrics.UsageRegistry
-	traceController trace.Controller
-	Options         *Options
-	CloseNotify     <-chan struct{}
+	circuits            *circuitTable
+	destinations        *destinationTable
+	linkSchedulers      cmap.ConcurrentMap[*linkScheduler]
+	serviceLimiters     map[string]*serviceRateLimiter
+	serviceLimitersLock sync.Mutex
+	faulter             *Faulter
+	scanner             *Scanner
+	metricsRegistry     metrics.UsageRegistry
+	traceController     trace.Controller
+	Options             *Options
+	CloseNotify         <-chan struct{}
+}
+
+// serviceRateLimiter is a rate limiter shared by the circuits for a service. It, and its throttled bytes meter, are
+// disposed of once the last of those circuits is removed
+type serviceRateLimiter struct {
+	limiter  *xgress.RateLimiter
+	meter    metrics.Meter
+	circuits map[string]struct{}
 }
 
 type Destination interface {
@@ -49,6 +62,11 @@ type Destination interface {
 	InspectCircuit(detail *inspect.CircuitInspectDetail)
 }
 
+// BandwidthLimitedDestination is implemented by destinations which can shape the traffic they read from their peer
+type BandwidthLimitedDestination interface {
+	SetBandwidthLimits(circuitLimit uint64, serviceRateLimiter *xgress.RateLimiter)
+}
+
 type XgressDestination interface {
 	Destination
 	Unrouted()
@@ -62,6 +80,8 @@ func NewForwarder(metricsRegistry metrics.UsageRegistry, faulter *Faulter, scann
 	f := &Forwarder{
 		circuits:        newCircuitTable(),
 		destinations:    newDestinationTable(),
+		linkSchedulers:  cmap.New[*linkScheduler](),
+		serviceLimiters: map[string]*serviceRateLimiter{},
 		faulter:         faulter,
 		scanner:         scanner,
 		metricsRegistry: metricsRegistry,
@@ -69,7 +89,7 @@ func NewForwarder(metricsRegistry metrics.UsageRegistry, faulter *Faulter, scann
 		Options:         options,
 		CloseNotify:     closeNotify,
 	}
-	f.scanner.setCircuitTable(f.circuits)
+	f.scanner.setForwarder(f)
 	return f
 }
 
@@ -84,6 +104,7 @@ func (forwarder *Forwarder) TraceController() trace.Controller {
 func (forwarder *Forwarder) RegisterDestination(circuitId string, address xgress.Address, destination Destination) {
 	forwarder.destinations.addDestination(address, destination)
 	forwarder.destinations.linkDestinationToCircuit(circuitId, address)
+	forwarder.applyBandwidthLimits(circuitId)
 }
 
 func (forwarder *Forwarder) UnregisterDestinations(circuitId string) {
@@ -113,21 +134,40 @@ func (forwarder *Forwarder) RegisterLink(link xlink.Xlink) error {
 	if !forwarder.destinations.addDestinationIfAbsent(xgress.Address(link.Id().Token), link) {
 		return errors.Errorf("unable to register link %v as it is already registered", link.Id().Token)
 	}
+	scheduler := newLinkScheduler(link, forwarder.Options)
+	forwarder.linkSchedulers.Set(link.Id().Token, scheduler)
+	go scheduler.run()
 	return nil
 }
 
 func (forwarder *Forwarder) UnregisterLink(link xlink.Xlink) {
 	forwarder.destinations.removeDestination(xgress.Address(link.Id().Token))
+	if scheduler, found := forwarder.linkSchedulers.Get(link.Id().Token); found && scheduler.link == link {
+		forwarder.linkSchedulers.Remove(link.Id().Token)
+		scheduler.close()
+	}
 }
 
-func (forwarder *Forwarder) Route(route *ctrl_pb.Route) error {
+// Route installs the forwarding entries for a circuit. The ctrlId identifies the controller which owns the
+// circuit, so that circuit related notifications, such as faults and reconciliation, can be sent back to it
+func (forwarder *Forwarder) Route(ctrlId string, route *ctrl_pb.Route) error {
 	circuitId := route.CircuitId
 	var circuitFt *forwardTable
 	if ft, found := forwarder.circuits.getForwardTable(circuitId); found {
 		circuitFt = ft
 	} else {
-		circuitFt = newForwardTable()
+		circuitFt = newForwardTable(ctrlId)
+	}
+	circuitFt.setPriority(route.Priority)
+	limits := &bandwidthLimits{
+		serviceId:    route.ServiceId,
+		serviceLimit: route.ServiceBandwidthLimit,
+		circuitLimit: route.CircuitBandwidthLimit,
+	}
+	if previous := circuitFt.bandwidthLimits.Load(); previous != nil && (previous.serviceId != limits.serviceId || limits.serviceLimit == 0) {
+		forwarder.releaseServiceRateLimiter(previous.serviceId, circuitId)
 	}
+	circuitFt.bandwidthLimits.Store(limits)
 	for _, forward := range route.Forwards {
 		if !forwarder.HasDestination(xgress.Address(forward.DstAddress)) {
 			if forward.DstType == ctrl_pb.DestType_Link {
@@ -142,12 +182,103 @@ func (forwarder *Forwarder) Route(route *ctrl_pb.Route) error {
 		circuitFt.setForwardAddress(xgress.Address(forward.SrcAddress), xgress.Address(forward.DstAddress))
 	}
 	forwarder.circuits.setForwardTable(circuitId, circuitFt)
+	forwarder.applyBandwidthLimits(circuitId)
 	return nil
 }
 
+// applyBandwidthLimits passes the circuit's bandwidth limits to its xgress destinations. It's called both when
+// routing and when registering destinations, as egress xgress instances are bound before the route is installed
+func (forwarder *Forwarder) applyBandwidthLimits(circuitId string) {
+	ft, found := forwarder.circuits.circuits.Get(circuitId)
+	if !found {
+		return
+	}
+
+	limits := ft.bandwidthLimits.Load()
+	if limits == nil {
+		return
+	}
+
+	serviceRateLimiter := forwarder.acquireServiceRateLimiter(limits.serviceId, circuitId, limits.serviceLimit)
+
+	// the circuit may have been removed while the limiter was acquired, in which case nothing else will release it
+	if current, found := forwarder.circuits.circuits.Get(circuitId); serviceRateLimiter != nil && (!found || current != ft) {
+		forwarder.releaseServiceRateLimiter(limits.serviceId, circuitId)
+		return
+	}
+
+	if addresses, found := forwarder.destinations.getAddressesForCircuit(circuitId); found {
+		for _, address := range addresses {
+			if destination, found := forwarder.destinations.getDestination(address); found {
+				if limited, ok := destination.(BandwidthLimitedDestination); ok {
+					limited.SetBandwidthLimits(limits.circuitLimit, serviceRateLimiter)
+				}
+			}
+		}
+	}
+}
+
+// acquireServiceRateLimiter returns the rate limiter shared by all circuits on this router for the given service, or
+// nil if the service isn't limited. The circuit holds a reference to the limiter until it's released
+func (forwarder *Forwarder) acquireServiceRateLimiter(serviceId string, circuitId string, limit uint64) *xgress.RateLimiter {
+	if serviceId == "" || limit == 0 {
+		return nil
+	}
+
+	forwarder.serviceLimitersLock.Lock()
+	defer forwarder.serviceLimitersLock.Unlock()
+
+	serviceLimiter, found := forwarder.serviceLimiters[serviceId]
+	if !found {
+		meter := forwarder.metricsRegistry.Meter("xgress.service." + serviceId + ".throttled_bytes")
+		serviceLimiter = &serviceRateLimiter{
+			limiter:  xgress.NewRateLimiter(limit, meter),
+			meter:    meter,
+			circuits: map[string]struct{}{},
+		}
+		forwarder.serviceLimiters[serviceId] = serviceLimiter
+	}
+	serviceLimiter.circuits[circuitId] = struct{}{}
+	serviceLimiter.limiter.SetRate(limit)
+	return serviceLimiter.limiter
+}
+
+// releaseServiceRateLimiter drops the circuit's reference to the service's rate limiter, disposing of the limiter
+// and its meter if no other circuits are using it
+func (forwarder *Forwarder) releaseServiceRateLimiter(serviceId string, circuitId string) {
+	forwarder.serviceLimitersLock.Lock()
+	defer forwarder.serviceLimitersLock.Unlock()
+
+	if serviceLimiter, found := forwarder.serviceLimiters[serviceId]; found {
+		delete(serviceLimiter.circuits, circuitId)
+		if len(serviceLimiter.circuits) == 0 {
+			delete(forwarder.serviceLimiters, serviceId)
+			serviceLimiter.meter.Dispose()
+		}
+	}
+}
+
+// removeForwardTable removes the circuit's forwarding entries, releasing any service rate limiter it was using
+func (forwarder *Forwarder) removeForwardTable(circuitId string) {
+	if ft, found := forwarder.circuits.circuits.Get(circuitId); found {
+		forwarder.circuits.removeForwardTable(circuitId)
+		if limits := ft.bandwidthLimits.Load(); limits != nil {
+			forwarder.releaseServiceRateLimiter(limits.serviceId, circuitId)
+		}
+	}
+}
+
+// GetCircuitCtrlId returns the id of the controller which routed the given circuit, if the circuit is known
+func (forwarder *Forwarder) GetCircuitCtrlId(circuitId string) (string, bool) {
+	if ft, found := forwarder.circuits.circuits.Get(circuitId); found {
+		return ft.ctrlId.Load(), true
+	}
+	return "", false
+}
+
 func (forwarder *Forwarder) Unroute(circuitId string, now bool) {
 	if now {
-		forwarder.circuits.removeForwardTable(circuitId)
+		forwarder.removeForwardTable(circuitId)
 		forwarder.EndCircuit(circuitId)
 	} else {
 		go forwarder.unrouteTimeout(circuitId, forwarder.Options.XgressCloseCheckInterval)
@@ -164,7 +295,13 @@ func (forwarder *Forwarder) ForwardPayload(srcAddr xgress.Address, payload *xgre
 	circuitId := payload.GetCircuitId()
 	if forwardTable, found := forwarder.circuits.getForwardTable(circuitId); found {
 		if dstAddr, found := forwardTable.getForwardAddress(srcAddr); found {
-			if dst, found := forwarder.destinations.getDestination(dstAddr); found {
+			if scheduler, found := forwarder.linkSchedulers.Get(string(dstAddr)); found {
+				if err := scheduler.send(payload, forwardTable.getPriority()); err != nil {
+					return err
+				}
+				log.WithFields(payload.GetLoggerFields()).Debugf("=> %s", string(dstAddr))
+				return nil
+			} else if dst, found := forwarder.destinations.getDestination(dstAddr); found {
 				if err := dst.SendPayload(payload); err != nil {
 					return err
 				}
@@ -280,12 +417,12 @@ func (forwarder *Forwarder) unrouteTimeout(circuitId string, interval time.Durat
 			if dest := forwarder.getXgressForCircuit(circuitId); dest != nil {
 				elapsedDelta := info.NowInMilliseconds() - dest.GetTimeOfLastRxFromLink()
 				if (time.Duration(elapsedDelta) * time.Millisecond) >= interval {
-					forwarder.circuits.removeForwardTable(circuitId)
+					forwarder.removeForwardTable(circuitId)
 					forwarder.EndCircuit(circuitId)
 					return
 				}
 			} else {
-				forwarder.circuits.removeForwardTable(circuitId)
+				forwarder.removeForwardTable(circuitId)
 				forwarder.EndCircuit(circuitId)
 				return
 			}
@@ -309,10 +446,11 @@ func (forwarder *Forwarder) getXgressForCircuit(circuitId string) XgressDestinat
 func (forwarder *Forwarder) InspectCircuit(circuitId string, getRelatedGoroutines bool) *inspect.CircuitInspectDetail {
 	if ft, found := forwarder.circuits.circuits.Get(circuitId); found {
 		result := &inspect.CircuitInspectDetail{
-			CircuitId:     circuitId,
-			Forwards:      map[string]string{},
-			XgressDetails: map[string]*inspect.XgressDetail{},
-			LinkDetails:   map[string]*inspect.LinkInspectDetail{},
+			CircuitId:             circuitId,
+			TimeSinceLastActivity: ft.timeSinceLastActivity().String(),
+			Forwards:              map[string]string{},
+			XgressDetails:         map[string]*inspect.XgressDetail{},
+			LinkDetails:           map[string]*inspect.LinkInspectDetail{},
 		}
 		result.SetIncludeGoroutines(getRelatedGoroutines)
 
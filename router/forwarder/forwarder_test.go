@@ -0,0 +1,65 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/metrics"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServiceRateLimiterReleasedWithLastCircuit(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	options := DefaultOptions()
+	options.IdleTxInterval = 0
+	registry := metrics.NewUsageRegistry("test", map[string]string{}, closeNotify)
+	forwarder := NewForwarder(registry, nil, NewScanner(nil, options, closeNotify), options, closeNotify)
+
+	hasMeter := func() bool {
+		found := false
+		registry.EachMetric(func(name string, _ metrics.Metric) {
+			found = found || name == "xgress.service.s1.throttled_bytes"
+		})
+		return found
+	}
+
+	for _, circuitId := range []string{"c1", "c2"} {
+		req.NoError(forwarder.Route("ctrl1", &ctrl_pb.Route{
+			CircuitId:             circuitId,
+			ServiceId:             "s1",
+			ServiceBandwidthLimit: 1024,
+		}))
+	}
+
+	// routing the same circuit again doesn't add another reference
+	req.NoError(forwarder.Route("ctrl1", &ctrl_pb.Route{CircuitId: "c1", ServiceId: "s1", ServiceBandwidthLimit: 1024}))
+	req.Len(forwarder.serviceLimiters["s1"].circuits, 2)
+	req.True(hasMeter())
+
+	forwarder.Unroute("c1", true)
+	req.Len(forwarder.serviceLimiters["s1"].circuits, 1)
+	req.True(hasMeter())
+
+	forwarder.Unroute("c2", true)
+	req.Empty(forwarder.serviceLimiters)
+	req.False(hasMeter())
+}
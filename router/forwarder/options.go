@@ -42,6 +42,14 @@ const (
 	DefaultLinkDialWorkerCount         = 32
 	MinLinkDialWorkerCount             = 1
 	MaxLinkDialWorkerCount             = 10000
+	DefaultInteractivePriorityWeight   = 8
+	DefaultStandardPriorityWeight      = 4
+	DefaultBulkPriorityWeight          = 1
+	MinPriorityWeight                  = 1
+	MaxPriorityWeight                  = 100
+	DefaultLinkSchedulerQueueLength    = 64
+	MinLinkSchedulerQueueLength        = 1
+	MaxLinkSchedulerQueueLength        = 10000
 )
 
 type Options struct {
@@ -54,6 +62,8 @@ type Options struct {
 	IdleCircuitTimeout       time.Duration
 	XgressDial               WorkerPoolOptions
 	LinkDial                 WorkerPoolOptions
+	PriorityWeights          PriorityWeights
+	LinkSchedulerQueueLength uint16
 }
 
 type WorkerPoolOptions struct {
@@ -61,6 +71,13 @@ type WorkerPoolOptions struct {
 	WorkerCount uint16
 }
 
+// PriorityWeights configures the share of link bandwidth each circuit priority class receives when links are busy
+type PriorityWeights struct {
+	Interactive uint16
+	Standard    uint16
+	Bulk        uint16
+}
+
 func DefaultOptions() *Options {
 	return &Options{
 		LatencyProbeInterval:     DefaultLatencyProbeInterval,
@@ -78,6 +95,12 @@ func DefaultOptions() *Options {
 			QueueLength: DefaultLinkDialQueueLength,
 			WorkerCount: DefaultLinkDialWorkerCount,
 		},
+		PriorityWeights: PriorityWeights{
+			Interactive: DefaultInteractivePriorityWeight,
+			Standard:    DefaultStandardPriorityWeight,
+			Bulk:        DefaultBulkPriorityWeight,
+		},
+		LinkSchedulerQueueLength: DefaultLinkSchedulerQueueLength,
 	}
 }
 
@@ -184,5 +207,32 @@ func LoadOptions(src map[interface{}]interface{}) (*Options, error) {
 		}
 	}
 
+	priorityWeights := []struct {
+		key   string
+		field *uint16
+	}{
+		{"interactivePriorityWeight", &options.PriorityWeights.Interactive},
+		{"standardPriorityWeight", &options.PriorityWeights.Standard},
+		{"bulkPriorityWeight", &options.PriorityWeights.Bulk},
+	}
+
+	for _, priorityWeight := range priorityWeights {
+		if value, found := src[priorityWeight.key]; found {
+			if weight, ok := value.(int); ok && weight >= MinPriorityWeight && weight <= MaxPriorityWeight {
+				*priorityWeight.field = uint16(weight)
+			} else {
+				return nil, errors.New(fmt.Sprintf("invalid value for '%v', expected integer between %v and %v", priorityWeight.key, MinPriorityWeight, MaxPriorityWeight))
+			}
+		}
+	}
+
+	if value, found := src["linkSchedulerQueueLength"]; found {
+		if length, ok := value.(int); ok && length >= MinLinkSchedulerQueueLength && length <= MaxLinkSchedulerQueueLength {
+			options.LinkSchedulerQueueLength = uint16(length)
+		} else {
+			return nil, errors.New(fmt.Sprintf("invalid value for 'linkSchedulerQueueLength', expected integer between %v and %v", MinLinkSchedulerQueueLength, MaxLinkSchedulerQueueLength))
+		}
+	}
+
 	return options, nil
 }
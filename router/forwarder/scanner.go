@@ -17,24 +17,29 @@
 package forwarder
 
 import (
-	"github.com/openziti/channel"
 	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
 	"sync/atomic"
 	"time"
 )
 
+// reconciliationTimeout bounds how long we wait for a controller to reconcile a circuit digest
+const reconciliationTimeout = 30 * time.Second
+
 type Scanner struct {
-	ctrl        channel.Channel
-	circuits    *circuitTable
+	ctrls       env.NetworkControllers
+	forwarder   *Forwarder
 	interval    time.Duration
 	timeout     time.Duration
 	closeNotify <-chan struct{}
 }
 
-func NewScanner(options *Options, closeNotify <-chan struct{}) *Scanner {
+func NewScanner(ctrls env.NetworkControllers, options *Options, closeNotify <-chan struct{}) *Scanner {
 	s := &Scanner{
+		ctrls:       ctrls,
 		interval:    options.IdleTxInterval,
 		timeout:     options.IdleCircuitTimeout,
 		closeNotify: closeNotify,
@@ -47,12 +52,8 @@ func NewScanner(options *Options, closeNotify <-chan struct{}) *Scanner {
 	return s
 }
 
-func (self *Scanner) SetCtrl(ch channel.Channel) {
-	self.ctrl = ch
-}
-
-func (self *Scanner) setCircuitTable(circuits *circuitTable) {
-	self.circuits = circuits
+func (self *Scanner) setForwarder(forwarder *Forwarder) {
+	self.forwarder = forwarder
 }
 
 func (self *Scanner) run() {
@@ -71,15 +72,17 @@ func (self *Scanner) run() {
 }
 
 func (self *Scanner) scan() {
-	circuits := self.circuits.circuits.Items()
+	circuits := self.forwarder.circuits.circuits.Items()
 	logrus.Debugf("scanning [%d] circuits", len(circuits))
 
-	var idleCircuitIds []string
+	// idle circuits are grouped by owning controller, since only that controller can reconcile them
+	idleCircuits := map[string][]*ctrl_pb.CircuitDigest{}
 	now := time.Now().UnixMilli()
 	for circuitId, ft := range circuits {
 		idleTime := time.Duration(now-atomic.LoadInt64(&ft.last)) * time.Millisecond
 		if idleTime > self.timeout {
-			idleCircuitIds = append(idleCircuitIds, circuitId)
+			ctrlId := ft.ctrlId.Load()
+			idleCircuits[ctrlId] = append(idleCircuits[ctrlId], newCircuitDigest(circuitId, ft))
 			logrus.WithField("circuitId", circuitId).
 				WithField("idleTime", idleTime).
 				WithField("idleThreshold", self.timeout).
@@ -87,18 +90,106 @@ func (self *Scanner) scan() {
 		}
 	}
 
-	if len(idleCircuitIds) > 0 {
-		logrus.Debugf("found [%d] idle circuits, confirming with controller", len(idleCircuitIds))
+	for ctrlId, digests := range idleCircuits {
+		logrus.Debugf("found [%d] idle circuits, reconciling with controller [%s]", len(digests), ctrlId)
+		self.reconcile(ctrlId, digests, false)
+	}
+}
 
-		if self.ctrl != nil {
-			confirm := &ctrl_pb.CircuitConfirmation{CircuitIds: idleCircuitIds}
-			if err := protobufs.MarshalTyped(confirm).Send(self.ctrl); err == nil {
-				logrus.WithField("circuitCount", len(idleCircuitIds)).Warnf("sent confirmation for circuits")
-			} else {
-				logrus.WithError(err).Error("error sending confirmation request")
-			}
-		} else {
-			logrus.Errorf("no ctrl channel, cannot request circuit confirmations")
+// ReconcileCtrl sends a digest of every circuit owned by the given controller, so that state which diverged while
+// the control channel was down can be cleaned up. The controller replies with the circuits to unroute or adopt.
+func (self *Scanner) ReconcileCtrl(ctrlId string) {
+	var digests []*ctrl_pb.CircuitDigest
+	for circuitId, ft := range self.forwarder.circuits.circuits.Items() {
+		if ft.ctrlId.Load() == ctrlId {
+			digests = append(digests, newCircuitDigest(circuitId, ft))
 		}
 	}
+	logrus.Infof("reconciling [%d] circuits with controller [%s]", len(digests), ctrlId)
+	self.reconcile(ctrlId, digests, true)
+}
+
+func (self *Scanner) reconcile(ctrlId string, digests []*ctrl_pb.CircuitDigest, full bool) {
+	log := logrus.WithField("ctrlId", ctrlId).WithField("circuitCount", len(digests)).WithField("full", full)
+
+	// circuits aren't replicated between controllers, so only the owning controller can tell whether a circuit is
+	// still valid. If it isn't available, the circuits are left alone until it is. Circuits without an owner, such
+	// as those created by debug routes, go to the leader, which may adopt them
+	var ctrl env.NetworkController
+	var found bool
+	if ctrlId == "" {
+		ctrl, found = self.ctrls.LeaderCtrl()
+	} else {
+		ctrl, found = self.ctrls.Get(ctrlId)
+	}
+	if !found || !ctrl.IsResponsive() {
+		log.Warn("owning controller unavailable, cannot reconcile circuits")
+		return
+	}
+
+	if !ctrl.SupportsCircuitReconciliation() {
+		// older controllers only understand confirmations of idle circuits
+		if !full {
+			self.confirm(ctrl, digests, log)
+		}
+		return
+	}
+
+	request := &ctrl_pb.CircuitReconciliation{Circuits: digests, Full: full}
+	reply, err := protobufs.MarshalTyped(request).WithTimeout(reconciliationTimeout).SendForReply(ctrl.Channel())
+	if err != nil {
+		log.WithError(err).Error("error sending circuit reconciliation request")
+		return
+	}
+
+	if reply.ContentType != int32(ctrl_pb.ContentType_CircuitReconciliationResultType) {
+		log.Errorf("unexpected circuit reconciliation response type %v", reply.ContentType)
+		return
+	}
+
+	result := &ctrl_pb.CircuitReconciliationResult{}
+	if err = proto.Unmarshal(reply.Body, result); err != nil {
+		log.WithError(err).Error("error unmarshalling circuit reconciliation result")
+		return
+	}
+
+	self.applyReconciliation(ctrl, result, log)
+}
+
+func (self *Scanner) applyReconciliation(ctrl env.NetworkController, result *ctrl_pb.CircuitReconciliationResult, log *logrus.Entry) {
+	for _, circuitId := range result.Unroute {
+		log.WithField("circuitId", circuitId).Info("unrouting circuit unknown to controller")
+		self.forwarder.Unroute(circuitId, true)
+	}
+
+	// the controller which adopts a circuit becomes its owner, so later reconciliations and notifications go there
+	for _, circuitId := range result.Adopt {
+		if ft, found := self.forwarder.circuits.circuits.Get(circuitId); found && ft.ctrlId.Load() != ctrl.Address() {
+			log.WithField("circuitId", circuitId).WithField("newCtrlId", ctrl.Address()).Info("circuit adopted by controller")
+			ft.ctrlId.Store(ctrl.Address())
+		}
+	}
+
+	log.WithField("unrouteCount", len(result.Unroute)).WithField("adoptCount", len(result.Adopt)).
+		Info("circuits reconciled")
+}
+
+func (self *Scanner) confirm(ctrl env.NetworkController, digests []*ctrl_pb.CircuitDigest, log *logrus.Entry) {
+	confirm := &ctrl_pb.CircuitConfirmation{}
+	for _, digest := range digests {
+		confirm.CircuitIds = append(confirm.CircuitIds, digest.CircuitId)
+	}
+
+	if err := protobufs.MarshalTyped(confirm).Send(ctrl.Channel()); err == nil {
+		log.Warn("sent confirmation for circuits")
+	} else {
+		log.WithError(err).Error("error sending confirmation request")
+	}
+}
+
+func newCircuitDigest(circuitId string, ft *forwardTable) *ctrl_pb.CircuitDigest {
+	return &ctrl_pb.CircuitDigest{
+		CircuitId: circuitId,
+		Addresses: ft.destinationAddresses(),
+	}
 }
@@ -0,0 +1,68 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
+	"github.com/openziti/metrics"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testCtrl struct {
+	env.NetworkController
+	address string
+}
+
+func (self *testCtrl) Address() string {
+	return self.address
+}
+
+func TestReconciliationAdoptsCircuits(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	defer close(closeNotify)
+
+	options := DefaultOptions()
+	options.IdleTxInterval = 0
+	registry := metrics.NewUsageRegistry("test", map[string]string{}, closeNotify)
+	scanner := NewScanner(nil, options, closeNotify)
+	forwarder := NewForwarder(registry, nil, scanner, options, closeNotify)
+
+	// a debug route has no owner
+	req.NoError(forwarder.Route("", &ctrl_pb.Route{CircuitId: "c1"}))
+	req.NoError(forwarder.Route("ctrl1", &ctrl_pb.Route{CircuitId: "c2"}))
+	req.NoError(forwarder.Route("ctrl1", &ctrl_pb.Route{CircuitId: "c3"}))
+
+	result := &ctrl_pb.CircuitReconciliationResult{
+		Adopt:   []string{"c1", "c2"},
+		Unroute: []string{"c3"},
+	}
+	scanner.applyReconciliation(&testCtrl{address: "ctrl2"}, result, logrus.NewEntry(logrus.StandardLogger()))
+
+	for _, circuitId := range []string{"c1", "c2"} {
+		ctrlId, found := forwarder.GetCircuitCtrlId(circuitId)
+		req.True(found)
+		req.Equal("ctrl2", ctrlId)
+	}
+
+	_, found := forwarder.GetCircuitCtrlId("c3")
+	req.False(found)
+}
@@ -0,0 +1,162 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/foundation/v2/concurrenz"
+	"github.com/pkg/errors"
+)
+
+// schedulerQuantum is the number of payload bytes a priority class may send per unit of weight in each round
+const schedulerQuantum = 16 * 1024
+
+var priorityClasses = []ctrl_pb.CircuitPriority{
+	ctrl_pb.CircuitPriority_Interactive,
+	ctrl_pb.CircuitPriority_Standard,
+	ctrl_pb.CircuitPriority_Bulk,
+}
+
+// linkScheduler sends payloads to a link using deficit round robin weighted fair queueing across the circuit
+// priority classes. Each class has its own bounded queue, so a bulk transfer filling the link only delays its own
+// class, while interactive circuits on the same link keep getting their share of the link.
+//
+// Senders wait for their payload to be written to the link and get the result, so forwarding errors are reported
+// to them the same way as when writing to the link directly.
+type linkScheduler struct {
+	link        Destination
+	queues      map[ctrl_pb.CircuitPriority]chan *scheduledPayload
+	weights     map[ctrl_pb.CircuitPriority]int
+	deficits    map[ctrl_pb.CircuitPriority]int
+	notify      chan struct{}
+	closeNotify chan struct{}
+	closed      concurrenz.AtomicBoolean
+}
+
+func newLinkScheduler(link Destination, options *Options) *linkScheduler {
+	result := &linkScheduler{
+		link:   link,
+		queues: map[ctrl_pb.CircuitPriority]chan *scheduledPayload{},
+		weights: map[ctrl_pb.CircuitPriority]int{
+			ctrl_pb.CircuitPriority_Interactive: int(options.PriorityWeights.Interactive),
+			ctrl_pb.CircuitPriority_Standard:    int(options.PriorityWeights.Standard),
+			ctrl_pb.CircuitPriority_Bulk:        int(options.PriorityWeights.Bulk),
+		},
+		deficits:    map[ctrl_pb.CircuitPriority]int{},
+		notify:      make(chan struct{}, 1),
+		closeNotify: make(chan struct{}),
+	}
+	for _, priority := range priorityClasses {
+		result.queues[priority] = make(chan *scheduledPayload, options.LinkSchedulerQueueLength)
+	}
+	return result
+}
+
+// scheduledPayload is a queued payload along with the channel its sender is waiting on for the send result
+type scheduledPayload struct {
+	payload *xgress.Payload
+	result  chan error
+}
+
+// send queues the payload and waits until it has been written to the link, returning the result of the write.
+// Senders of a class are held up while their class is waiting for its turn, which pushes back on that class only
+func (self *linkScheduler) send(payload *xgress.Payload, priority ctrl_pb.CircuitPriority) error {
+	scheduled := &scheduledPayload{
+		payload: payload,
+		result:  make(chan error, 1),
+	}
+
+	if err := self.enqueue(scheduled, priority); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-scheduled.result:
+		return err
+	case <-self.closeNotify:
+		return self.closedError(payload)
+	}
+}
+
+func (self *linkScheduler) enqueue(scheduled *scheduledPayload, priority ctrl_pb.CircuitPriority) error {
+	queue, found := self.queues[priority]
+	if !found {
+		queue = self.queues[ctrl_pb.CircuitPriority_Standard]
+	}
+
+	select {
+	case queue <- scheduled:
+	case <-self.closeNotify:
+		return self.closedError(scheduled.payload)
+	}
+
+	select {
+	case self.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (self *linkScheduler) closedError(payload *xgress.Payload) error {
+	return errors.Errorf("cannot forward payload, link scheduler for circuit=%v is closed", payload.GetCircuitId())
+}
+
+func (self *linkScheduler) run() {
+	for {
+		if !self.sendRound() {
+			select {
+			case <-self.notify:
+			case <-self.closeNotify:
+				return
+			}
+		}
+	}
+}
+
+// sendRound gives each priority class with queued payloads a quantum proportional to its weight. Returns false if
+// there was nothing to send
+func (self *linkScheduler) sendRound() bool {
+	sent := false
+	for _, priority := range priorityClasses {
+		queue := self.queues[priority]
+		if len(queue) == 0 {
+			// idle classes don't get to bank credit
+			self.deficits[priority] = 0
+			continue
+		}
+
+		self.deficits[priority] += schedulerQuantum * self.weights[priority]
+		for self.deficits[priority] > 0 {
+			select {
+			case scheduled := <-queue:
+				self.deficits[priority] -= len(scheduled.payload.Data)
+				scheduled.result <- self.link.SendPayload(scheduled.payload)
+				sent = true
+			default:
+				self.deficits[priority] = 0
+			}
+		}
+	}
+	return sent
+}
+
+func (self *linkScheduler) close() {
+	if self.closed.CompareAndSwap(false, true) {
+		close(self.closeNotify)
+	}
+}
@@ -0,0 +1,162 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package forwarder
+
+import (
+	"github.com/openziti/fabric/inspect"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type recordingDestination struct {
+	payloads chan *xgress.Payload
+	err      error
+}
+
+func (self *recordingDestination) SendPayload(payload *xgress.Payload) error {
+	if self.err != nil {
+		return self.err
+	}
+	self.payloads <- payload
+	return nil
+}
+
+func (self *recordingDestination) SendAcknowledgement(*xgress.Acknowledgement) error {
+	return nil
+}
+
+func (self *recordingDestination) SendControl(*xgress.Control) error {
+	return nil
+}
+
+func (self *recordingDestination) InspectCircuit(*inspect.CircuitInspectDetail) {}
+
+func TestLinkSchedulerWeightedRound(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	options.LinkSchedulerQueueLength = 100
+	dst := &recordingDestination{payloads: make(chan *xgress.Payload, 1000)}
+	scheduler := newLinkScheduler(dst, options)
+
+	circuits := map[ctrl_pb.CircuitPriority]string{
+		ctrl_pb.CircuitPriority_Interactive: "interactive",
+		ctrl_pb.CircuitPriority_Standard:    "standard",
+		ctrl_pb.CircuitPriority_Bulk:        "bulk",
+	}
+
+	for priority, circuitId := range circuits {
+		for i := 0; i < 20; i++ {
+			payload := &xgress.Payload{Header: xgress.Header{CircuitId: circuitId}, Sequence: int32(i), Data: make([]byte, schedulerQuantum)}
+			req.NoError(scheduler.enqueue(newScheduledPayload(payload), priority))
+		}
+	}
+
+	req.True(scheduler.sendRound())
+	close(dst.payloads)
+
+	counts := map[string]int{}
+	lastSequence := map[string]int32{}
+	for payload := range dst.payloads {
+		if counts[payload.CircuitId] > 0 {
+			req.Equal(lastSequence[payload.CircuitId]+1, payload.Sequence, "payloads within a class must stay in order")
+		}
+		lastSequence[payload.CircuitId] = payload.Sequence
+		counts[payload.CircuitId]++
+	}
+
+	req.Equal(int(options.PriorityWeights.Interactive), counts["interactive"])
+	req.Equal(int(options.PriorityWeights.Standard), counts["standard"])
+	req.Equal(int(options.PriorityWeights.Bulk), counts["bulk"])
+}
+
+func TestLinkSchedulerInteractiveNotStarved(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	options.LinkSchedulerQueueLength = 1000
+	dst := &recordingDestination{payloads: make(chan *xgress.Payload, 2000)}
+	scheduler := newLinkScheduler(dst, options)
+
+	for i := 0; i < 1000; i++ {
+		req.NoError(scheduler.enqueue(newScheduledPayload(&xgress.Payload{Header: xgress.Header{CircuitId: "bulk"}, Data: make([]byte, 64*1024)}), ctrl_pb.CircuitPriority_Bulk))
+	}
+
+	go func() {
+		_ = scheduler.send(&xgress.Payload{Header: xgress.Header{CircuitId: "ssh"}, Data: make([]byte, 64)}, ctrl_pb.CircuitPriority_Interactive)
+	}()
+	req.Eventually(func() bool {
+		return len(scheduler.queues[ctrl_pb.CircuitPriority_Interactive]) == 1
+	}, time.Second, time.Millisecond)
+
+	go scheduler.run()
+	defer scheduler.close()
+
+	for i := 0; i < 2; i++ {
+		select {
+		case payload := <-dst.payloads:
+			if payload.CircuitId == "ssh" {
+				return
+			}
+		case <-time.After(time.Second):
+			req.Fail("timed out waiting for payloads")
+		}
+	}
+	req.Fail("interactive payload was queued behind bulk payloads")
+}
+
+func TestLinkSchedulerReturnsSendErrors(t *testing.T) {
+	req := require.New(t)
+
+	dst := &recordingDestination{err: errors.New("link closed")}
+	scheduler := newLinkScheduler(dst, DefaultOptions())
+	go scheduler.run()
+	defer scheduler.close()
+
+	err := scheduler.send(&xgress.Payload{Header: xgress.Header{CircuitId: "circuit"}}, ctrl_pb.CircuitPriority_Standard)
+	req.EqualError(err, "link closed")
+
+	scheduler.close()
+	err = scheduler.send(&xgress.Payload{Header: xgress.Header{CircuitId: "circuit"}}, ctrl_pb.CircuitPriority_Standard)
+	req.Error(err)
+}
+
+func newScheduledPayload(payload *xgress.Payload) *scheduledPayload {
+	return &scheduledPayload{payload: payload, result: make(chan error, 1)}
+}
+
+func TestLoadPriorityWeights(t *testing.T) {
+	req := require.New(t)
+
+	options, err := LoadOptions(map[interface{}]interface{}{
+		"interactivePriorityWeight": 20,
+		"bulkPriorityWeight":        2,
+		"linkSchedulerQueueLength":  128,
+	})
+	req.NoError(err)
+	req.Equal(uint16(20), options.PriorityWeights.Interactive)
+	req.Equal(uint16(DefaultStandardPriorityWeight), options.PriorityWeights.Standard)
+	req.Equal(uint16(2), options.PriorityWeights.Bulk)
+	req.Equal(uint16(128), options.LinkSchedulerQueueLength)
+
+	_, err = LoadOptions(map[interface{}]interface{}{"standardPriorityWeight": 0})
+	req.Error(err)
+}
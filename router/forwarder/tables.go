@@ -18,7 +18,9 @@ package forwarder
 
 import (
 	"fmt"
+	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/foundation/v2/concurrenz"
 	"github.com/orcaman/concurrent-map/v2"
 	"reflect"
 	"sync/atomic"
@@ -67,14 +69,51 @@ func (st *circuitTable) debug() string {
 // forwardTable implements a directory of destinations, keyed by source address.
 //
 type forwardTable struct {
-	last         int64
-	destinations cmap.ConcurrentMap[string]
+	last            int64
+	priority        int32
+	ctrlId          concurrenz.AtomicValue[string]
+	bandwidthLimits concurrenz.AtomicValue[*bandwidthLimits]
+	destinations    cmap.ConcurrentMap[string]
 }
 
-func newForwardTable() *forwardTable {
-	return &forwardTable{
+// bandwidthLimits holds the traffic shaping settings for a circuit, in bytes per second
+type bandwidthLimits struct {
+	serviceId    string
+	serviceLimit uint64
+	circuitLimit uint64
+}
+
+func newForwardTable(ctrlId string) *forwardTable {
+	result := &forwardTable{
 		destinations: cmap.New[string](),
 	}
+	result.ctrlId.Store(ctrlId)
+	return result
+}
+
+// destinationAddresses returns the distinct addresses the circuit forwards to
+func (ft *forwardTable) destinationAddresses() []string {
+	seen := map[string]struct{}{}
+	var result []string
+	for _, dst := range ft.destinations.Items() {
+		if _, found := seen[dst]; !found {
+			seen[dst] = struct{}{}
+			result = append(result, dst)
+		}
+	}
+	return result
+}
+
+func (ft *forwardTable) setPriority(priority ctrl_pb.CircuitPriority) {
+	atomic.StoreInt32(&ft.priority, int32(priority))
+}
+
+func (ft *forwardTable) getPriority() ctrl_pb.CircuitPriority {
+	return ctrl_pb.CircuitPriority(atomic.LoadInt32(&ft.priority))
+}
+
+func (ft *forwardTable) timeSinceLastActivity() time.Duration {
+	return time.Duration(time.Now().UnixMilli()-atomic.LoadInt64(&ft.last)) * time.Millisecond
 }
 
 func (ft *forwardTable) setForwardAddress(src, dst xgress.Address) {
@@ -35,17 +35,22 @@ type bindHandler struct {
 	env                env.RouterEnv
 	forwarder          *forwarder.Forwarder
 	ctrlAddressChanger CtrlAddressChanger
+	ctrlAddress        string
 }
 
-func NewBindHandler(routerEnv env.RouterEnv, forwarder *forwarder.Forwarder, ctrlAddressChanger CtrlAddressChanger) channel.BindHandler {
+// NewBindHandler creates the bind handler for the control channel to the controller at the given address
+func NewBindHandler(routerEnv env.RouterEnv, forwarder *forwarder.Forwarder, ctrlAddressChanger CtrlAddressChanger, ctrlAddress string) channel.BindHandler {
 	return &bindHandler{
 		env:                routerEnv,
 		forwarder:          forwarder,
 		ctrlAddressChanger: ctrlAddressChanger,
+		ctrlAddress:        ctrlAddress,
 	}
 }
 
 func (self *bindHandler) BindChannel(binding channel.Binding) error {
+	ctrl := self.env.GetNetworkControllers().Add(self.ctrlAddress, binding.GetChannel())
+
 	linkDialerPoolConfig := goroutines.PoolConfig{
 		QueueSize:   uint32(self.forwarder.Options.LinkDial.QueueLength),
 		MinWorkers:  0,
@@ -83,26 +88,25 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 	}
 
 	binding.AddTypedReceiveHandler(newDialHandler(self.env, linkDialerPool))
-	binding.AddTypedReceiveHandler(newRouteHandler(self.env, self.forwarder, xgDialerPool))
+	binding.AddTypedReceiveHandler(newRouteHandler(ctrl, self.env, self.forwarder, xgDialerPool))
 	binding.AddTypedReceiveHandler(newValidateTerminatorsHandler(self.env))
 	binding.AddTypedReceiveHandler(newUnrouteHandler(self.forwarder))
 	binding.AddTypedReceiveHandler(newTraceHandler(self.env.GetRouterId(), self.forwarder.TraceController()))
 	binding.AddTypedReceiveHandler(newInspectHandler(self.env.GetRouterId(), self.env.GetXlinkRegistry(), self.forwarder))
 	binding.AddTypedReceiveHandler(newSettingsHandler(self.ctrlAddressChanger))
 	binding.AddTypedReceiveHandler(newFaultHandler(self.env.GetXlinkRegistry()))
+	binding.AddTypedReceiveHandler(newCtrlLeaderUpdateHandler(ctrl, self.env.GetNetworkControllers()))
 
 	binding.AddPeekHandler(trace.NewChannelPeekHandler(self.env.GetRouterId().Token, binding.GetChannel(), self.forwarder.TraceController(), trace.NewChannelSink(binding.GetChannel())))
 	latency.AddLatencyProbeResponder(binding)
 
-	cb := &heartbeatCallback{}
-
-	channel.ConfigureHeartbeat(binding, 10*time.Second, time.Second, cb)
+	channel.ConfigureHeartbeat(binding, 10*time.Second, time.Second, ctrl.HeartbeatCallback())
 
 	if self.env.GetTraceHandler() != nil {
 		binding.AddPeekHandler(self.env.GetTraceHandler())
 	}
 
-	for _, x := range self.env.GetXtrls() {
+	for _, x := range self.env.GetXrctrls() {
 		if err := binding.Bind(x); err != nil {
 			return err
 		}
@@ -110,15 +114,3 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 
 	return nil
 }
-
-type heartbeatCallback struct{}
-
-func (self *heartbeatCallback) HeartbeatTx(int64) {}
-
-func (self *heartbeatCallback) HeartbeatRx(int64) {}
-
-func (self *heartbeatCallback) HeartbeatRespTx(int64) {}
-
-func (self *heartbeatCallback) HeartbeatRespRx(ts int64) {}
-
-func (self *heartbeatCallback) CheckHeartBeat() {}
@@ -0,0 +1,48 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package handler_ctrl
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
+	"google.golang.org/protobuf/proto"
+)
+
+type ctrlLeaderUpdateHandler struct {
+	ctrl  env.NetworkController
+	ctrls env.NetworkControllers
+}
+
+func newCtrlLeaderUpdateHandler(ctrl env.NetworkController, ctrls env.NetworkControllers) *ctrlLeaderUpdateHandler {
+	return &ctrlLeaderUpdateHandler{ctrl: ctrl, ctrls: ctrls}
+}
+
+func (self *ctrlLeaderUpdateHandler) ContentType() int32 {
+	return int32(ctrl_pb.ContentType_CtrlLeaderUpdateType)
+}
+
+func (self *ctrlLeaderUpdateHandler) HandleReceive(msg *channel.Message, ch channel.Channel) {
+	update := &ctrl_pb.CtrlLeaderUpdate{}
+	if err := proto.Unmarshal(msg.Body, update); err != nil {
+		pfxlog.ContextLogger(ch.Label()).WithError(err).Error("failed to unmarshal ctrl leader update")
+		return
+	}
+
+	self.ctrls.UpdateLeader(self.ctrl.Address(), update.IsLeader)
+}
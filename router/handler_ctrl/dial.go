@@ -22,7 +22,6 @@ import (
 	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/fabric/router/env"
-	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xlink"
 	"github.com/openziti/foundation/v2/goroutines"
 	"github.com/openziti/identity"
@@ -33,7 +32,6 @@ import (
 
 type dialHandler struct {
 	id       *identity.TokenId
-	ctrl     xgress.CtrlChannel
 	dialers  []xlink.Dialer
 	registry xlink.Registry
 	pool     goroutines.Pool
@@ -42,7 +40,6 @@ type dialHandler struct {
 func newDialHandler(env env.RouterEnv, pool goroutines.Pool) *dialHandler {
 	handler := &dialHandler{
 		id:       env.GetRouterId(),
-		ctrl:     env,
 		dialers:  env.GetXlinkDialer(),
 		pool:     pool,
 		registry: env.GetXlinkRegistry(),
@@ -71,12 +68,14 @@ func (self *dialHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 	}
 }
 
-func (self *dialHandler) handle(dial *ctrl_pb.Dial, _ channel.Channel) {
+// handle dials the requested link. Results are reported to the controller which requested the dial. Other
+// controllers will learn about the link via router link messages
+func (self *dialHandler) handle(dial *ctrl_pb.Dial, ch channel.Channel) {
 	log := self.getLogger(dial)
 
 	if len(self.dialers) != 1 {
 		log.Errorf("invalid Xlink dialers configuration")
-		if err := self.sendLinkFault(dial.LinkId); err != nil {
+		if err := self.sendLinkFault(ch, dial.LinkId); err != nil {
 			log.WithError(err).Error("error sending link fault")
 		}
 		return
@@ -85,7 +84,7 @@ func (self *dialHandler) handle(dial *ctrl_pb.Dial, _ channel.Channel) {
 	link, lockAcquired := self.registry.GetDialLock(dial)
 	if link != nil && link.Id().Token != dial.LinkId {
 		log.WithField("existingLinkId", link.Id().Token).Info("existing link found")
-		if err := self.sendLinkFault(dial.LinkId); err != nil {
+		if err := self.sendLinkFault(ch, dial.LinkId); err != nil {
 			log.WithError(err).Error("error sending link fault")
 		}
 		return
@@ -96,7 +95,7 @@ func (self *dialHandler) handle(dial *ctrl_pb.Dial, _ channel.Channel) {
 		if link, err := self.dialers[0].Dial(dial); err == nil {
 			if existingLink, success := self.registry.DialSucceeded(link); success {
 				log.Info("link registered")
-				if err := self.sendLinkMessage(link); err != nil {
+				if err := self.sendLinkMessage(ch, link); err != nil {
 					log.WithError(err).Error("error sending link message ")
 				}
 			} else if existingLink != nil {
@@ -105,7 +104,7 @@ func (self *dialHandler) handle(dial *ctrl_pb.Dial, _ channel.Channel) {
 		} else {
 			log.WithError(err).Error("link dialing failed")
 			self.registry.DialFailed(dial)
-			if err := self.sendLinkFault(dial.LinkId); err != nil {
+			if err := self.sendLinkFault(ch, dial.LinkId); err != nil {
 				log.WithError(err).Error("error sending fault")
 			}
 		}
@@ -114,20 +113,20 @@ func (self *dialHandler) handle(dial *ctrl_pb.Dial, _ channel.Channel) {
 	}
 }
 
-func (self *dialHandler) sendLinkMessage(link xlink.Xlink) error {
+func (self *dialHandler) sendLinkMessage(ch channel.Channel, link xlink.Xlink) error {
 	linkMsg := &ctrl_pb.LinkConnected{
 		Id:    link.Id().Token,
 		Conns: link.GetAddresses(),
 	}
-	if err := protobufs.MarshalTyped(linkMsg).Send(self.ctrl.Channel()); err != nil {
+	if err := protobufs.MarshalTyped(linkMsg).Send(ch); err != nil {
 		return errors.Wrap(err, "error sending link message")
 	}
 	return nil
 }
 
-func (self *dialHandler) sendLinkFault(linkId string) error {
+func (self *dialHandler) sendLinkFault(ch channel.Channel, linkId string) error {
 	fault := &ctrl_pb.Fault{Subject: ctrl_pb.FaultSubject_LinkFault, Id: linkId}
-	if err := protobufs.MarshalTyped(fault).Send(self.ctrl.Channel()); err != nil {
+	if err := protobufs.MarshalTyped(fault).Send(ch); err != nil {
 		return errors.Wrap(err, "error sending fault")
 	}
 	return nil
@@ -39,17 +39,19 @@ import (
 
 type routeHandler struct {
 	id        *identity.TokenId
-	ctrl      xgress.CtrlChannel
+	ctrl      env.NetworkController
+	env       env.RouterEnv
 	dialerCfg map[string]xgress.OptionsData
 	forwarder *forwarder.Forwarder
 	pool      goroutines.Pool
 }
 
-func newRouteHandler(env env.RouterEnv, forwarder *forwarder.Forwarder, pool goroutines.Pool) *routeHandler {
+func newRouteHandler(ctrl env.NetworkController, routerEnv env.RouterEnv, forwarder *forwarder.Forwarder, pool goroutines.Pool) *routeHandler {
 	handler := &routeHandler{
-		id:        env.GetRouterId(),
-		ctrl:      env,
-		dialerCfg: env.GetDialerCfg(),
+		id:        routerEnv.GetRouterId(),
+		ctrl:      ctrl,
+		env:       routerEnv,
+		dialerCfg: routerEnv.GetDialerCfg(),
 		forwarder: forwarder,
 		pool:      pool,
 	}
@@ -111,7 +113,7 @@ func (rh *routeHandler) HandleReceive(msg *channel.Message, ch channel.Channel)
 }
 
 func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *ctrl_pb.Route, peerData xt.PeerData, log *logrus.Entry) {
-	if err := rh.forwarder.Route(route); err != nil {
+	if err := rh.forwarder.Route(rh.ctrl.Address(), route); err != nil {
 		rh.fail(msg, attempt, route, err, ctrl_msg.ErrorTypeGeneric, log)
 		return
 	}
@@ -126,7 +128,7 @@ func (rh *routeHandler) completeRoute(msg *channel.Message, attempt int, route *
 	response.ReplyTo(msg)
 
 	log.Debug("sending success response")
-	if err := response.WithTimeout(rh.ctrl.DefaultRequestTimeout()).Send(rh.ctrl.Channel()); err == nil {
+	if err := response.WithTimeout(rh.env.DefaultRequestTimeout()).Send(rh.ctrl.Channel()); err == nil {
 		log.Debug("handled route")
 	} else {
 		log.WithError(err).Error("send response failed")
@@ -140,7 +142,7 @@ func (rh *routeHandler) fail(msg *channel.Message, attempt int, route *ctrl_pb.R
 	response.PutByteHeader(ctrl_msg.RouteResultErrorCodeHeader, errorHeader)
 
 	response.ReplyTo(msg)
-	if err = response.WithTimeout(rh.ctrl.DefaultRequestTimeout()).Send(rh.ctrl.Channel()); err != nil {
+	if err = response.WithTimeout(rh.env.DefaultRequestTimeout()).Send(rh.ctrl.Channel()); err != nil {
 		log.WithError(err).Error("send failure response failed")
 	}
 }
@@ -161,7 +163,7 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 
 			bindHandler := handler_xgress.NewBindHandler(
 				handler_xgress.NewReceiveHandler(rh.forwarder),
-				handler_xgress.NewCloseHandler(rh.ctrl, rh.forwarder),
+				handler_xgress.NewCloseHandler(rh.env.GetNetworkControllers(), rh.forwarder),
 				rh.forwarder)
 
 			if rh.forwarder.Options.XgressDialDwellTime > 0 {
@@ -170,6 +172,13 @@ func (rh *routeHandler) connectEgress(msg *channel.Message, attempt int, ch chan
 			}
 
 			if peerData, err := dialer.Dial(route.Egress.Destination, circuitId, xgress.Address(route.Egress.Address), bindHandler, ctx, deadline); err == nil {
+				// accept the compression offered by the ingress xgress, so it knows this end can decompress
+				if compression := xgress.NegotiatedCompression(route.Egress.PeerData); compression != xgress.CompressionTypeNone {
+					if peerData == nil {
+						peerData = xt.PeerData{}
+					}
+					peerData[xgress.PeerDataCompressionHeader] = []byte{byte(compression)}
+				}
 				rh.completeRoute(msg, attempt, route, peerData, log)
 			} else {
 				var errCode byte
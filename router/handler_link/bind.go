@@ -6,6 +6,7 @@ import (
 	"github.com/openziti/channel/latency"
 	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
 	"github.com/openziti/fabric/router/forwarder"
 	metrics2 "github.com/openziti/fabric/router/metrics"
 	"github.com/openziti/fabric/router/xgress"
@@ -20,9 +21,10 @@ import (
 	"time"
 )
 
-func NewBindHandlerFactory(c xgress.CtrlChannel, f *forwarder.Forwarder, fo *forwarder.Options, mr metrics.Registry, registry xlink.Registry) *bindHandlerFactory {
+func NewBindHandlerFactory(c xgress.CtrlChannel, ctrls env.NetworkControllers, f *forwarder.Forwarder, fo *forwarder.Options, mr metrics.Registry, registry xlink.Registry) *bindHandlerFactory {
 	return &bindHandlerFactory{
 		ctrl:             c,
+		ctrls:            ctrls,
 		forwarder:        f,
 		forwarderOptions: fo,
 		metricsRegistry:  mr,
@@ -32,6 +34,7 @@ func NewBindHandlerFactory(c xgress.CtrlChannel, f *forwarder.Forwarder, fo *for
 
 type bindHandlerFactory struct {
 	ctrl             xgress.CtrlChannel
+	ctrls            env.NetworkControllers
 	forwarder        *forwarder.Forwarder
 	forwarderOptions *forwarder.Options
 	metricsRegistry  metrics.Registry
@@ -72,7 +75,7 @@ func (self *bindHandler) BindChannel(binding channel.Binding) error {
 
 	binding.GetChannel().SetLogicalName("l/" + self.xlink.Id().Token)
 	binding.SetUserData(self.xlink.Id().Token)
-	binding.AddCloseHandler(newCloseHandler(self.xlink, self.ctrl, self.forwarder, closeNotify, self.xlinkRegistry))
+	binding.AddCloseHandler(newCloseHandler(self.xlink, self.ctrls, self.forwarder, closeNotify, self.xlinkRegistry))
 	binding.AddErrorHandler(newErrorHandler(self.xlink, self.ctrl))
 	binding.AddTypedReceiveHandler(newPayloadHandler(self.xlink, self.forwarder))
 	binding.AddTypedReceiveHandler(newQueuingAckHandler(self.xlink, self.forwarder, closeNotify))
@@ -21,25 +21,25 @@ import (
 	"github.com/openziti/channel"
 	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
 	"github.com/openziti/fabric/router/forwarder"
-	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xlink"
 	"github.com/openziti/foundation/v2/concurrenz"
 )
 
 type closeHandler struct {
 	link          xlink.Xlink
-	ctrl          xgress.CtrlChannel
+	ctrls         env.NetworkControllers
 	forwarder     *forwarder.Forwarder
 	closeNotify   chan struct{}
 	closed        concurrenz.AtomicBoolean
 	xlinkRegistry xlink.Registry
 }
 
-func newCloseHandler(link xlink.Xlink, ctrl xgress.CtrlChannel, forwarder *forwarder.Forwarder, closeNotify chan struct{}, registry xlink.Registry) *closeHandler {
+func newCloseHandler(link xlink.Xlink, ctrls env.NetworkControllers, forwarder *forwarder.Forwarder, closeNotify chan struct{}, registry xlink.Registry) *closeHandler {
 	return &closeHandler{
 		link:          link,
-		ctrl:          ctrl,
+		ctrls:         ctrls,
 		forwarder:     forwarder,
 		closeNotify:   closeNotify,
 		xlinkRegistry: registry,
@@ -61,13 +61,16 @@ func (self *closeHandler) HandleClose(ch channel.Channel) {
 
 		log.Info("link closed")
 
+		// every controller tracks links, so they all need to hear about the fault
 		self.link.HandleCloseNotification(func() {
 			fault := &ctrl_pb.Fault{Subject: ctrl_pb.FaultSubject_LinkFault, Id: self.link.Id().Token}
-			if err := protobufs.MarshalTyped(fault).Send(self.ctrl.Channel()); err == nil {
-				log.Debug("transmitted link fault")
-			} else {
-				log.WithError(err).Error("unexpected error transmitting link fault")
-			}
+			self.ctrls.ForEach(func(ctrl env.NetworkController) {
+				if err := protobufs.MarshalTyped(fault).Send(ctrl.Channel()); err == nil {
+					log.WithField("ctrlAddress", ctrl.Address()).Debug("transmitted link fault")
+				} else {
+					log.WithField("ctrlAddress", ctrl.Address()).WithError(err).Error("unexpected error transmitting link fault")
+				}
+			})
 		})
 
 		self.forwarder.UnregisterLink(self.link)
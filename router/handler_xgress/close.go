@@ -20,17 +20,18 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
 	"github.com/openziti/fabric/router/forwarder"
 	"github.com/openziti/fabric/router/xgress"
 )
 
 type closeHandler struct {
-	ctrl      xgress.CtrlChannel
+	ctrls     env.NetworkControllers
 	forwarder *forwarder.Forwarder
 }
 
-func NewCloseHandler(ctrl xgress.CtrlChannel, forwarder *forwarder.Forwarder) *closeHandler {
-	return &closeHandler{ctrl: ctrl, forwarder: forwarder}
+func NewCloseHandler(ctrls env.NetworkControllers, forwarder *forwarder.Forwarder) *closeHandler {
+	return &closeHandler{ctrls: ctrls, forwarder: forwarder}
 }
 
 func (txc *closeHandler) HandleXgressClose(x *xgress.Xgress) {
@@ -48,6 +49,9 @@ func (txc *closeHandler) HandleXgressClose(x *xgress.Xgress) {
 		return true
 	})
 
+	// faults go to the controller which owns the circuit. If the circuit was never routed, it's owned by the leader
+	ctrlId, _ := txc.forwarder.GetCircuitCtrlId(x.CircuitId())
+
 	// Notify the forwarder that the circuit is ending
 	log.Debug("removing circuit from forwarder")
 	txc.forwarder.EndCircuit(x.CircuitId())
@@ -61,7 +65,7 @@ func (txc *closeHandler) HandleXgressClose(x *xgress.Xgress) {
 	}
 
 	log.Debug("notifying controller of fault")
-	if err := protobufs.MarshalTyped(fault).Send(txc.ctrl.Channel()); err != nil {
+	if err := protobufs.MarshalTyped(fault).Send(txc.ctrls.GetCtrlChannel(ctrlId)); err != nil {
 		log.WithError(err).Error("error sending fault")
 	}
 }
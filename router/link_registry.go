@@ -6,18 +6,19 @@ import (
 	"github.com/openziti/channel"
 	"github.com/openziti/channel/protobufs"
 	"github.com/openziti/fabric/pb/ctrl_pb"
+	"github.com/openziti/fabric/router/env"
 	"github.com/openziti/fabric/router/xlink"
-	"github.com/openziti/storage/boltz"
 	"github.com/sirupsen/logrus"
 	"sync"
 	"time"
 )
 
-func NewLinkRegistry() xlink.Registry {
+func NewLinkRegistry(ctrls env.NetworkControllers) xlink.Registry {
 	return &linkRegistryImpl{
 		linkMap:     map[string]xlink.Xlink{},
 		linkByIdMap: map[string]xlink.Xlink{},
 		dialLocks:   map[string]int64{},
+		ctrls:       ctrls,
 	}
 }
 
@@ -26,17 +27,22 @@ type linkRegistryImpl struct {
 	linkByIdMap map[string]xlink.Xlink
 	dialLocks   map[string]int64
 	sync.Mutex
-	ctrlCh channel.Channel
+	ctrls env.NetworkControllers
 }
 
-func (self *linkRegistryImpl) ControlChannel() channel.Channel {
+// sendToAllControllers sends the message to every controller, since each controller tracks links independently
+func (self *linkRegistryImpl) sendToAllControllers(msg protobufs.TypedMessage, desc string, log *logrus.Entry) {
 	// we may get link requests before the control channel is fully
-	// established. wait until it's set before we return. Will only
+	// established. wait until it's set before we send. Will only
 	// happen right at startup
-	for self.ctrlCh == nil {
+	for self.ctrls.AnyCtrlChannel() == nil {
 		time.Sleep(30 * time.Millisecond)
 	}
-	return self.ctrlCh
+	self.ctrls.ForEach(func(ctrl env.NetworkController) {
+		if err := protobufs.MarshalTyped(msg).Send(ctrl.Channel()); err != nil {
+			log.WithField("ctrlAddress", ctrl.Address()).WithError(err).Errorf("error sending %s", desc)
+		}
+	})
 }
 
 func (self *linkRegistryImpl) GetLink(routerId, linkProtocol string) (xlink.Xlink, bool) {
@@ -172,9 +178,7 @@ func (self *linkRegistryImpl) applyLink(link xlink.Xlink) (xlink.Xlink, bool) {
 			Subject: ctrl_pb.FaultSubject_LinkFault,
 		}
 
-		if err := protobufs.MarshalTyped(fault).Send(self.ControlChannel()); err != nil {
-			logrus.WithError(err).Error("failed to send router fault when duplicate link detected")
-		}
+		self.sendToAllControllers(fault, "router fault when duplicate link detected", logrus.NewEntry(logrus.StandardLogger()))
 
 		time.AfterFunc(5*time.Minute, func() {
 			_ = existing.Close()
@@ -217,31 +221,10 @@ func (self *linkRegistryImpl) sendRouterLinkMessage(link xlink.Xlink) {
 			},
 		},
 	}
-	if err := protobufs.MarshalTyped(linkMsg).Send(self.ControlChannel()); err != nil {
-		pfxlog.Logger().WithField("linkId", link.Id().Token).
-			WithField("dest", link.DestinationId()).
-			WithField("linkProtocol", link.LinkProtocol()).
-			WithError(err).Error("error sending router link message")
-	}
-}
-
-/* XCtrl implementation so we get reconnect notifications */
-
-func (self *linkRegistryImpl) LoadConfig(map[interface{}]interface{}) error {
-	return nil
-}
-
-func (self *linkRegistryImpl) BindChannel(binding channel.Binding) error {
-	self.ctrlCh = binding.GetChannel()
-	return nil
-}
-
-func (self *linkRegistryImpl) Enabled() bool {
-	return true
-}
-
-func (self *linkRegistryImpl) Run(channel.Channel, boltz.Db, chan struct{}) error {
-	return nil
+	log := pfxlog.Logger().WithField("linkId", link.Id().Token).
+		WithField("dest", link.DestinationId()).
+		WithField("linkProtocol", link.LinkProtocol())
+	self.sendToAllControllers(linkMsg, "router link message", log)
 }
 
 func (self *linkRegistryImpl) Iter() <-chan xlink.Xlink {
@@ -261,7 +244,7 @@ func (self *linkRegistryImpl) Iter() <-chan xlink.Xlink {
 	return result
 }
 
-func (self *linkRegistryImpl) NotifyOfReconnect() {
+func (self *linkRegistryImpl) NotifyOfReconnect(ch channel.Channel) {
 	routerLinks := &ctrl_pb.RouterLinks{}
 	for link := range self.Iter() {
 		routerLinks.Links = append(routerLinks.Links, &ctrl_pb.RouterLinks_RouterLink{
@@ -272,11 +255,8 @@ func (self *linkRegistryImpl) NotifyOfReconnect() {
 		})
 	}
 
-	if err := protobufs.MarshalTyped(routerLinks).Send(self.ControlChannel()); err != nil {
-		logrus.WithError(err).Error("failed to send router links on reconnect")
+	// only the reconnected controller needs to hear about the links, the others have been kept up to date
+	if err := protobufs.MarshalTyped(routerLinks).Send(ch); err != nil {
+		logrus.WithError(err).Error("error sending router links on reconnect")
 	}
 }
-
-func (self *linkRegistryImpl) GetTraceDecoders() []channel.TraceMessageDecoder {
-	return nil
-}
@@ -25,17 +25,18 @@ import (
 	"github.com/AppsFlyer/go-sundheit/checks"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel"
-	"github.com/openziti/fabric/controller/xctrl"
 	"github.com/openziti/fabric/health"
 	fabricMetrics "github.com/openziti/fabric/metrics"
 	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/fabric/profiler"
+	"github.com/openziti/fabric/router/env"
 	"github.com/openziti/fabric/router/forwarder"
 	"github.com/openziti/fabric/router/handler_ctrl"
 	"github.com/openziti/fabric/router/handler_link"
 	"github.com/openziti/fabric/router/handler_xgress"
 	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xgress_proxy"
+	"github.com/openziti/fabric/router/xgress_proxy_connect"
 	"github.com/openziti/fabric/router/xgress_proxy_udp"
 	"github.com/openziti/fabric/router/xgress_transport"
 	"github.com/openziti/fabric/router/xgress_transport_udp"
@@ -58,11 +59,11 @@ import (
 
 type Router struct {
 	config          *Config
-	ctrl            concurrenz.AtomicValue[channel.Channel]
+	ctrls           env.NetworkControllers
 	faulter         *forwarder.Faulter
 	scanner         *forwarder.Scanner
 	forwarder       *forwarder.Forwarder
-	xctrls          []xctrl.Xctrl
+	xrctrls         []env.Xrctrl
 	xlinkFactories  map[string]xlink.Factory
 	xlinkListeners  []xlink.Listener
 	xlinkDialers    []xlink.Dialer
@@ -93,8 +94,8 @@ func (self *Router) GetXlinkDialer() []xlink.Dialer {
 	return self.xlinkDialers
 }
 
-func (self *Router) GetXtrls() []xctrl.Xctrl {
-	return self.xctrls
+func (self *Router) GetXrctrls() []env.Xrctrl {
+	return self.xrctrls
 }
 
 func (self *Router) GetTraceHandler() *channel.TraceHandler {
@@ -113,16 +114,21 @@ func (self *Router) GetMetricsRegistry() metrics.UsageRegistry {
 	return self.metricsRegistry
 }
 
+func (self *Router) GetNetworkControllers() env.NetworkControllers {
+	return self.ctrls
+}
+
+// Channel returns the control channel to the leader controller, if known, otherwise to any available controller
 func (self *Router) Channel() channel.Channel {
 	// if we're just starting up, we may be nil. wait till initialized
 	// The initial control channel connect has a timeout, so if that timeouts the process will exit
 	// Once connected the control channel will never get set back to nil. Reconnects happen under the hood
-	ch := self.ctrl.Load()
+	ch := self.ctrls.LeaderCtrlChannel()
 	for ch == nil {
 		time.Sleep(50 * time.Millisecond)
-		ch = self.ctrl.Load()
+		ch = self.ctrls.LeaderCtrlChannel()
 	}
-	return self.ctrl.Load()
+	return ch
 }
 
 func (self *Router) DefaultRequestTimeout() time.Duration {
@@ -135,8 +141,9 @@ func Create(config *Config, versionProvider versions.VersionProvider) *Router {
 	metricsRegistry := metrics.NewUsageRegistry(config.Id.Token, map[string]string{}, closeNotify)
 	xgress.InitMetrics(metricsRegistry)
 
-	faulter := forwarder.NewFaulter(config.Forwarder.FaultTxInterval, closeNotify)
-	scanner := forwarder.NewScanner(config.Forwarder, closeNotify)
+	ctrls := env.NewNetworkControllers()
+	faulter := forwarder.NewFaulter(ctrls, config.Forwarder.FaultTxInterval, closeNotify)
+	scanner := forwarder.NewScanner(ctrls, config.Forwarder, closeNotify)
 	fwd := forwarder.NewForwarder(metricsRegistry, faulter, scanner, config.Forwarder, closeNotify)
 
 	xgress.InitPayloadIngester(closeNotify)
@@ -145,6 +152,7 @@ func Create(config *Config, versionProvider versions.VersionProvider) *Router {
 
 	return &Router{
 		config:              config,
+		ctrls:               ctrls,
 		faulter:             faulter,
 		scanner:             scanner,
 		forwarder:           fwd,
@@ -154,16 +162,16 @@ func Create(config *Config, versionProvider versions.VersionProvider) *Router {
 		versionProvider:     versionProvider,
 		debugOperations:     map[byte]func(c *bufio.ReadWriter) error{},
 		xwebFactoryRegistry: xweb.NewRegistryMap(),
-		xlinkRegistry:       NewLinkRegistry(),
+		xlinkRegistry:       NewLinkRegistry(ctrls),
 	}
 }
 
-func (self *Router) RegisterXctrl(x xctrl.Xctrl) error {
+func (self *Router) RegisterXrctrl(x env.Xrctrl) error {
 	if err := self.config.Configure(x); err != nil {
 		return err
 	}
 	if x.Enabled() {
-		self.xctrls = append(self.xctrls, x)
+		self.xrctrls = append(self.xrctrls, x)
 	}
 	return nil
 }
@@ -217,10 +225,8 @@ func (self *Router) Start() error {
 func (self *Router) Shutdown() error {
 	var errors []error
 	if self.isShutdown.CompareAndSwap(false, true) {
-		if ch := self.ctrl.Load(); ch != nil {
-			if err := ch.Close(); err != nil {
-				errors = append(errors, err)
-			}
+		if err := self.ctrls.Close(); err != nil {
+			errors = append(errors, err)
 		}
 
 		close(self.shutdownC)
@@ -296,6 +302,7 @@ func (self *Router) registerComponents() error {
 	xlinkAccepter := newXlinkAccepter(self.forwarder)
 	xlinkChAccepter := handler_link.NewBindHandlerFactory(
 		self,
+		self.ctrls,
 		self.forwarder,
 		self.config.Forwarder,
 		self.metricsRegistry,
@@ -304,18 +311,15 @@ func (self *Router) registerComponents() error {
 	self.xlinkFactories["transport"] = xlink_transport.NewFactory(xlinkAccepter, xlinkChAccepter, self.config.Transport, self.xlinkRegistry, self.metricsRegistry)
 
 	xgress.GlobalRegistry().Register("proxy", xgress_proxy.NewFactory(self.config.Id, self, self.config.Transport))
+	xgress.GlobalRegistry().Register("proxy_connect", xgress_proxy_connect.NewFactory(self.config.Id, self, self.config.Transport))
 	xgress.GlobalRegistry().Register("proxy_udp", xgress_proxy_udp.NewFactory(self))
-	xgress.GlobalRegistry().Register("transport", xgress_transport.NewFactory(self.config.Id, self, self.config.Transport))
+	xgress.GlobalRegistry().Register("transport", xgress_transport.NewFactory(self.config.Id, self, self.config.Transport, self.shutdownC))
 	xgress.GlobalRegistry().Register("transport_udp", xgress_transport_udp.NewFactory(self.config.Id, self))
 
 	if err := self.RegisterXweb(xweb.NewDefaultInstance(self.xwebFactoryRegistry, self.config.Id)); err != nil {
 		return err
 	}
 
-	if err := self.RegisterXctrl(self.xlinkRegistry); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -391,7 +395,7 @@ func (self *Router) startXgressListeners() {
 		err = listener.Listen(address,
 			handler_xgress.NewBindHandler(
 				handler_xgress.NewReceiveHandler(self.forwarder),
-				handler_xgress.NewCloseHandler(self, self.forwarder),
+				handler_xgress.NewCloseHandler(self.ctrls, self.forwarder),
 				self.forwarder,
 			),
 		)
@@ -434,40 +438,89 @@ func (self *Router) startControlPlane() error {
 		}
 	}
 
-	reconnectHandler := func() {
-		for _, x := range self.xctrls {
-			go x.NotifyOfReconnect()
-		}
-	}
-
 	if "" != self.config.Ctrl.LocalBinding {
 		logrus.Debugf("Using local interface %s to dial controller", self.config.Ctrl.LocalBinding)
 	}
-	dialer := channel.NewReconnectingDialerWithHandlerAndLocalBinding(self.config.Id, self.config.Ctrl.Endpoint, self.config.Ctrl.LocalBinding, attributes, reconnectHandler)
 
-	bindHandler := handler_ctrl.NewBindHandler(self, self.forwarder, self.config)
+	var failed []*UpdatableAddress
+	for _, endpoint := range self.config.Ctrl.Endpoints {
+		if err := self.connectToController(endpoint, attributes); err != nil {
+			logrus.WithError(err).WithField("endpoint", endpoint.String()).Error("unable to connect to controller")
+			failed = append(failed, endpoint)
+		}
+	}
 
-	ch, err := channel.NewChannel("ctrl", dialer, bindHandler, self.config.Ctrl.Options)
-	if err != nil {
-		return fmt.Errorf("error connecting ctrl (%v)", err)
+	if len(failed) == len(self.config.Ctrl.Endpoints) {
+		return errors.New("unable to connect to any controller")
 	}
 
-	self.ctrl.Store(ch)
-	self.faulter.SetCtrl(ch)
-	self.scanner.SetCtrl(ch)
+	// as long as we have one controller, keep trying the others in the background
+	for _, endpoint := range failed {
+		go self.retryControllerConnect(endpoint, attributes)
+	}
 
-	for _, x := range self.xctrls {
-		if err := x.Run(ch, nil, self.shutdownC); err != nil {
+	for _, x := range self.xrctrls {
+		if err := x.Run(self); err != nil {
 			return err
 		}
 	}
 
-	self.metricsReporter = fabricMetrics.NewChannelReporter(ch)
+	self.metricsReporter = fabricMetrics.NewChannelProviderReporter(self.Channel)
 	self.metricsRegistry.StartReporting(self.metricsReporter, self.config.Metrics.ReportInterval, self.config.Metrics.MessageQueueSize)
 
 	return nil
 }
 
+func (self *Router) connectToController(endpoint *UpdatableAddress, attributes map[int32][]byte) error {
+	// the initially configured address identifies the controller, even if the endpoint is later updated
+	address := endpoint.String()
+
+	reconnectHandler := func() {
+		if ctrl, found := self.ctrls.Get(address); found {
+			ctrl.MarkContact()
+			go self.xlinkRegistry.NotifyOfReconnect(ctrl.Channel())
+			for _, x := range self.xrctrls {
+				go x.NotifyOfReconnect(ctrl.Channel())
+			}
+		}
+		go self.scanner.ReconcileCtrl(address)
+	}
+
+	dialer := channel.NewReconnectingDialerWithHandlerAndLocalBinding(self.config.Id, endpoint, self.config.Ctrl.LocalBinding, attributes, reconnectHandler)
+	bindHandler := handler_ctrl.NewBindHandler(self, self.forwarder, &ctrlEndpointChanger{Config: self.config, endpoint: endpoint}, address)
+
+	if _, err := channel.NewChannel("ctrl", dialer, bindHandler, self.config.Ctrl.Options); err != nil {
+		return fmt.Errorf("error connecting ctrl (%v)", err)
+	}
+	logrus.WithField("endpoint", address).Info("connected to controller")
+
+	// the controller may believe circuits are routed through us which we no longer have, such as after a restart
+	go self.scanner.ReconcileCtrl(address)
+	return nil
+}
+
+func (self *Router) retryControllerConnect(endpoint *UpdatableAddress, attributes map[int32][]byte) {
+	delay := 5 * time.Second
+	for {
+		select {
+		case <-time.After(delay):
+		case <-self.shutdownC:
+			return
+		}
+
+		err := self.connectToController(endpoint, attributes)
+		if err == nil {
+			return
+		}
+
+		if delay < time.Minute {
+			delay *= 2
+		}
+		logrus.WithError(err).WithField("endpoint", endpoint.String()).WithField("nextAttempt", delay).
+			Error("unable to connect to controller, will retry")
+	}
+}
+
 func (self *Router) initializeHealthChecks() (gosundheit.Health, error) {
 	checkConfig := self.config.HealthChecks
 	logrus.Infof("starting health check with ctrl ping initially after %v, then every %v, timing out after %v",
@@ -18,9 +18,24 @@ type ackEntry struct {
 	*Acknowledgement
 }
 
+func (self *ackEntry) key() ackKey {
+	return ackKey{
+		Address:   self.Address,
+		circuitId: self.CircuitId,
+		flags:     self.Flags,
+	}
+}
+
+type ackKey struct {
+	Address
+	circuitId string
+	flags     uint32
+}
+
 type Acker struct {
 	forwarder     PayloadBufferForwarder
 	acks          *deque.Deque
+	queued        map[ackKey]*ackEntry
 	ackIngest     chan *ackEntry
 	ackSend       chan *ackEntry
 	acksQueueSize int64
@@ -31,6 +46,7 @@ func NewAcker(forwarder PayloadBufferForwarder, metrics metrics.Registry, closeN
 	result := &Acker{
 		forwarder:   forwarder,
 		acks:        deque.New(),
+		queued:      map[ackKey]*ackEntry{},
 		ackIngest:   make(chan *ackEntry, 16),
 		ackSend:     make(chan *ackEntry, 1),
 		closeNotify: closeNotify,
@@ -53,26 +69,44 @@ func (acker *Acker) ack(ack *Acknowledgement, address Address) {
 	}
 }
 
+// enqueue adds the ack to the send queue. If an ack for the same circuit is already waiting to be sent, the new
+// ack is merged into it instead. The newer ack's ranges and buffer size supersede the older ones, since they
+// describe the receiver's more recent state
+func (acker *Acker) enqueue(ack *ackEntry) {
+	key := ack.key()
+	if queued, found := acker.queued[key]; found {
+		queued.Sequence = append(queued.Sequence, ack.Sequence...)
+		queued.Ranges = ack.Ranges
+		queued.RecvBufferSize = ack.RecvBufferSize
+		queued.RTT = ack.RTT
+		return
+	}
+
+	acker.queued[key] = ack
+	acker.acks.PushBack(ack)
+}
+
 func (acker *Acker) ackIngester() {
 	var next *ackEntry
 	for {
 		if next == nil {
 			if val, _ := acker.acks.PopFront(); val != nil {
 				next = val.(*ackEntry)
+				delete(acker.queued, next.key())
 			}
 		}
 
 		if next == nil {
 			select {
 			case ack := <-acker.ackIngest:
-				acker.acks.PushBack(ack)
+				acker.enqueue(ack)
 			case <-acker.closeNotify:
 				return
 			}
 		} else {
 			select {
 			case ack := <-acker.ackIngest:
-				acker.acks.PushBack(ack)
+				acker.enqueue(ack)
 			case acker.ackSend <- next:
 				next = nil
 			case <-acker.closeNotify:
@@ -0,0 +1,150 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"sync"
+)
+
+// MaxDecompressedPayloadSize bounds how large a single compressed payload may expand to at egress
+const MaxDecompressedPayloadSize = 16 * 1024 * 1024
+
+// PeerDataCompressionHeader is the circuit peer data key used to negotiate compression. The ingress xgress offers
+// a compression type in its circuit request. The egress router echoes the type back in its route result if it
+// supports it, and both xgresses only compress once the type has been agreed on this way
+const PeerDataCompressionHeader = 1112
+
+// CompressionType identifies the algorithm used to compress Payload.Data. It is agreed once per circuit, through
+// circuit peer data, so payloads only carry PayloadFlagCompressed. Transit routers of any version keep the payload
+// flags when forwarding, so compressed payloads survive routes through older routers
+type CompressionType uint8
+
+const (
+	CompressionTypeNone    CompressionType = 0
+	CompressionTypeDeflate CompressionType = 1
+)
+
+func (self CompressionType) String() string {
+	switch self {
+	case CompressionTypeNone:
+		return "none"
+	case CompressionTypeDeflate:
+		return "deflate"
+	default:
+		return fmt.Sprintf("unknown(%v)", uint8(self))
+	}
+}
+
+func (self CompressionType) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + self.String() + `"`), nil
+}
+
+func ParseCompressionType(value string) (CompressionType, error) {
+	switch value {
+	case "", "none":
+		return CompressionTypeNone, nil
+	case "deflate":
+		return CompressionTypeDeflate, nil
+	default:
+		return CompressionTypeNone, errors.Errorf("unsupported compression type '%v', valid values: [none, deflate]", value)
+	}
+}
+
+// NegotiatedCompression returns the compression type carried in the given circuit peer data, or
+// CompressionTypeNone if no compression was negotiated or the type isn't one this router supports
+func NegotiatedCompression(peerData map[uint32][]byte) CompressionType {
+	if value, found := peerData[PeerDataCompressionHeader]; found && len(value) == 1 {
+		if compressionType := CompressionType(value[0]); compressionType == CompressionTypeDeflate {
+			return compressionType
+		}
+	}
+	return CompressionTypeNone
+}
+
+type deflateWriter struct {
+	buf    bytes.Buffer
+	writer *flate.Writer
+}
+
+var deflateWriterPools sync.Map
+
+func getDeflateWriterPool(level int) *sync.Pool {
+	if pool, found := deflateWriterPools.Load(level); found {
+		return pool.(*sync.Pool)
+	}
+	pool, _ := deflateWriterPools.LoadOrStore(level, &sync.Pool{
+		New: func() interface{} {
+			result := &deflateWriter{}
+			// level is validated when options are loaded
+			result.writer, _ = flate.NewWriter(&result.buf, level)
+			return result
+		},
+	})
+	return pool.(*sync.Pool)
+}
+
+// compressPayloadData compresses data using the given algorithm. If compression doesn't shrink the data, ok
+// will be false and the data should be sent uncompressed
+func compressPayloadData(compressionType CompressionType, level int, data []byte) (result []byte, ok bool, err error) {
+	if compressionType != CompressionTypeDeflate {
+		return nil, false, errors.Errorf("unsupported compression type %v", compressionType)
+	}
+
+	pool := getDeflateWriterPool(level)
+	w := pool.Get().(*deflateWriter)
+	defer pool.Put(w)
+
+	w.buf.Reset()
+	w.writer.Reset(&w.buf)
+	if _, err = w.writer.Write(data); err != nil {
+		return nil, false, err
+	}
+	if err = w.writer.Close(); err != nil {
+		return nil, false, err
+	}
+
+	if w.buf.Len() >= len(data) {
+		return nil, false, nil
+	}
+
+	result = make([]byte, w.buf.Len())
+	copy(result, w.buf.Bytes())
+	return result, true, nil
+}
+
+func decompressPayloadData(compressionType CompressionType, data []byte) ([]byte, error) {
+	if compressionType != CompressionTypeDeflate {
+		return nil, errors.Errorf("unsupported compression type %v", compressionType)
+	}
+
+	reader := flate.NewReader(bytes.NewReader(data))
+	defer func() { _ = reader.Close() }()
+
+	result, err := io.ReadAll(io.LimitReader(reader, MaxDecompressedPayloadSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decompress payload")
+	}
+	if len(result) > MaxDecompressedPayloadSize {
+		return nil, errors.Errorf("decompressed payload exceeds max size of %v bytes", MaxDecompressedPayloadSize)
+	}
+	return result, nil
+}
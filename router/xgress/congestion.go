@@ -0,0 +1,176 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/openziti/fabric/inspect"
+	"github.com/pkg/errors"
+	"sort"
+	"sync"
+)
+
+const (
+	CongestionControlAimd  = "aimd"
+	CongestionControlBbr   = "bbr"
+	CongestionControlCubic = "cubic"
+)
+
+// CongestionControl decides how much unacknowledged data a LinkSendBuffer may have outstanding and, optionally,
+// how quickly newly buffered payloads may be released onto the link. Implementations are only called from the
+// LinkSendBuffer run loop, so they don't need to be safe for concurrent use.
+type CongestionControl interface {
+	Name() string
+
+	// OnAck is called once for every payload newly acknowledged by the remote side
+	OnAck(payloadSize uint32)
+
+	// OnRtt is called with every new round trip time sample, in milliseconds
+	OnRtt(rttMs uint16)
+
+	// OnRetransmit is called every time a payload is queued for retransmission
+	OnRetransmit()
+
+	// WindowSize returns the maximum number of unacknowledged bytes allowed in flight
+	WindowSize() uint32
+
+	// PacingRate returns the maximum send rate in bytes per second, or zero if sends should not be paced
+	PacingRate() uint64
+
+	// Inspect adds implementation specific state to the send buffer detail
+	Inspect(detail *inspect.XgressSendBufferDetail)
+}
+
+type CongestionControlFactory func(options *Options) CongestionControl
+
+var congestionControlFactories = map[string]CongestionControlFactory{
+	CongestionControlAimd:  newAimdCongestionControl,
+	CongestionControlBbr:   newBbrCongestionControl,
+	CongestionControlCubic: newCubicCongestionControl,
+}
+
+var congestionControlFactoriesLock sync.RWMutex
+
+// RegisterCongestionControl makes a congestion control implementation selectable by name via the
+// congestionControl xgress option
+func RegisterCongestionControl(name string, factory CongestionControlFactory) {
+	congestionControlFactoriesLock.Lock()
+	defer congestionControlFactoriesLock.Unlock()
+	congestionControlFactories[name] = factory
+}
+
+func getCongestionControlFactory(name string) (CongestionControlFactory, error) {
+	congestionControlFactoriesLock.RLock()
+	defer congestionControlFactoriesLock.RUnlock()
+
+	if factory, found := congestionControlFactories[name]; found {
+		return factory, nil
+	}
+
+	var names []string
+	for k := range congestionControlFactories {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return nil, errors.Errorf("unknown congestion control '%v', valid values: %v", name, names)
+}
+
+func NewCongestionControl(options *Options) (CongestionControl, error) {
+	factory, err := getCongestionControlFactory(options.CongestionControl)
+	if err != nil {
+		return nil, err
+	}
+	return factory(options), nil
+}
+
+// aimdCongestionControl is the original xgress window algorithm. The window grows by a multiple of the bytes
+// acknowledged every TxPortalIncreaseThresh acks, and shrinks by TxPortalRetxScale every TxPortalRetxThresh
+// retransmits.
+type aimdCongestionControl struct {
+	options        *Options
+	windowSize     uint32
+	accumulator    uint32
+	successfulAcks uint32
+	retransmits    uint32
+}
+
+func newAimdCongestionControl(options *Options) CongestionControl {
+	return &aimdCongestionControl{
+		options:    options,
+		windowSize: options.TxPortalStartSize,
+	}
+}
+
+func (self *aimdCongestionControl) Name() string {
+	return CongestionControlAimd
+}
+
+func (self *aimdCongestionControl) OnAck(payloadSize uint32) {
+	self.accumulator += payloadSize
+	self.successfulAcks++
+	if self.successfulAcks >= self.options.TxPortalIncreaseThresh {
+		self.successfulAcks = 0
+		delta := uint32(float64(self.accumulator) * self.options.TxPortalIncreaseScale)
+		self.windowSize += delta
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+	}
+}
+
+func (self *aimdCongestionControl) OnRtt(uint16) {}
+
+func (self *aimdCongestionControl) OnRetransmit() {
+	self.retransmits++
+	if self.retransmits >= self.options.TxPortalRetxThresh {
+		self.accumulator = 0
+		self.retransmits = 0
+		self.scale(self.options.TxPortalRetxScale)
+	}
+}
+
+func (self *aimdCongestionControl) scale(factor float64) {
+	self.windowSize = uint32(float64(self.windowSize) * factor)
+	if factor > 1 {
+		if self.windowSize > self.options.TxPortalMaxSize {
+			self.windowSize = self.options.TxPortalMaxSize
+		}
+	} else if self.windowSize < self.options.TxPortalMinSize {
+		self.windowSize = self.options.TxPortalMinSize
+	}
+}
+
+func (self *aimdCongestionControl) WindowSize() uint32 {
+	return self.windowSize
+}
+
+func (self *aimdCongestionControl) PacingRate() uint64 {
+	return 0
+}
+
+func (self *aimdCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.Accumulator = self.accumulator
+}
+
+func clampWindowSize(options *Options, size float64) uint32 {
+	if size < float64(options.TxPortalMinSize) {
+		return options.TxPortalMinSize
+	}
+	if size > float64(options.TxPortalMaxSize) {
+		return options.TxPortalMaxSize
+	}
+	return uint32(size)
+}
@@ -0,0 +1,181 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/openziti/fabric/inspect"
+	"github.com/openziti/foundation/v2/info"
+)
+
+const (
+	bbrHighGain          = 2.885
+	bbrCwndGain          = 2.0
+	bbrBwWindowRounds    = 10
+	bbrMinRttWindowMs    = 10_000
+	bbrMinRoundMs        = 10
+	bbrFullBwGrowth      = 1.25
+	bbrFullBwStallRounds = 3
+)
+
+var bbrPacingGainCycle = []float64{1.25, 0.75, 1, 1, 1, 1, 1, 1}
+
+type bbrState uint8
+
+const (
+	bbrStateStartup bbrState = iota
+	bbrStateDrain
+	bbrStateProbeBw
+)
+
+func (self bbrState) String() string {
+	switch self {
+	case bbrStateStartup:
+		return "startup"
+	case bbrStateDrain:
+		return "drain"
+	default:
+		return "probe_bw"
+	}
+}
+
+// bbrCongestionControl is a simplified BBR. It estimates the bottleneck bandwidth from the delivery rate seen over
+// each round trip and the path's minimum RTT, then sizes the window to a multiple of the bandwidth-delay product
+// and paces sends at a multiple of the bandwidth estimate. Loss is not treated as a congestion signal.
+type bbrCongestionControl struct {
+	options *Options
+	now     func() int64
+
+	state      bbrState
+	pacingGain float64
+	cwndGain   float64
+	cycleIndex int
+
+	delivered      uint64
+	roundStart     int64
+	roundDelivered uint64
+	bwSamples      [bbrBwWindowRounds]uint64
+	bwSampleIndex  int
+
+	minRttMs    uint16
+	minRttStamp int64
+
+	fullBw       uint64
+	fullBwRounds int
+}
+
+func newBbrCongestionControl(options *Options) CongestionControl {
+	return &bbrCongestionControl{
+		options:    options,
+		now:        info.NowInMilliseconds,
+		state:      bbrStateStartup,
+		pacingGain: bbrHighGain,
+		cwndGain:   bbrHighGain,
+	}
+}
+
+func (self *bbrCongestionControl) Name() string {
+	return CongestionControlBbr
+}
+
+func (self *bbrCongestionControl) OnAck(payloadSize uint32) {
+	now := self.now()
+	if self.roundStart == 0 {
+		self.roundStart = now
+	}
+
+	self.delivered += uint64(payloadSize)
+
+	roundLength := int64(self.minRttMs)
+	if roundLength < bbrMinRoundMs {
+		roundLength = bbrMinRoundMs
+	}
+
+	if elapsed := now - self.roundStart; elapsed >= roundLength {
+		self.bwSamples[self.bwSampleIndex] = (self.delivered - self.roundDelivered) * 1000 / uint64(elapsed)
+		self.bwSampleIndex = (self.bwSampleIndex + 1) % bbrBwWindowRounds
+		self.roundStart = now
+		self.roundDelivered = self.delivered
+		self.onRoundEnd()
+	}
+}
+
+func (self *bbrCongestionControl) onRoundEnd() {
+	switch self.state {
+	case bbrStateStartup:
+		maxBw := self.maxBw()
+		if float64(maxBw) >= float64(self.fullBw)*bbrFullBwGrowth {
+			self.fullBw = maxBw
+			self.fullBwRounds = 0
+			return
+		}
+		self.fullBwRounds++
+		if self.fullBwRounds >= bbrFullBwStallRounds {
+			self.state = bbrStateDrain
+			self.pacingGain = 1 / bbrHighGain
+			self.cwndGain = bbrHighGain
+		}
+	case bbrStateDrain:
+		self.state = bbrStateProbeBw
+		self.cycleIndex = 0
+		self.pacingGain = bbrPacingGainCycle[self.cycleIndex]
+		self.cwndGain = bbrCwndGain
+	case bbrStateProbeBw:
+		self.cycleIndex = (self.cycleIndex + 1) % len(bbrPacingGainCycle)
+		self.pacingGain = bbrPacingGainCycle[self.cycleIndex]
+	}
+}
+
+func (self *bbrCongestionControl) maxBw() uint64 {
+	var result uint64
+	for _, sample := range self.bwSamples {
+		if sample > result {
+			result = sample
+		}
+	}
+	return result
+}
+
+func (self *bbrCongestionControl) OnRtt(rttMs uint16) {
+	if rttMs == 0 {
+		rttMs = 1
+	}
+	now := self.now()
+	if self.minRttMs == 0 || rttMs <= self.minRttMs || now-self.minRttStamp > bbrMinRttWindowMs {
+		self.minRttMs = rttMs
+		self.minRttStamp = now
+	}
+}
+
+func (self *bbrCongestionControl) OnRetransmit() {}
+
+func (self *bbrCongestionControl) WindowSize() uint32 {
+	maxBw := self.maxBw()
+	if maxBw == 0 || self.minRttMs == 0 {
+		return self.options.TxPortalStartSize
+	}
+	bdp := float64(maxBw) * float64(self.minRttMs) / 1000
+	return clampWindowSize(self.options, bdp*self.cwndGain)
+}
+
+func (self *bbrCongestionControl) PacingRate() uint64 {
+	return uint64(float64(self.maxBw()) * self.pacingGain)
+}
+
+func (self *bbrCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	detail.CongestionState = self.state.String()
+	detail.MinRtt = self.minRttMs
+}
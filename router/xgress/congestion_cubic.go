@@ -0,0 +1,155 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/openziti/fabric/inspect"
+	"github.com/openziti/foundation/v2/info"
+	"math"
+)
+
+const (
+	cubicC           = 0.4
+	cubicBeta        = 0.7
+	cubicSegmentSize = 1460
+	cubicMinRttMs    = 10
+)
+
+// cubicCongestionControl follows RFC 8312. After a loss the window grows along a cubic curve centered on the
+// window size at which the loss occurred, so it recovers quickly on paths with a large bandwidth-delay product.
+// Retransmits are treated as loss, with at most one window reduction per round trip.
+type cubicCongestionControl struct {
+	options *Options
+	now     func() int64
+
+	window   float64
+	ssthresh float64
+
+	wMax        float64
+	k           float64
+	originPoint float64
+	wEst        float64
+	epochStart  int64
+
+	srttMs            uint16
+	lastReductionTime int64
+}
+
+func newCubicCongestionControl(options *Options) CongestionControl {
+	return &cubicCongestionControl{
+		options:  options,
+		now:      info.NowInMilliseconds,
+		window:   float64(options.TxPortalStartSize),
+		ssthresh: float64(options.TxPortalMaxSize),
+	}
+}
+
+func (self *cubicCongestionControl) Name() string {
+	return CongestionControlCubic
+}
+
+func (self *cubicCongestionControl) inSlowStart() bool {
+	return self.window < self.ssthresh
+}
+
+func (self *cubicCongestionControl) OnAck(payloadSize uint32) {
+	acked := float64(payloadSize)
+
+	if self.inSlowStart() {
+		self.window += acked
+		self.clamp()
+		return
+	}
+
+	now := self.now()
+	if self.epochStart == 0 {
+		self.epochStart = now
+		if self.window < self.wMax {
+			self.k = math.Cbrt((self.wMax - self.window) / cubicSegmentSize / cubicC)
+			self.originPoint = self.wMax
+		} else {
+			self.k = 0
+			self.originPoint = self.window
+		}
+		self.wEst = self.window
+	}
+
+	t := float64(now-self.epochStart+int64(self.srttMs)) / 1000
+	target := self.originPoint + cubicC*math.Pow(t-self.k, 3)*cubicSegmentSize
+
+	// stay at least as aggressive as standard AIMD would be
+	self.wEst += 3 * cubicBeta / (2 - cubicBeta) * acked * cubicSegmentSize / self.window
+	if target < self.wEst {
+		target = self.wEst
+	}
+
+	if target > self.window {
+		self.window += (target - self.window) * acked / self.window
+	}
+	self.clamp()
+}
+
+func (self *cubicCongestionControl) OnRtt(rttMs uint16) {
+	if self.srttMs == 0 {
+		self.srttMs = rttMs
+	} else {
+		self.srttMs = (self.srttMs + rttMs) >> 1
+	}
+}
+
+func (self *cubicCongestionControl) OnRetransmit() {
+	now := self.now()
+	minInterval := int64(self.srttMs)
+	if minInterval < cubicMinRttMs {
+		minInterval = cubicMinRttMs
+	}
+	if now-self.lastReductionTime < minInterval {
+		return
+	}
+	self.lastReductionTime = now
+	self.epochStart = 0
+
+	// fast convergence: if we lost before reaching the previous max, release bandwidth for other flows
+	if self.window < self.wMax {
+		self.wMax = self.window * (1 + cubicBeta) / 2
+	} else {
+		self.wMax = self.window
+	}
+	self.window *= cubicBeta
+	self.clamp()
+	self.ssthresh = self.window
+}
+
+func (self *cubicCongestionControl) clamp() {
+	self.window = float64(clampWindowSize(self.options, self.window))
+}
+
+func (self *cubicCongestionControl) WindowSize() uint32 {
+	return uint32(self.window)
+}
+
+func (self *cubicCongestionControl) PacingRate() uint64 {
+	return 0
+}
+
+func (self *cubicCongestionControl) Inspect(detail *inspect.XgressSendBufferDetail) {
+	if self.inSlowStart() {
+		detail.CongestionState = "slow_start"
+	} else {
+		detail.CongestionState = "congestion_avoidance"
+	}
+}
@@ -0,0 +1,140 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testClock struct {
+	now int64
+}
+
+func (self *testClock) Now() int64 {
+	return self.now
+}
+
+func TestAimdCongestionControl(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	cc := newAimdCongestionControl(options)
+
+	req.Equal(options.TxPortalStartSize, cc.WindowSize())
+	req.Equal(uint64(0), cc.PacingRate())
+
+	for i := uint32(0); i < options.TxPortalIncreaseThresh; i++ {
+		cc.OnAck(1000)
+	}
+	req.Equal(options.TxPortalStartSize+options.TxPortalIncreaseThresh*1000, cc.WindowSize())
+
+	grown := cc.WindowSize()
+	for i := uint32(0); i < options.TxPortalRetxThresh; i++ {
+		cc.OnRetransmit()
+	}
+	req.Equal(uint32(float64(grown)*options.TxPortalRetxScale), cc.WindowSize())
+
+	for i := 0; i < 100; i++ {
+		for j := uint32(0); j < options.TxPortalRetxThresh; j++ {
+			cc.OnRetransmit()
+		}
+	}
+	req.Equal(options.TxPortalMinSize, cc.WindowSize())
+}
+
+func TestCubicCongestionControl(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	clock := &testClock{now: 1000}
+	cc := newCubicCongestionControl(options).(*cubicCongestionControl)
+	cc.now = clock.Now
+
+	cc.OnRtt(100)
+
+	// slow start grows by the bytes acked
+	cc.OnAck(10000)
+	req.Equal(options.TxPortalStartSize+10000, cc.WindowSize())
+
+	beforeLoss := cc.WindowSize()
+	cc.OnRetransmit()
+	afterLoss := cc.WindowSize()
+	req.Equal(uint32(float64(beforeLoss)*cubicBeta), afterLoss)
+	req.False(cc.inSlowStart())
+
+	// a second loss in the same round trip doesn't reduce the window again
+	clock.now += 10
+	cc.OnRetransmit()
+	req.Equal(afterLoss, cc.WindowSize())
+
+	// the window grows back towards, and then past, the previous max as time passes
+	for i := 0; i < 50; i++ {
+		clock.now += 100
+		for j := 0; j < 10; j++ {
+			cc.OnAck(1460)
+		}
+	}
+	req.Greater(cc.WindowSize(), beforeLoss)
+	req.LessOrEqual(cc.WindowSize(), options.TxPortalMaxSize)
+}
+
+func TestBbrCongestionControl(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+	clock := &testClock{now: 1000}
+	cc := newBbrCongestionControl(options).(*bbrCongestionControl)
+	cc.now = clock.Now
+
+	req.Equal(options.TxPortalStartSize, cc.WindowSize())
+	req.Equal(uint64(0), cc.PacingRate())
+
+	cc.OnRtt(100)
+
+	// deliver 100KB every 100ms, ie 1MB/s, for long enough to leave startup
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 10; j++ {
+			cc.OnAck(10000)
+		}
+		clock.now += 100
+	}
+	cc.OnAck(1)
+
+	req.Equal(uint64(1000000), cc.maxBw())
+	req.Equal(bbrStateProbeBw, cc.state)
+
+	// 1MB/s * 100ms = 100KB bdp, with a cwnd gain of 2
+	req.Equal(uint32(200000), cc.WindowSize())
+	req.Equal(uint64(float64(cc.maxBw())*cc.pacingGain), cc.PacingRate())
+}
+
+func TestNewCongestionControl(t *testing.T) {
+	req := require.New(t)
+	options := DefaultOptions()
+
+	for _, name := range []string{CongestionControlAimd, CongestionControlBbr, CongestionControlCubic} {
+		options.CongestionControl = name
+		cc, err := NewCongestionControl(options)
+		req.NoError(err)
+		req.Equal(name, cc.Name())
+	}
+
+	options.CongestionControl = "reno"
+	_, err := NewCongestionControl(options)
+	req.Error(err)
+
+	_, err = LoadOptions(OptionsData{"options": map[interface{}]interface{}{"congestionControl": "reno"}})
+	req.Error(err)
+}
@@ -111,6 +111,9 @@ func DecodePayload(payload *Payload) ([]byte, bool) {
 		meta["flags"] = payload.Flags
 	}
 	meta["length"] = len(payload.Data)
+	if payload.IsCompressedFlagSet() {
+		meta["compressed"] = true
+	}
 
 	data, err := meta.MarshalTraceMessageDecode()
 	if err != nil {
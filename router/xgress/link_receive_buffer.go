@@ -22,6 +22,7 @@ import (
 	"github.com/emirpasic/gods/utils"
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/fabric/inspect"
+	"sort"
 	"sync/atomic"
 	"time"
 )
@@ -32,12 +33,18 @@ type LinkReceiveBuffer struct {
 	maxSequence        int32
 	size               uint32
 	lastBufferSizeSent uint32
+
+	// contiguousThrough is the highest sequence for which it and every earlier payload has been received
+	contiguousThrough int32
+	// gapRanges holds the payloads received beyond a gap, as sorted, non-adjacent ranges
+	gapRanges []AckRange
 }
 
 func NewLinkReceiveBuffer() *LinkReceiveBuffer {
 	return &LinkReceiveBuffer{
-		tree:     btree.NewWith(10240, utils.Int32Comparator),
-		sequence: -1,
+		tree:              btree.NewWith(10240, utils.Int32Comparator),
+		sequence:          -1,
+		contiguousThrough: -1,
 	}
 }
 
@@ -64,10 +71,66 @@ func (buffer *LinkReceiveBuffer) ReceiveUnordered(payload *Payload, maxSize uint
 		if payload.Sequence > buffer.maxSequence {
 			buffer.maxSequence = payload.Sequence
 		}
+		buffer.trackReceived(payload.Sequence)
 	}
 	return true
 }
 
+func (buffer *LinkReceiveBuffer) trackReceived(sequence int32) {
+	if sequence <= buffer.contiguousThrough {
+		return
+	}
+
+	if sequence == buffer.contiguousThrough+1 {
+		buffer.contiguousThrough = sequence
+		if len(buffer.gapRanges) > 0 && buffer.gapRanges[0].Start == sequence+1 {
+			// the gap has been filled
+			buffer.contiguousThrough = buffer.gapRanges[0].End
+			buffer.gapRanges = buffer.gapRanges[1:]
+		}
+		return
+	}
+
+	ranges := buffer.gapRanges
+	idx := sort.Search(len(ranges), func(i int) bool {
+		return ranges[i].End >= sequence-1
+	})
+
+	if idx < len(ranges) && ranges[idx].Start <= sequence+1 {
+		if sequence < ranges[idx].Start {
+			ranges[idx].Start = sequence
+		} else if sequence > ranges[idx].End {
+			ranges[idx].End = sequence
+			if idx+1 < len(ranges) && ranges[idx+1].Start == sequence+1 {
+				ranges[idx].End = ranges[idx+1].End
+				buffer.gapRanges = append(ranges[:idx+1], ranges[idx+2:]...)
+			}
+		}
+		return
+	}
+
+	ranges = append(ranges, AckRange{})
+	copy(ranges[idx+1:], ranges[idx:])
+	ranges[idx] = AckRange{Start: sequence, End: sequence}
+	buffer.gapRanges = ranges
+}
+
+// getAckRanges returns the ranges of payloads received so far, for use in acknowledgements. The first range covers
+// everything received without a gap, and the rest are payloads received beyond gaps, which lets the sender detect
+// losses and retransmit them early
+func (buffer *LinkReceiveBuffer) getAckRanges() []AckRange {
+	count := len(buffer.gapRanges)
+	if count > MaxAckRanges-1 {
+		count = MaxAckRanges - 1
+	}
+
+	result := make([]AckRange, 0, count+1)
+	if buffer.contiguousThrough >= 0 {
+		result = append(result, AckRange{Start: 0, End: buffer.contiguousThrough})
+	}
+	return append(result, buffer.gapRanges[:count]...)
+}
+
 func (buffer *LinkReceiveBuffer) PeekHead() *Payload {
 	if val := buffer.tree.LeftValue(); val != nil {
 		payload := val.(*Payload)
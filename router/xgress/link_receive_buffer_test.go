@@ -0,0 +1,65 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLinkReceiveBuffer_AckRanges(t *testing.T) {
+	req := require.New(t)
+	buffer := NewLinkReceiveBuffer()
+
+	receive := func(sequences ...int32) {
+		for _, sequence := range sequences {
+			req.True(buffer.ReceiveUnordered(&Payload{Sequence: sequence, Data: []byte{1}}, 1024))
+		}
+	}
+
+	req.Empty(buffer.getAckRanges())
+
+	receive(1, 2)
+	req.Equal([]AckRange{{1, 2}}, buffer.getAckRanges())
+
+	receive(0)
+	req.Equal([]AckRange{{0, 2}}, buffer.getAckRanges())
+
+	receive(5, 9, 7, 10)
+	req.Equal([]AckRange{{0, 2}, {5, 5}, {7, 7}, {9, 10}}, buffer.getAckRanges())
+
+	receive(8)
+	req.Equal([]AckRange{{0, 2}, {5, 5}, {7, 10}}, buffer.getAckRanges())
+
+	receive(6, 4)
+	req.Equal([]AckRange{{0, 2}, {4, 10}}, buffer.getAckRanges())
+
+	// duplicates don't change anything
+	receive(4, 10, 1)
+	req.Equal([]AckRange{{0, 2}, {4, 10}}, buffer.getAckRanges())
+
+	receive(3)
+	req.Equal([]AckRange{{0, 10}}, buffer.getAckRanges())
+
+	for i := int32(0); i < MaxAckRanges*2; i++ {
+		receive(12 + i*2)
+	}
+	ranges := buffer.getAckRanges()
+	req.Len(ranges, MaxAckRanges)
+	req.Equal(AckRange{0, 10}, ranges[0])
+	req.Equal(AckRange{12, 12}, ranges[1])
+}
@@ -38,10 +38,12 @@ type LinkSendBuffer struct {
 	buffer                map[int32]*txPayload
 	newlyBuffered         chan *txPayload
 	newlyReceivedAcks     chan *Acknowledgement
+	congestionControl     CongestionControl
 	windowsSize           uint32
+	pacingRate            uint64
+	nextSendTime          time.Time
 	linkSendBufferSize    uint32
 	linkRecvBufferSize    uint32
-	accumulator           uint32
 	successfulAcks        uint32
 	duplicateAcks         uint32
 	retransmits           uint32
@@ -52,6 +54,7 @@ type LinkSendBuffer struct {
 	retxScale             float64
 	retxThreshold         uint32
 	lastRtt               uint16
+	ackedThrough          int32
 	lastRetransmitTime    int64
 	closeWhenEmpty        concurrenz.AtomicBoolean
 	inspectRequests       chan *sendBufferInspectEvent
@@ -64,6 +67,11 @@ type txPayload struct {
 	x          *Xgress
 	next       *txPayload
 	prev       *txPayload
+
+	// gapReports counts range acks which have reported this payload missing, and is only accessed from the
+	// LinkSendBuffer run loop
+	gapReports        uint32
+	fastRetransmitted bool
 }
 
 func (self *txPayload) markSent() {
@@ -98,14 +106,23 @@ func (self *txPayload) isRetransmittable() bool {
 func NewLinkSendBuffer(x *Xgress) *LinkSendBuffer {
 	logrus.Debugf("txPortalStartSize = %d", x.Options.TxPortalStartSize)
 
+	congestionControl, err := NewCongestionControl(x.Options)
+	if err != nil {
+		pfxlog.ContextLogger(x.Label()).WithError(err).Warnf("falling back to %v congestion control", CongestionControlAimd)
+		congestionControl = newAimdCongestionControl(x.Options)
+	}
+
 	buffer := &LinkSendBuffer{
 		x:                 x,
 		buffer:            make(map[int32]*txPayload),
 		newlyBuffered:     make(chan *txPayload, x.Options.TxQueueSize),
 		newlyReceivedAcks: make(chan *Acknowledgement),
 		closeNotify:       make(chan struct{}),
-		windowsSize:       x.Options.TxPortalStartSize,
+		congestionControl: congestionControl,
+		windowsSize:       congestionControl.WindowSize(),
+		pacingRate:        congestionControl.PacingRate(),
 		retxThreshold:     x.Options.RetxStartMs,
+		ackedThrough:      -1,
 		retxScale:         x.Options.RetxScale,
 		inspectRequests:   make(chan *sendBufferInspectEvent, 1),
 	}
@@ -179,21 +196,63 @@ func (buffer *LinkSendBuffer) isBlocked() bool {
 	return blocked
 }
 
+// pacingDelay returns how long to wait before the next payload may be sent, based on the congestion control
+// pacing rate. A zero result means the next payload may be sent immediately
+func (buffer *LinkSendBuffer) pacingDelay() time.Duration {
+	if buffer.pacingRate == 0 || buffer.nextSendTime.IsZero() {
+		return 0
+	}
+	return time.Until(buffer.nextSendTime)
+}
+
+func (buffer *LinkSendBuffer) markPaced(payloadSize int) {
+	if buffer.pacingRate == 0 {
+		return
+	}
+	now := time.Now()
+	if buffer.nextSendTime.Before(now) {
+		buffer.nextSendTime = now
+	}
+	buffer.nextSendTime = buffer.nextSendTime.Add(time.Duration(uint64(payloadSize) * uint64(time.Second) / buffer.pacingRate))
+}
+
+func (buffer *LinkSendBuffer) updateCongestionControlState() {
+	atomic.StoreUint32(&buffer.windowsSize, buffer.congestionControl.WindowSize())
+	atomic.StoreUint64(&buffer.pacingRate, buffer.congestionControl.PacingRate())
+}
+
 func (buffer *LinkSendBuffer) run() {
 	log := pfxlog.ContextLogger(buffer.x.Label())
 	defer log.Debugf("[%p] exited", buffer)
 	log.Debugf("[%p] started", buffer)
 
 	var buffered chan *txPayload
+	var paced <-chan time.Time
 
 	retransmitTicker := time.NewTicker(100 * time.Millisecond)
 	defer retransmitTicker.Stop()
 
+	// a single timer is reused for pacing, rather than allocating one per loop. A stale fire just causes an extra
+	// pass through the loop, after which the delay is recalculated
+	pacingTimer := time.NewTimer(time.Hour)
+	pacingTimer.Stop()
+	defer pacingTimer.Stop()
+	pacingTimerActive := false
+
 	for {
+		paced = nil
+
 		// don't block when we're closing, since the only thing that should still be coming in is end-of-circuit
 		// if we're blocked, but empty, let one payload in to reduce the chances of a stall
 		if buffer.isBlocked() && !buffer.closeWhenEmpty.Get() && buffer.linkSendBufferSize != 0 {
 			buffered = nil
+		} else if delay := buffer.pacingDelay(); delay > 0 && !buffer.closeWhenEmpty.Get() {
+			buffered = nil
+			if !pacingTimerActive {
+				pacingTimer.Reset(delay)
+				pacingTimerActive = true
+			}
+			paced = pacingTimer.C
 		} else {
 			buffered = buffer.newlyBuffered
 		}
@@ -228,9 +287,14 @@ func (buffer *LinkSendBuffer) run() {
 			buffer.linkSendBufferSize += uint32(payloadSize)
 			atomic.AddInt64(&outstandingPayloads, 1)
 			atomic.AddInt64(&outstandingPayloadBytes, int64(payloadSize))
+			buffer.markPaced(payloadSize)
 			log.Tracef("buffering payload %v with size %v. payload buffer size: %v",
 				txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
 
+		case <-paced:
+			// pacing delay has elapsed, loop around so newly buffered payloads can be accepted again
+			pacingTimerActive = false
+
 		case <-retransmitTicker.C:
 			buffer.retransmit()
 
@@ -255,32 +319,7 @@ func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 
 	for _, sequence := range ack.Sequence {
 		if txPayload, found := buffer.buffer[sequence]; found {
-			if txPayload.markAcked() { // if it's been queued for retransmission, remove it from the queue
-				retransmitter.queue(txPayload)
-			}
-
-			payloadSize := uint32(len(txPayload.payload.Data))
-			buffer.accumulator += payloadSize
-			buffer.successfulAcks++
-			delete(buffer.buffer, sequence)
-			atomic.AddInt64(&outstandingPayloads, -1)
-			atomic.AddInt64(&outstandingPayloadBytes, -int64(payloadSize))
-			buffer.linkSendBufferSize -= payloadSize
-			log.Debugf("removing payload %v with size %v. payload buffer size: %v",
-				txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
-
-			if buffer.successfulAcks >= buffer.x.Options.TxPortalIncreaseThresh {
-				buffer.successfulAcks = 0
-				delta := uint32(float64(buffer.accumulator) * buffer.x.Options.TxPortalIncreaseScale)
-				buffer.windowsSize += delta
-				if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-					buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-				}
-				buffer.retxScale -= 0.02
-				if buffer.retxScale < buffer.x.Options.RetxScale {
-					buffer.retxScale = buffer.x.Options.RetxScale
-				}
-			}
+			buffer.acknowledge(txPayload, log)
 		} else { // duplicate ack
 			duplicateAcksMeter.Mark(1)
 			buffer.duplicateAcks++
@@ -291,15 +330,107 @@ func (buffer *LinkSendBuffer) receiveAcknowledgement(ack *Acknowledgement) {
 		}
 	}
 
+	if len(ack.Ranges) > 0 {
+		buffer.receiveAckRanges(ack.Ranges, log)
+	}
+
 	buffer.linkRecvBufferSize = ack.RecvBufferSize
 	if ack.RTT > 0 {
 		rtt := uint16(info.NowInMilliseconds()) - ack.RTT
+		buffer.congestionControl.OnRtt(rtt)
 		if buffer.lastRtt > 0 {
 			rtt = (rtt + buffer.lastRtt) >> 1
 		}
 		buffer.lastRtt = rtt
 		buffer.retxThreshold = uint32(float64(rtt)*buffer.retxScale) + buffer.x.Options.RetxAddMs
 	}
+
+	buffer.updateCongestionControlState()
+}
+
+func (buffer *LinkSendBuffer) acknowledge(txPayload *txPayload, log *logrus.Entry) {
+	if txPayload.markAcked() { // if it's been queued for retransmission, remove it from the queue
+		retransmitter.queue(txPayload)
+	}
+
+	payloadSize := uint32(len(txPayload.payload.Data))
+	buffer.congestionControl.OnAck(payloadSize)
+	buffer.successfulAcks++
+	delete(buffer.buffer, txPayload.payload.Sequence)
+	atomic.AddInt64(&outstandingPayloads, -1)
+	atomic.AddInt64(&outstandingPayloadBytes, -int64(payloadSize))
+	buffer.linkSendBufferSize -= payloadSize
+	log.Debugf("removing payload %v with size %v. payload buffer size: %v",
+		txPayload.payload.Sequence, len(txPayload.payload.Data), buffer.linkSendBufferSize)
+
+	if buffer.successfulAcks >= buffer.x.Options.TxPortalIncreaseThresh {
+		buffer.successfulAcks = 0
+		buffer.retxScale -= 0.02
+		if buffer.retxScale < buffer.x.Options.RetxScale {
+			buffer.retxScale = buffer.x.Options.RetxScale
+		}
+	}
+}
+
+// receiveAckRanges removes every buffered payload covered by the given ranges. Payloads falling in the gaps
+// between ranges were sent before payloads which have since arrived, so they're likely lost. Once a payload has
+// been reported missing FastRetxThresh times, it's retransmitted without waiting for the retransmit timeout.
+func (buffer *LinkSendBuffer) receiveAckRanges(ranges []AckRange, log *logrus.Entry) {
+	prevEnd := buffer.ackedThrough
+	for _, r := range ranges {
+		if r.Start > prevEnd+1 {
+			buffer.checkGap(prevEnd+1, r.Start-1)
+		}
+
+		start := r.Start
+		if start <= buffer.ackedThrough {
+			start = buffer.ackedThrough + 1
+		}
+		for sequence := start; sequence <= r.End && sequence >= start; sequence++ {
+			if txPayload, found := buffer.buffer[sequence]; found {
+				buffer.acknowledge(txPayload, log)
+			}
+		}
+
+		if r.Start <= buffer.ackedThrough+1 && r.End > buffer.ackedThrough {
+			buffer.ackedThrough = r.End
+		}
+		if r.End > prevEnd {
+			prevEnd = r.End
+		}
+	}
+}
+
+func (buffer *LinkSendBuffer) checkGap(from, to int32) {
+	threshold := buffer.x.Options.FastRetxThresh
+	if threshold == 0 {
+		return
+	}
+
+	for sequence := from; sequence <= to && sequence >= from; sequence++ {
+		txPayload, found := buffer.buffer[sequence]
+		if !found || txPayload.fastRetransmitted {
+			continue
+		}
+		txPayload.gapReports++
+		if txPayload.gapReports >= threshold && txPayload.isRetransmittable() {
+			txPayload.fastRetransmitted = true
+			txPayload.markQueued()
+			retransmitter.queue(txPayload)
+			fastRetransmissions.Mark(1)
+			buffer.onRetransmit()
+		}
+	}
+}
+
+// onRetransmit counts a payload queued for retransmission. The count is reset once it reaches TxPortalRetxThresh,
+// matching the point at which the default congestion control shrinks the window
+func (buffer *LinkSendBuffer) onRetransmit() {
+	buffer.retransmits++
+	if buffer.retransmits >= buffer.x.Options.TxPortalRetxThresh {
+		buffer.retransmits = 0
+	}
+	buffer.congestionControl.OnRetransmit()
 }
 
 func (buffer *LinkSendBuffer) retransmit() {
@@ -313,40 +444,24 @@ func (buffer *LinkSendBuffer) retransmit() {
 				v.markQueued()
 				retransmitter.queue(v)
 				retransmitted++
-				buffer.retransmits++
-				if buffer.retransmits >= buffer.x.Options.TxPortalRetxThresh {
-					buffer.accumulator = 0
-					buffer.retransmits = 0
-					buffer.scale(buffer.x.Options.TxPortalRetxScale)
-				}
+				buffer.onRetransmit()
 			}
 		}
 
 		if retransmitted > 0 {
+			buffer.updateCongestionControlState()
 			log.Debugf("retransmitted [%d] payloads, [%d] buffered, linkSendBufferSize: %d", retransmitted, len(buffer.buffer), buffer.linkSendBufferSize)
 		}
 		buffer.lastRetransmitTime = now
 	}
 }
 
-func (buffer *LinkSendBuffer) scale(factor float64) {
-	buffer.windowsSize = uint32(float64(buffer.windowsSize) * factor)
-	if factor > 1 {
-		if buffer.windowsSize > buffer.x.Options.TxPortalMaxSize {
-			buffer.windowsSize = buffer.x.Options.TxPortalMaxSize
-		}
-	} else if buffer.windowsSize < buffer.x.Options.TxPortalMinSize {
-		buffer.windowsSize = buffer.x.Options.TxPortalMinSize
-	}
-}
-
 func (buffer *LinkSendBuffer) inspect() *inspect.XgressSendBufferDetail {
 	timeSinceLastRetransmit := time.Duration(info.NowInMilliseconds()-buffer.lastRetransmitTime) * time.Millisecond
 	result := &inspect.XgressSendBufferDetail{
 		WindowSize:            buffer.windowsSize,
 		LinkSendBufferSize:    buffer.linkSendBufferSize,
 		LinkRecvBufferSize:    buffer.linkRecvBufferSize,
-		Accumulator:           buffer.accumulator,
 		SuccessfulAcks:        buffer.successfulAcks,
 		DuplicateAcks:         buffer.duplicateAcks,
 		Retransmits:           buffer.retransmits,
@@ -357,7 +472,11 @@ func (buffer *LinkSendBuffer) inspect() *inspect.XgressSendBufferDetail {
 		RetxThreshold:         buffer.retxThreshold,
 		TimeSinceLastRetx:     timeSinceLastRetransmit.String(),
 		CloseWhenEmpty:        buffer.closeWhenEmpty.Get(),
+		CongestionControl:     buffer.congestionControl.Name(),
+		PacingRate:            buffer.pacingRate,
+		Rtt:                   buffer.lastRtt,
 	}
+	buffer.congestionControl.Inspect(result)
 	return result
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/openziti/channel"
 	"github.com/openziti/foundation/v2/info"
 	"github.com/openziti/foundation/v2/uuidz"
+	"github.com/openziti/foundation/v2/versions"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"math"
@@ -36,10 +37,18 @@ const (
 	HeaderKeyFlags          = 2258
 	HeaderKeyRecvBufferSize = 2259
 	HeaderKeyRTT            = 2260
+	HeaderKeyAckRanges      = 2262
 
 	ContentTypePayloadType         = 1100
 	ContentTypeAcknowledgementType = 1101
 	ContentTypeControlType         = 1102
+
+	// MaxAckRanges limits how many received sequence ranges are reported in a single acknowledgement
+	MaxAckRanges = 16
+
+	// AckRangesMinRouterVersion is the first router version which understands range acknowledgements. Acks sent
+	// over links to older routers only carry the individual sequence list
+	AckRangesMinRouterVersion = "0.19.1"
 )
 
 var ContentTypeValue = map[string]int32{
@@ -68,6 +77,7 @@ const (
 	PayloadFlagCircuitEnd   PayloadFlag = 1
 	PayloadFlagOriginator   PayloadFlag = 2
 	PayloadFlagCircuitStart PayloadFlag = 4
+	PayloadFlagCompressed   PayloadFlag = 8
 )
 
 type Header struct {
@@ -130,9 +140,31 @@ func NewAcknowledgement(circuitId string, originator Originator) *Acknowledgemen
 	}
 }
 
+// AckRange is an inclusive range of payload sequence numbers which the receiver holds or has already delivered
+type AckRange struct {
+	Start int32
+	End   int32
+}
+
+func (self AckRange) Contains(sequence int32) bool {
+	return sequence >= self.Start && sequence <= self.End
+}
+
+// SupportsAckRanges returns true if a router running the given version can decode range acknowledgements
+func SupportsAckRanges(routerVersion string) bool {
+	versionInfo := &versions.VersionInfo{Version: routerVersion}
+	supported, err := versionInfo.HasMinimumVersion(AckRangesMinRouterVersion)
+	return err == nil && supported
+}
+
 type Acknowledgement struct {
 	Header
 	Sequence []int32
+
+	// Ranges, if present, describes everything the receiver has received, in ascending order. When the first range
+	// starts at zero, it acknowledges every payload up to its end. Later ranges are selective acknowledgements of
+	// payloads received after a gap
+	Ranges []AckRange
 }
 
 func (ack *Acknowledgement) GetSequence() []int32 {
@@ -170,7 +202,47 @@ func (ack *Acknowledgement) unmarshallSequence(data []byte) error {
 	return nil
 }
 
+func (ack *Acknowledgement) marshallRanges() []byte {
+	buf := make([]byte, len(ack.Ranges)*8)
+	nextWriteBuf := buf
+	for _, r := range ack.Ranges {
+		binary.BigEndian.PutUint32(nextWriteBuf, uint32(r.Start))
+		binary.BigEndian.PutUint32(nextWriteBuf[4:], uint32(r.End))
+		nextWriteBuf = nextWriteBuf[8:]
+	}
+	return buf
+}
+
+func (ack *Acknowledgement) unmarshallRanges(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	if len(data)%8 != 0 {
+		return fmt.Errorf("received ack ranges with wrong number of bytes: %v", len(data))
+	}
+	ack.Ranges = make([]AckRange, len(data)/8)
+
+	nextReadBuf := data
+	for i := range ack.Ranges {
+		ack.Ranges[i].Start = int32(binary.BigEndian.Uint32(nextReadBuf))
+		ack.Ranges[i].End = int32(binary.BigEndian.Uint32(nextReadBuf[4:]))
+		nextReadBuf = nextReadBuf[8:]
+	}
+	return nil
+}
+
 func (ack *Acknowledgement) Marshall() *channel.Message {
+	msg := ack.MarshallWithoutRanges()
+	if len(ack.Ranges) > 0 {
+		msg.Headers[HeaderKeyAckRanges] = ack.marshallRanges()
+	}
+	return msg
+}
+
+// MarshallWithoutRanges encodes the acknowledgement in the format understood by routers which predate range
+// acknowledgements
+func (ack *Acknowledgement) MarshallWithoutRanges() *channel.Message {
 	msg := channel.NewMessage(ContentTypeAcknowledgementType, ack.marshallSequence())
 	msg.PutUint16Header(HeaderKeyRTT, ack.RTT)
 	ack.marshallHeader(msg)
@@ -186,6 +258,9 @@ func UnmarshallAcknowledgement(msg *channel.Message) (*Acknowledgement, error) {
 	if err := ack.unmarshallSequence(msg.Body); err != nil {
 		return nil, err
 	}
+	if err := ack.unmarshallRanges(msg.Headers[HeaderKeyAckRanges]); err != nil {
+		return nil, err
+	}
 
 	return ack, nil
 }
@@ -195,6 +270,7 @@ func (ack *Acknowledgement) GetLoggerFields() logrus.Fields {
 		"circuitId":          ack.CircuitId,
 		"linkRecvBufferSize": ack.RecvBufferSize,
 		"seq":                fmt.Sprintf("%+v", ack.Sequence),
+		"ranges":             fmt.Sprintf("%+v", ack.Ranges),
 		"RTT":                ack.RTT,
 	}
 }
@@ -265,6 +341,10 @@ func (payload *Payload) IsCircuitStartFlagSet() bool {
 	return isPayloadFlagSet(payload.Flags, PayloadFlagCircuitStart)
 }
 
+func (payload *Payload) IsCompressedFlagSet() bool {
+	return isPayloadFlagSet(payload.Flags, PayloadFlagCompressed)
+}
+
 func SetOriginatorFlag(flags uint32, originator Originator) uint32 {
 	if originator == Initiator {
 		return ^uint32(PayloadFlagOriginator) & flags
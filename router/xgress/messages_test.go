@@ -17,7 +17,13 @@
 package xgress
 
 import (
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"github.com/openziti/channel"
+	"github.com/openziti/identity"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"reflect"
 	"testing"
 )
@@ -97,7 +103,7 @@ func TestAcknowledgement_marshallSequence(t *testing.T) {
 			err := ack2.unmarshallSequence(got)
 			assert.NoError(t, err)
 
-			if len(ack.Sequence) == 0 && len(ack.Sequence) == 0 {
+			if len(ack.Sequence) == 0 && len(ack2.Sequence) == 0 {
 				return
 			}
 			if !reflect.DeepEqual(ack, ack2) {
@@ -106,3 +112,107 @@ func TestAcknowledgement_marshallSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestPayload_MarshallCompressed(t *testing.T) {
+	req := require.New(t)
+
+	data := bytes.Repeat([]byte("satellite links are slow "), 100)
+	compressed, ok, err := compressPayloadData(CompressionTypeDeflate, flate.BestSpeed, data)
+	req.NoError(err)
+	req.True(ok)
+	req.Less(len(compressed), len(data))
+
+	payload := &Payload{
+		Header: Header{
+			CircuitId: "test",
+			Flags:     uint32(PayloadFlagCompressed),
+		},
+		Sequence: 10,
+		Data:     compressed,
+	}
+
+	// a transit router from before compression was added only forwards the headers it knows about
+	payload2, err := UnmarshallPayload(baselineForward(payload.Marshall()))
+	req.NoError(err)
+	req.True(payload2.IsCompressedFlagSet())
+
+	decompressed, err := decompressPayloadData(CompressionTypeDeflate, payload2.Data)
+	req.NoError(err)
+	req.Equal(data, decompressed)
+}
+
+// baselineForward re-marshals a payload message the way a pre-0.19.1 transit router does, dropping any headers
+// it doesn't understand
+func baselineForward(msg *channel.Message) *channel.Message {
+	result := channel.NewMessage(msg.ContentType, msg.Body)
+	for key, value := range msg.Headers {
+		if (key >= MinHeaderKey && key <= MaxHeaderKey) || (key >= HeaderKeyCircuitId && key <= HeaderKeyRTT) {
+			result.Headers[key] = value
+		}
+	}
+	return result
+}
+
+func TestCompressPayloadData_Incompressible(t *testing.T) {
+	req := require.New(t)
+
+	data := make([]byte, 1024)
+	_, err := rand.Read(data)
+	req.NoError(err)
+
+	_, ok, err := compressPayloadData(CompressionTypeDeflate, flate.BestSpeed, data)
+	req.NoError(err)
+	req.False(ok)
+}
+
+func TestCompressionNegotiation(t *testing.T) {
+	req := require.New(t)
+
+	options := DefaultOptions()
+	req.Nil(options.AddCompressionOffer("svc1", nil))
+
+	options.Compression = CompressionTypeDeflate
+	offer := options.AddCompressionOffer("svc1", nil)
+	req.Equal(CompressionTypeDeflate, NegotiatedCompression(offer))
+
+	options.CompressionServices = map[string]struct{}{"svc2": {}}
+	req.Equal(CompressionTypeNone, NegotiatedCompression(options.AddCompressionOffer("svc1", nil)))
+	req.Equal(CompressionTypeDeflate, NegotiatedCompression(options.AddCompressionOffer("svc2", nil)))
+
+	// an ingress without the ack from the egress router doesn't compress
+	x := NewXgress(&identity.TokenId{Token: "c1", Data: map[uint32][]byte{}}, "", nil, Initiator, options)
+	req.Equal(CompressionTypeNone, x.compression)
+
+	x = NewXgress(&identity.TokenId{Token: "c1", Data: offer}, "", nil, Terminator, options)
+	req.Equal(CompressionTypeDeflate, x.compression)
+
+	req.Equal(CompressionTypeNone, NegotiatedCompression(map[uint32][]byte{PeerDataCompressionHeader: {99}}))
+}
+
+func TestAcknowledgement_MarshallRanges(t *testing.T) {
+	req := require.New(t)
+
+	ack := NewAcknowledgement("test", Terminator)
+	ack.Sequence = []int32{7}
+	ack.Ranges = []AckRange{{0, 3}, {5, 7}}
+	ack.RecvBufferSize = 1000
+
+	ack2, err := UnmarshallAcknowledgement(ack.Marshall())
+	req.NoError(err)
+	req.Equal(ack.Sequence, ack2.Sequence)
+	req.Equal(ack.Ranges, ack2.Ranges)
+	req.Equal(Terminator, ack2.GetOriginator())
+
+	ack3, err := UnmarshallAcknowledgement(ack.MarshallWithoutRanges())
+	req.NoError(err)
+	req.Equal(ack.Sequence, ack3.Sequence)
+	req.Empty(ack3.Ranges)
+}
+
+func TestSupportsAckRanges(t *testing.T) {
+	req := require.New(t)
+	req.True(SupportsAckRanges(AckRangesMinRouterVersion))
+	req.True(SupportsAckRanges("v0.0.0"))
+	req.False(SupportsAckRanges("v0.18.9"))
+	req.False(SupportsAckRanges(""))
+}
@@ -9,11 +9,13 @@ var ackTxMeter metrics.Meter
 var ackRxMeter metrics.Meter
 var droppedPayloadsMeter metrics.Meter
 var retransmissions metrics.Meter
+var fastRetransmissions metrics.Meter
 var retransmissionFailures metrics.Meter
 
 var ackFailures metrics.Meter
 var payloadWriteTimer metrics.Timer
 var duplicateAcksMeter metrics.Meter
+var throttledBytesMeter metrics.Meter
 
 var buffersBlockedByLocalWindow int64
 var buffersBlockedByRemoteWindow int64
@@ -23,12 +25,14 @@ var outstandingPayloadBytes int64
 func InitMetrics(registry metrics.UsageRegistry) {
 	droppedPayloadsMeter = registry.Meter("xgress.dropped_payloads")
 	retransmissions = registry.Meter("xgress.retransmissions")
+	fastRetransmissions = registry.Meter("xgress.fast_retransmissions")
 	retransmissionFailures = registry.Meter("xgress.retransmission_failures")
 	ackRxMeter = registry.Meter("xgress.rx.acks")
 	ackTxMeter = registry.Meter("xgress.tx.acks")
 	ackFailures = registry.Meter("xgress.ack_failures")
 	payloadWriteTimer = registry.Timer("xgress.tx_write_time")
 	duplicateAcksMeter = registry.Meter("xgress.ack_duplicates")
+	throttledBytesMeter = registry.Meter("xgress.throttled_bytes")
 
 	registry.FuncGauge("xgress.blocked_by_local_window", func() int64 {
 		return atomic.LoadInt64(&buffersBlockedByLocalWindow)
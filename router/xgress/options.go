@@ -17,7 +17,9 @@
 package xgress
 
 import (
+	"compress/flate"
 	"encoding/json"
+	"fmt"
 	"github.com/pkg/errors"
 	"time"
 )
@@ -44,10 +46,22 @@ type Options struct {
 	RetxScale    float64
 	RetxAddMs    uint32
 
+	FastRetxThresh uint32
+
+	CongestionControl string
+
 	MaxCloseWait        time.Duration
 	GetCircuitTimeout   time.Duration
 	CircuitStartTimeout time.Duration
 	ConnectTimeout      time.Duration
+
+	Compression        CompressionType
+	CompressionLevel   int
+	CompressionMinSize int32
+	// CompressionServices, if not empty, limits compression to circuits for the listed service names or ids
+	CompressionServices map[string]struct{}
+
+	BandwidthLimit uint64
 }
 
 func LoadOptions(data OptionsData) (*Options, error) {
@@ -110,6 +124,17 @@ func LoadOptions(data OptionsData) (*Options, error) {
 			options.RetxAddMs = uint32(value.(int))
 		}
 
+		if value, found := data["fastRetxThresh"]; found {
+			options.FastRetxThresh = uint32(value.(int))
+		}
+
+		if value, found := data["congestionControl"]; found {
+			options.CongestionControl = value.(string)
+			if _, err := getCongestionControlFactory(options.CongestionControl); err != nil {
+				return nil, errors.Wrap(err, "invalid 'congestionControl' value")
+			}
+		}
+
 		if value, found := data["maxCloseWaitMs"]; found {
 			options.MaxCloseWait = time.Duration(value.(int)) * time.Millisecond
 		}
@@ -137,6 +162,42 @@ func LoadOptions(data OptionsData) (*Options, error) {
 			}
 			options.ConnectTimeout = connectTimeout
 		}
+
+		if value, found := data["compression"]; found {
+			compression, err := ParseCompressionType(value.(string))
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid 'compression' value")
+			}
+			options.Compression = compression
+		}
+		if value, found := data["compressionLevel"]; found {
+			level := value.(int)
+			if level < flate.HuffmanOnly || level > flate.BestCompression {
+				return nil, errors.Errorf("invalid 'compressionLevel' value %v, must be between %v and %v", level, flate.HuffmanOnly, flate.BestCompression)
+			}
+			options.CompressionLevel = level
+		}
+		if value, found := data["compressionMinSize"]; found {
+			options.CompressionMinSize = int32(value.(int))
+		}
+		if value, found := data["compressionServices"]; found {
+			services, ok := value.([]interface{})
+			if !ok {
+				return nil, errors.Errorf("invalid 'compressionServices' value %v, must be a list of service names or ids", value)
+			}
+			options.CompressionServices = map[string]struct{}{}
+			for _, service := range services {
+				options.CompressionServices[fmt.Sprintf("%v", service)] = struct{}{}
+			}
+		}
+
+		if value, found := data["bandwidthLimit"]; found {
+			bandwidthLimit, ok := value.(int)
+			if !ok || bandwidthLimit < 0 {
+				return nil, errors.Errorf("invalid 'bandwidthLimit' value %v, must be a non-negative number of bytes per second", value)
+			}
+			options.BandwidthLimit = uint64(bandwidthLimit)
+		}
 	}
 
 	return options, nil
@@ -161,11 +222,37 @@ func DefaultOptions() *Options {
 		RetxStartMs:            200,
 		RetxScale:              1.5,
 		RetxAddMs:              0,
+		FastRetxThresh:         3,
+		CongestionControl:      CongestionControlAimd,
 		MaxCloseWait:           30 * time.Second,
 		GetCircuitTimeout:      30 * time.Second,
 		CircuitStartTimeout:    3 * time.Minute,
 		ConnectTimeout:         0, // operating system default
+		Compression:            CompressionTypeNone,
+		CompressionLevel:       flate.BestSpeed,
+		CompressionMinSize:     256,
+		BandwidthLimit:         0,
+	}
+}
+
+// AddCompressionOffer adds the configured compression type to the peer data of a circuit request for the given
+// service, if compression is enabled for that service. The egress router accepts the offer by echoing it back
+func (options *Options) AddCompressionOffer(service string, peerData map[uint32][]byte) map[uint32][]byte {
+	if options.Compression == CompressionTypeNone {
+		return peerData
+	}
+
+	if len(options.CompressionServices) > 0 {
+		if _, found := options.CompressionServices[service]; !found {
+			return peerData
+		}
+	}
+
+	if peerData == nil {
+		peerData = map[uint32][]byte{}
 	}
+	peerData[PeerDataCompressionHeader] = []byte{byte(options.Compression)}
+	return peerData
 }
 
 func (options Options) String() string {
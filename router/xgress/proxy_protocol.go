@@ -0,0 +1,329 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Peer data keys used to carry the address of the client which connected to the ingress xgress through to the
+// egress xgress, so it can be passed on to the backend, for example in a PROXY protocol header
+const (
+	PeerDataSourceAddrHeader = 1110
+	PeerDataDestAddrHeader   = 1111
+)
+
+const (
+	ProxyProtocolV1 = "v1"
+	ProxyProtocolV2 = "v2"
+
+	proxyV1Prefix    = "PROXY "
+	proxyV1MaxLength = 107
+
+	proxyV2CmdLocal = 0x20
+	proxyV2CmdProxy = 0x21
+	proxyV2Unspec   = 0x00
+	proxyV2TCP4     = 0x11
+	proxyV2TCP6     = 0x21
+
+	// proxyHeaderTimeout bounds how long a peer may take to send its PROXY protocol header
+	proxyHeaderTimeout = 10 * time.Second
+)
+
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// LoadAcceptProxyProtocol reads the 'acceptProxyProtocol' listener setting, which requires every connection to the
+// listener to start with a PROXY protocol header, as sent by load balancers such as HAProxy or AWS NLB
+func LoadAcceptProxyProtocol(optionsData OptionsData) (bool, error) {
+	value, found := optionsData["acceptProxyProtocol"]
+	if !found {
+		return false, nil
+	}
+	accept, ok := value.(bool)
+	if !ok {
+		return false, errors.Errorf("invalid 'acceptProxyProtocol' configuration option, expected boolean, got %T", value)
+	}
+	return accept, nil
+}
+
+// ClientAddrPeerData returns peer data describing the client end of the given ingress connection, for use when
+// requesting a circuit
+func ClientAddrPeerData(conn net.Conn) map[uint32][]byte {
+	result := map[uint32][]byte{}
+	if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+		result[PeerDataSourceAddrHeader] = []byte(addr.String())
+	}
+	if addr, ok := conn.LocalAddr().(*net.TCPAddr); ok {
+		result[PeerDataDestAddrHeader] = []byte(addr.String())
+	}
+	return result
+}
+
+// ClientAddrFromPeerData returns the client source and destination addresses recorded by the ingress xgress, if the
+// peer data has them
+func ClientAddrFromPeerData(peerData map[uint32][]byte) (*net.TCPAddr, *net.TCPAddr, bool) {
+	src := parseTCPAddr(peerData[PeerDataSourceAddrHeader])
+	dst := parseTCPAddr(peerData[PeerDataDestAddrHeader])
+	return src, dst, src != nil && dst != nil
+}
+
+func parseTCPAddr(val []byte) *net.TCPAddr {
+	if len(val) == 0 {
+		return nil
+	}
+	host, port, err := net.SplitHostPort(string(val))
+	if err != nil {
+		return nil
+	}
+	ip := net.ParseIP(host)
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if ip == nil || err != nil {
+		return nil
+	}
+	return &net.TCPAddr{IP: ip, Port: int(portNum)}
+}
+
+func IsValidProxyProtocolVersion(version string) bool {
+	return version == ProxyProtocolV1 || version == ProxyProtocolV2
+}
+
+// EncodeProxyHeader returns a PROXY protocol header of the given version for a connection from src to dst. If either
+// address is unknown, a header is generated which tells the backend to use the addresses of the connection itself
+func EncodeProxyHeader(version string, src, dst *net.TCPAddr) ([]byte, error) {
+	switch version {
+	case ProxyProtocolV1:
+		return encodeProxyHeaderV1(src, dst), nil
+	case ProxyProtocolV2:
+		return encodeProxyHeaderV2(src, dst), nil
+	default:
+		return nil, errors.Errorf("unsupported proxy protocol version '%v'", version)
+	}
+}
+
+func encodeProxyHeaderV1(src, dst *net.TCPAddr) []byte {
+	if src == nil || dst == nil {
+		return []byte(proxyV1Prefix + "UNKNOWN\r\n")
+	}
+
+	srcIP, dstIP, ipv4 := proxyHeaderIPs(src, dst)
+	family := "TCP6"
+	if ipv4 {
+		family = "TCP4"
+	}
+	return []byte(fmt.Sprintf("%v%v %v %v %v %v\r\n", proxyV1Prefix, family, srcIP, dstIP, src.Port, dst.Port))
+}
+
+func encodeProxyHeaderV2(src, dst *net.TCPAddr) []byte {
+	buf := &bytes.Buffer{}
+	buf.Write(proxyV2Signature)
+
+	if src == nil || dst == nil {
+		buf.Write([]byte{proxyV2CmdLocal, proxyV2Unspec, 0, 0})
+		return buf.Bytes()
+	}
+
+	srcIP, dstIP, ipv4 := proxyHeaderIPs(src, dst)
+	family := byte(proxyV2TCP6)
+	if ipv4 {
+		family = proxyV2TCP4
+	}
+
+	buf.Write([]byte{proxyV2CmdProxy, family})
+	_ = binary.Write(buf, binary.BigEndian, uint16(2*len(srcIP)+4))
+	buf.Write(srcIP)
+	buf.Write(dstIP)
+	_ = binary.Write(buf, binary.BigEndian, uint16(src.Port))
+	_ = binary.Write(buf, binary.BigEndian, uint16(dst.Port))
+	return buf.Bytes()
+}
+
+// proxyHeaderIPs returns the addresses in a common family, as PROXY headers can't mix IPv4 and IPv6. If either
+// address is IPv6, both are sent as IPv6, using the IPv4 mapped form where needed
+func proxyHeaderIPs(src, dst *net.TCPAddr) (net.IP, net.IP, bool) {
+	if srcIP, dstIP := src.IP.To4(), dst.IP.To4(); srcIP != nil && dstIP != nil {
+		return srcIP, dstIP, true
+	}
+	return src.IP.To16(), dst.IP.To16(), false
+}
+
+// ProxiedConn is a connection received through a proxy or load balancer which sent a PROXY protocol header. Its
+// RemoteAddr and LocalAddr report the addresses from the header, rather than those of the proxy
+type ProxiedConn struct {
+	transport.Conn
+	reader     *bufio.Reader
+	remoteAddr net.Addr
+	localAddr  net.Addr
+}
+
+func (self *ProxiedConn) Read(b []byte) (int, error) {
+	return self.reader.Read(b)
+}
+
+func (self *ProxiedConn) RemoteAddr() net.Addr {
+	if self.remoteAddr != nil {
+		return self.remoteAddr
+	}
+	return self.Conn.RemoteAddr()
+}
+
+func (self *ProxiedConn) LocalAddr() net.Addr {
+	if self.localAddr != nil {
+		return self.localAddr
+	}
+	return self.Conn.LocalAddr()
+}
+
+// AcceptProxyHeader reads a version 1 or version 2 PROXY protocol header from a newly accepted connection and returns
+// a connection which reports the addresses from the header. Headers which don't carry addresses (UNKNOWN and LOCAL)
+// are accepted, and the addresses of the connection itself are kept
+func AcceptProxyHeader(peer transport.Conn) (*ProxiedConn, error) {
+	if err := peer.SetReadDeadline(time.Now().Add(proxyHeaderTimeout)); err != nil {
+		return nil, err
+	}
+
+	result := &ProxiedConn{
+		Conn:   peer,
+		reader: bufio.NewReader(peer),
+	}
+
+	src, dst, err := readProxyHeader(result.reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if src != nil && dst != nil {
+		result.remoteAddr = src
+		result.localAddr = dst
+	}
+
+	if err = peer.SetReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func readProxyHeader(reader *bufio.Reader) (*net.TCPAddr, *net.TCPAddr, error) {
+	prefix, err := reader.Peek(len(proxyV2Signature))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to read proxy protocol header")
+	}
+
+	if bytes.Equal(prefix, proxyV2Signature) {
+		return readProxyHeaderV2(reader)
+	}
+
+	if bytes.HasPrefix(prefix, []byte(proxyV1Prefix)) {
+		return readProxyHeaderV1(reader)
+	}
+
+	return nil, nil, errors.New("connection did not start with a proxy protocol header")
+}
+
+func readProxyHeaderV1(reader *bufio.Reader) (*net.TCPAddr, *net.TCPAddr, error) {
+	var line []byte
+	for !bytes.HasSuffix(line, []byte("\r\n")) {
+		if len(line) >= proxyV1MaxLength {
+			return nil, nil, errors.New("proxy protocol v1 header too long")
+		}
+		b, err := reader.ReadByte()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "unable to read proxy protocol v1 header")
+		}
+		line = append(line, b)
+	}
+
+	fields := strings.Fields(strings.TrimSuffix(string(line), "\r\n"))
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil, nil
+	}
+
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, nil, errors.Errorf("invalid proxy protocol v1 header '%v'", strings.TrimSpace(string(line)))
+	}
+
+	src := parseTCPAddr([]byte(net.JoinHostPort(fields[2], fields[4])))
+	dst := parseTCPAddr([]byte(net.JoinHostPort(fields[3], fields[5])))
+	if src == nil || dst == nil {
+		return nil, nil, errors.Errorf("invalid addresses in proxy protocol v1 header '%v'", strings.TrimSpace(string(line)))
+	}
+	return src, dst, nil
+}
+
+func readProxyHeaderV2(reader *bufio.Reader) (*net.TCPAddr, *net.TCPAddr, error) {
+	header := make([]byte, len(proxyV2Signature)+4)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to read proxy protocol v2 header")
+	}
+
+	verCmd := header[len(proxyV2Signature)]
+	family := header[len(proxyV2Signature)+1]
+	length := binary.BigEndian.Uint16(header[len(proxyV2Signature)+2:])
+
+	if verCmd&0xF0 != 0x20 {
+		return nil, nil, errors.Errorf("unsupported proxy protocol v2 version %v", verCmd>>4)
+	}
+
+	// the address block may be followed by TLVs, which are read and discarded
+	body := make([]byte, length)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to read proxy protocol v2 addresses")
+	}
+
+	if verCmd == proxyV2CmdLocal {
+		return nil, nil, nil
+	}
+
+	if verCmd != proxyV2CmdProxy {
+		return nil, nil, errors.Errorf("unsupported proxy protocol v2 command %v", verCmd&0x0F)
+	}
+
+	var ipLen int
+	switch family {
+	case proxyV2TCP4:
+		ipLen = net.IPv4len
+	case proxyV2TCP6:
+		ipLen = net.IPv6len
+	default:
+		// not a TCP connection, so there's nothing useful to report
+		return nil, nil, nil
+	}
+
+	if len(body) < 2*ipLen+4 {
+		return nil, nil, errors.New("proxy protocol v2 address block too short")
+	}
+
+	src := &net.TCPAddr{
+		IP:   net.IP(append([]byte(nil), body[:ipLen]...)),
+		Port: int(binary.BigEndian.Uint16(body[2*ipLen:])),
+	}
+	dst := &net.TCPAddr{
+		IP:   net.IP(append([]byte(nil), body[ipLen:2*ipLen]...)),
+		Port: int(binary.BigEndian.Uint16(body[2*ipLen+2:])),
+	}
+	return src, dst, nil
+}
@@ -0,0 +1,141 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"testing"
+)
+
+func TestProxyHeaderRoundTrip(t *testing.T) {
+	ipv4Src := &net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 50123}
+	ipv4Dst := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 443}
+	ipv6Src := &net.TCPAddr{IP: net.ParseIP("fd00::10"), Port: 50123}
+
+	for _, version := range []string{ProxyProtocolV1, ProxyProtocolV2} {
+		t.Run(version, func(t *testing.T) {
+			req := require.New(t)
+
+			header, err := EncodeProxyHeader(version, ipv4Src, ipv4Dst)
+			req.NoError(err)
+			src, dst, err := readProxyHeader(bufio.NewReader(bytes.NewReader(header)))
+			req.NoError(err)
+			req.Equal(ipv4Src.String(), src.String())
+			req.Equal(ipv4Dst.String(), dst.String())
+
+			// mixed families are sent as IPv6
+			header, err = EncodeProxyHeader(version, ipv6Src, ipv4Dst)
+			req.NoError(err)
+			src, dst, err = readProxyHeader(bufio.NewReader(bytes.NewReader(header)))
+			req.NoError(err)
+			req.Equal(ipv6Src.String(), src.String())
+			req.True(dst.IP.Equal(ipv4Dst.IP))
+			req.Equal(ipv4Dst.Port, dst.Port)
+
+			// unknown addresses
+			header, err = EncodeProxyHeader(version, nil, nil)
+			req.NoError(err)
+			reader := bufio.NewReader(bytes.NewReader(append(header, []byte("data")...)))
+			src, dst, err = readProxyHeader(reader)
+			req.NoError(err)
+			req.Nil(src)
+			req.Nil(dst)
+
+			rest, err := io.ReadAll(reader)
+			req.NoError(err)
+			req.Equal("data", string(rest))
+		})
+	}
+}
+
+func TestProxyHeaderV1Format(t *testing.T) {
+	req := require.New(t)
+
+	header, err := EncodeProxyHeader(ProxyProtocolV1, &net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 50123}, &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 443})
+	req.NoError(err)
+	req.Equal("PROXY TCP4 192.168.1.10 10.0.0.1 50123 443\r\n", string(header))
+
+	_, err = EncodeProxyHeader("v3", nil, nil)
+	req.Error(err)
+}
+
+func TestReadProxyHeaderErrors(t *testing.T) {
+	req := require.New(t)
+
+	for _, header := range []string{
+		"GET / HTTP/1.1\r\n\r\n",
+		"PROXY TCP4 192.168.1.10 10.0.0.1 50123\r\n",
+		"PROXY TCP4 not-an-ip 10.0.0.1 50123 443\r\n",
+		"PROXY TCP4 192.168.1.10 10.0.0.1 50123 443 " + string(bytes.Repeat([]byte("x"), 100)) + "\r\n",
+	} {
+		_, _, err := readProxyHeader(bufio.NewReader(bytes.NewBufferString(header)))
+		req.Error(err, header)
+	}
+
+	// a v2 header with a truncated address block
+	header := append(append([]byte(nil), proxyV2Signature...), proxyV2CmdProxy, proxyV2TCP4, 0, 4, 1, 2, 3, 4)
+	_, _, err := readProxyHeader(bufio.NewReader(bytes.NewReader(header)))
+	req.Error(err)
+}
+
+func TestAcceptProxyHeader(t *testing.T) {
+	req := require.New(t)
+
+	client, server := net.Pipe()
+	defer func() { _ = client.Close() }()
+
+	go func() {
+		header, _ := EncodeProxyHeader(ProxyProtocolV2, &net.TCPAddr{IP: net.ParseIP("192.168.1.10"), Port: 50123}, &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 443})
+		_, _ = client.Write(append(header, []byte("hello")...))
+	}()
+
+	conn, err := AcceptProxyHeader(&tcp.Connection{Conn: server})
+	req.NoError(err)
+	req.Equal("192.168.1.10:50123", conn.RemoteAddr().String())
+	req.Equal("10.0.0.1:443", conn.LocalAddr().String())
+
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	req.NoError(err)
+	req.Equal("hello", string(buf))
+
+	peerData := ClientAddrPeerData(conn)
+	src, dst, found := ClientAddrFromPeerData(peerData)
+	req.True(found)
+	req.Equal("192.168.1.10:50123", src.String())
+	req.Equal("10.0.0.1:443", dst.String())
+}
+
+func TestLoadAcceptProxyProtocol(t *testing.T) {
+	req := require.New(t)
+
+	accept, err := LoadAcceptProxyProtocol(OptionsData{})
+	req.NoError(err)
+	req.False(accept)
+
+	accept, err = LoadAcceptProxyProtocol(OptionsData{"acceptProxyProtocol": true})
+	req.NoError(err)
+	req.True(accept)
+
+	_, err = LoadAcceptProxyProtocol(OptionsData{"acceptProxyProtocol": "yes"})
+	req.Error(err)
+}
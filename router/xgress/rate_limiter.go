@@ -0,0 +1,110 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/openziti/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// rateLimiterBurstWindow is how much traffic, in time at the configured rate, may be sent in a burst after idling
+	rateLimiterBurstWindow = 100 * time.Millisecond
+	minRateLimiterBurst    = 64 * 1024
+)
+
+// RateLimiter is a token bucket which shapes the bytes read from xgress peers. Reservations may take the bucket
+// negative, in which case the caller waits until the bucket has refilled, so payloads larger than the burst size are
+// delayed rather than rejected. A rate of 0 disables shaping.
+type RateLimiter struct {
+	rate           uint64
+	lock           sync.Mutex
+	burst          float64
+	tokens         float64
+	last           time.Time
+	now            func() time.Time
+	throttledBytes metrics.Meter
+}
+
+// NewRateLimiter returns a RateLimiter allowing bytesPerSecond. If throttledBytes is not nil, it is marked with the
+// size of every reservation which had to wait
+func NewRateLimiter(bytesPerSecond uint64, throttledBytes metrics.Meter) *RateLimiter {
+	result := &RateLimiter{
+		now:            time.Now,
+		throttledBytes: throttledBytes,
+	}
+	result.SetRate(bytesPerSecond)
+	return result
+}
+
+func (self *RateLimiter) Rate() uint64 {
+	return atomic.LoadUint64(&self.rate)
+}
+
+func (self *RateLimiter) SetRate(bytesPerSecond uint64) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if atomic.LoadUint64(&self.rate) == bytesPerSecond {
+		return
+	}
+
+	self.burst = float64(bytesPerSecond) * rateLimiterBurstWindow.Seconds()
+	if self.burst < minRateLimiterBurst {
+		self.burst = minRateLimiterBurst
+	}
+	self.tokens = self.burst
+	self.last = self.now()
+	atomic.StoreUint64(&self.rate, bytesPerSecond)
+}
+
+// Reserve takes n bytes from the bucket and returns how long the caller must wait before sending them
+func (self *RateLimiter) Reserve(n int) time.Duration {
+	rate := atomic.LoadUint64(&self.rate)
+	if rate == 0 {
+		return 0
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := self.now()
+	self.tokens += now.Sub(self.last).Seconds() * float64(rate)
+	if self.tokens > self.burst {
+		self.tokens = self.burst
+	}
+	self.last = now
+	self.tokens -= float64(n)
+
+	if self.tokens >= 0 {
+		return 0
+	}
+
+	if self.throttledBytes != nil {
+		self.throttledBytes.Mark(int64(n))
+	}
+	return time.Duration(-self.tokens / float64(rate) * float64(time.Second))
+}
+
+func lowestBandwidthLimit(a, b uint64) uint64 {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
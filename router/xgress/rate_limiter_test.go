@@ -0,0 +1,86 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Now()
+	limiter := NewRateLimiter(0, nil)
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	// unlimited
+	req.Equal(time.Duration(0), limiter.Reserve(10*1024*1024))
+
+	// 1MB/s gives a 100KB burst
+	limiter.SetRate(1000 * 1000)
+	req.Equal(time.Duration(0), limiter.Reserve(100*1000))
+
+	// bucket is empty, so 50KB has to wait 50ms
+	req.Equal(50*time.Millisecond, limiter.Reserve(50*1000))
+
+	// after 50ms we're back at zero, another 10KB waits 10ms
+	now = now.Add(50 * time.Millisecond)
+	req.Equal(10*time.Millisecond, limiter.Reserve(10*1000))
+
+	// after idling, the bucket refills only up to the burst size
+	now = now.Add(10 * time.Second)
+	req.Equal(time.Duration(0), limiter.Reserve(100*1000))
+	req.Less(time.Duration(0), limiter.Reserve(1))
+}
+
+func TestRateLimiterMinBurst(t *testing.T) {
+	req := require.New(t)
+
+	now := time.Now()
+	limiter := NewRateLimiter(0, nil)
+	limiter.now = func() time.Time {
+		return now
+	}
+
+	limiter.SetRate(1024)
+	req.Equal(time.Duration(0), limiter.Reserve(minRateLimiterBurst))
+	req.Equal(time.Second, limiter.Reserve(1024))
+}
+
+func TestLowestBandwidthLimit(t *testing.T) {
+	req := require.New(t)
+	req.Equal(uint64(0), lowestBandwidthLimit(0, 0))
+	req.Equal(uint64(10), lowestBandwidthLimit(0, 10))
+	req.Equal(uint64(10), lowestBandwidthLimit(10, 0))
+	req.Equal(uint64(5), lowestBandwidthLimit(10, 5))
+	req.Equal(uint64(5), lowestBandwidthLimit(5, 10))
+}
+
+func TestLoadBandwidthLimitOption(t *testing.T) {
+	req := require.New(t)
+
+	options, err := LoadOptions(OptionsData{"options": map[interface{}]interface{}{"bandwidthLimit": 1024 * 1024}})
+	req.NoError(err)
+	req.Equal(uint64(1024*1024), options.BandwidthLimit)
+
+	_, err = LoadOptions(OptionsData{"options": map[interface{}]interface{}{"bandwidthLimit": -1}})
+	req.Error(err)
+}
@@ -186,7 +186,13 @@ func GetCircuit(ctrl CtrlChannel, ingressId string, service string, timeout time
 }
 
 func CreateCircuit(ctrl CtrlChannel, peer Connection, request *Request, bindHandler BindHandler, options *Options) *Response {
-	circuitInfo, err := GetCircuit(ctrl, request.Id, request.ServiceId, options.GetCircuitTimeout, nil)
+	return CreateCircuitWithPeerData(ctrl, peer, request, nil, bindHandler, options)
+}
+
+// CreateCircuitWithPeerData creates a circuit, passing the given peer data through to the egress xgress
+func CreateCircuitWithPeerData(ctrl CtrlChannel, peer Connection, request *Request, peerData map[uint32][]byte, bindHandler BindHandler, options *Options) *Response {
+	peerData = options.AddCompressionOffer(request.ServiceId, peerData)
+	circuitInfo, err := GetCircuit(ctrl, request.Id, request.ServiceId, options.GetCircuitTimeout, peerData)
 	if err != nil {
 		return &Response{Success: false, Message: err.Error()}
 	}
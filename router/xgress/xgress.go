@@ -135,6 +135,11 @@ type Xgress struct {
 	peekHandlers         []PeekHandler
 	flags                concurrenz.AtomicBitSet
 	timeOfLastRxFromLink int64
+	bytesReceived        uint64
+	bytesSent            uint64
+	circuitRateLimiter   *RateLimiter
+	serviceRateLimiter   concurrenz.AtomicValue[*RateLimiter]
+	compression          CompressionType
 }
 
 func NewXgress(circuitId *identity.TokenId, address Address, peer Connection, originator Originator, options *Options) *Xgress {
@@ -149,11 +154,21 @@ func NewXgress(circuitId *identity.TokenId, address Address, peer Connection, or
 		rxSequence:           0,
 		linkRxBuffer:         NewLinkReceiveBuffer(),
 		timeOfLastRxFromLink: info.NowInMilliseconds(),
+		circuitRateLimiter:   NewRateLimiter(options.BandwidthLimit, nil),
+		compression:          NegotiatedCompression(circuitId.Data),
 	}
 	result.payloadBuffer = NewLinkSendBuffer(result)
 	return result
 }
 
+// SetBandwidthLimits configures shaping of the data read from the peer. The circuit limit, in bytes per second, is
+// combined with the bandwidthLimit option, with the lowest non-zero limit applying. The service rate limiter, if not
+// nil, is shared by all circuits for the same service
+func (self *Xgress) SetBandwidthLimits(circuitLimit uint64, serviceRateLimiter *RateLimiter) {
+	self.circuitRateLimiter.SetRate(lowestBandwidthLimit(self.Options.BandwidthLimit, circuitLimit))
+	self.serviceRateLimiter.Store(serviceRateLimiter)
+}
+
 func (self *Xgress) GetTimeOfLastRxFromLink() int64 {
 	return atomic.LoadInt64(&self.timeOfLastRxFromLink)
 }
@@ -442,14 +457,25 @@ func (self *Xgress) tx() {
 		}
 
 		if !payload.IsCircuitStartFlagSet() {
+			data := payload.Data
+			if payload.IsCompressedFlagSet() {
+				var err error
+				if data, err = decompressPayloadData(self.compression, payload.Data); err != nil {
+					payloadLogger.WithError(err).Errorf("unable to decompress %v payload, closing xgress", self.compression)
+					self.Close()
+					return
+				}
+			}
+
 			start := time.Now()
-			n, err := self.peer.WritePayload(payload.Data, payload.Headers)
+			n, err := self.peer.WritePayload(data, payload.Headers)
 			if err != nil {
 				payloadLogger.Warnf("write failed (%s), closing xgress", err)
 				self.Close()
 				return
 			} else {
 				payloadWriteTimer.UpdateSince(start)
+				atomic.AddUint64(&self.bytesSent, uint64(n))
 				payloadLogger.Debugf("sent [%s]", info.ByteCount(int64(n)))
 			}
 		}
@@ -512,6 +538,10 @@ func (self *Xgress) rx() {
 			return
 		}
 
+		if !self.throttle(n) {
+			return
+		}
+
 		payload := &Payload{
 			Header: Header{
 				CircuitId: self.circuitId,
@@ -521,12 +551,14 @@ func (self *Xgress) rx() {
 			Data:     buffer[0:n],
 			Headers:  headers,
 		}
+		self.compressPayload(payload)
 
 		// if the payload buffer is closed, we can't forward any more data, so might as well exit the rx loop
 		// The txer will still have a chance to flush any already received data
 		if !self.forwardPayload(payload) {
 			return
 		}
+		atomic.AddUint64(&self.bytesReceived, uint64(n))
 		payloadLogger := log.WithFields(payload.GetLoggerFields())
 		payloadLogger.Debugf("received [%s]", info.ByteCount(int64(n)))
 
@@ -534,6 +566,52 @@ func (self *Xgress) rx() {
 	}
 }
 
+// throttle waits until the circuit and service rate limiters allow n bytes to be sent. Returns false if the xgress
+// was closed while waiting
+func (self *Xgress) throttle(n int) bool {
+	delay := self.circuitRateLimiter.Reserve(n)
+	if serviceRateLimiter := self.serviceRateLimiter.Load(); serviceRateLimiter != nil {
+		if serviceDelay := serviceRateLimiter.Reserve(n); serviceDelay > delay {
+			delay = serviceDelay
+		}
+	}
+
+	if delay <= 0 {
+		return true
+	}
+
+	if throttledBytesMeter != nil {
+		throttledBytesMeter.Mark(int64(n))
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-self.closeNotify:
+		return false
+	}
+}
+
+func (self *Xgress) compressPayload(payload *Payload) {
+	if self.compression == CompressionTypeNone || int32(len(payload.Data)) < self.Options.CompressionMinSize {
+		return
+	}
+
+	data, ok, err := compressPayloadData(self.compression, self.Options.CompressionLevel, payload.Data)
+	if err != nil {
+		pfxlog.ContextLogger(self.Label()).WithError(err).Warnf("unable to compress payload, sending uncompressed")
+		return
+	}
+
+	if ok {
+		payload.Data = data
+		payload.Flags |= uint32(PayloadFlagCompressed)
+	}
+}
+
 func (self *Xgress) forwardPayload(payload *Payload) bool {
 	sendCallback, err := self.payloadBuffer.BufferPayload(payload)
 
@@ -573,6 +651,7 @@ func (self *Xgress) PayloadReceived(payload *Payload) {
 		ack := NewAcknowledgement(self.circuitId, self.originator)
 		ack.RecvBufferSize = self.linkRxBuffer.Size()
 		ack.Sequence = append(ack.Sequence, payload.Sequence)
+		ack.Ranges = self.linkRxBuffer.getAckRanges()
 		ack.RTT = payload.RTT
 
 		atomic.StoreUint32(&self.linkRxBuffer.lastBufferSizeSent, ack.RecvBufferSize)
@@ -608,6 +687,13 @@ func (self *Xgress) InspectCircuit(detail *inspect.CircuitInspectDetail) {
 		LinkSendBufferPointer: fmt.Sprintf("%p", self.payloadBuffer),
 		Sequence:              self.GetSequence(),
 		Flags:                 strconv.FormatUint(uint64(self.flags.Load()), 2),
+		BytesReceived:         atomic.LoadUint64(&self.bytesReceived),
+		BytesSent:             atomic.LoadUint64(&self.bytesSent),
+		CircuitBandwidthLimit: self.circuitRateLimiter.Rate(),
+	}
+
+	if serviceRateLimiter := self.serviceRateLimiter.Load(); serviceRateLimiter != nil {
+		xgressDetail.ServiceBandwidthLimit = serviceRateLimiter.Rate()
 	}
 
 	detail.XgressDetails[string(self.address)] = xgressDetail
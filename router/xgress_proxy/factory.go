@@ -39,7 +39,11 @@ func (factory *factory) CreateListener(optionsData xgress.OptionsData) (xgress.L
 	} else {
 		return nil, fmt.Errorf("missing 'service' configuration option")
 	}
-	return newListener(factory.id, factory.ctrl, options, factory.tcfg, service), nil
+	acceptProxyProtocol, err := xgress.LoadAcceptProxyProtocol(optionsData)
+	if err != nil {
+		return nil, err
+	}
+	return newListener(factory.id, factory.ctrl, options, factory.tcfg, service, acceptProxyProtocol), nil
 }
 
 func (factory *factory) CreateDialer(optionsData xgress.OptionsData) (xgress.Dialer, error) {
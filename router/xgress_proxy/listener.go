@@ -25,14 +25,15 @@ import (
 	"github.com/openziti/transport/v2"
 )
 
-func newListener(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Options, tcfg transport.Configuration, service string) xgress.Listener {
+func newListener(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Options, tcfg transport.Configuration, service string, acceptProxyProtocol bool) xgress.Listener {
 	return &listener{
-		id:          id,
-		ctrl:        ctrl,
-		options:     options,
-		tcfg:        tcfg,
-		service:     service,
-		closeHelper: &xgress.CloseHelper{},
+		id:                  id,
+		ctrl:                ctrl,
+		options:             options,
+		tcfg:                tcfg,
+		service:             service,
+		acceptProxyProtocol: acceptProxyProtocol,
+		closeHelper:         &xgress.CloseHelper{},
 	}
 }
 
@@ -44,6 +45,9 @@ func (listener *listener) Listen(address string, bindHandler xgress.BindHandler)
 	if err != nil {
 		return fmt.Errorf("cannot listen on invalid address [%s] (%s)", address, err)
 	}
+	if listener.acceptProxyProtocol && txAddress.Type() != "tcp" {
+		return fmt.Errorf("proxy protocol can only be accepted on tcp addresses, not [%s]", address)
+	}
 
 	acceptF := func(peer transport.Conn) {
 		go listener.handleConnect(peer, bindHandler)
@@ -54,10 +58,20 @@ func (listener *listener) Listen(address string, bindHandler xgress.BindHandler)
 }
 
 func (listener *listener) handleConnect(peer transport.Conn, bindHandler xgress.BindHandler) {
+	if listener.acceptProxyProtocol {
+		proxied, err := xgress.AcceptProxyHeader(peer)
+		if err != nil {
+			pfxlog.ContextLogger(peer.Detail().String()).WithError(err).Error("invalid proxy protocol header")
+			_ = peer.Close()
+			return
+		}
+		peer = proxied
+	}
+
 	conn := &proxyXgressConnection{peer}
 	log := pfxlog.ContextLogger(conn.LogContext())
 	request := &xgress.Request{ServiceId: listener.service}
-	response := xgress.CreateCircuit(listener.ctrl, conn, request, bindHandler, listener.options)
+	response := xgress.CreateCircuitWithPeerData(listener.ctrl, conn, request, xgress.ClientAddrPeerData(peer), bindHandler, listener.options)
 	if !response.Success {
 		log.Errorf("error creating circuit (%s)", response.Message)
 		_ = peer.Close()
@@ -65,12 +79,13 @@ func (listener *listener) handleConnect(peer transport.Conn, bindHandler xgress.
 }
 
 type listener struct {
-	id          *identity.TokenId
-	ctrl        xgress.CtrlChannel
-	options     *xgress.Options
-	tcfg        transport.Configuration
-	service     string
-	closeHelper *xgress.CloseHelper
+	id                  *identity.TokenId
+	ctrl                xgress.CtrlChannel
+	options             *xgress.Options
+	tcfg                transport.Configuration
+	service             string
+	acceptProxyProtocol bool
+	closeHelper         *xgress.CloseHelper
 }
 
 func (listener *listener) Close() error {
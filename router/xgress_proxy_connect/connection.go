@@ -0,0 +1,53 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_proxy_connect
+
+import (
+	"bufio"
+	"github.com/openziti/channel"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+)
+
+type proxyConnectXgressConnection struct {
+	transport.Conn
+	// reader holds anything the client sent after the proxy handshake, before the circuit was established
+	reader *bufio.Reader
+}
+
+func (c *proxyConnectXgressConnection) LogContext() string {
+	return c.Detail().String()
+}
+
+func (c *proxyConnectXgressConnection) ReadPayload() ([]byte, map[uint8][]byte, error) {
+	buffer := make([]byte, 10240)
+	n, err := c.reader.Read(buffer)
+	return buffer[:n], nil, err
+}
+
+func (c *proxyConnectXgressConnection) WritePayload(p []byte, _ map[uint8][]byte) (n int, err error) {
+	return c.Write(p)
+}
+
+func (c *proxyConnectXgressConnection) HandleControlMsg(controlType xgress.ControlType, headers channel.Headers, responder xgress.ControlReceiver) error {
+	if controlType == xgress.ControlTypeTraceRoute {
+		xgress.RespondToTraceRequest(headers, "xgress/proxy_connect", "", responder)
+		return nil
+	}
+	return errors.Errorf("unhandled control type: %v", controlType)
+}
@@ -0,0 +1,67 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_proxy_connect
+
+import (
+	"fmt"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/identity"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+)
+
+// NewFactory returns a factory for listeners which accept HTTP CONNECT and, optionally, SOCKS5 requests and pick the
+// service for each connection from the requested host and port
+func NewFactory(id *identity.TokenId, ctrl xgress.CtrlChannel, tcfg transport.Configuration) xgress.Factory {
+	return &factory{id: id, ctrl: ctrl, tcfg: tcfg}
+}
+
+func (factory *factory) CreateListener(optionsData xgress.OptionsData) (xgress.Listener, error) {
+	options, err := xgress.LoadOptions(optionsData)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading options")
+	}
+
+	services, err := loadServiceMap(optionsData)
+	if err != nil {
+		return nil, err
+	}
+
+	socks5 := false
+	if value, found := optionsData["socks5"]; found {
+		if socks5, found = value.(bool); !found {
+			return nil, errors.Errorf("invalid 'socks5' configuration option, expected boolean, got %T", value)
+		}
+	}
+
+	acceptProxyProtocol, err := xgress.LoadAcceptProxyProtocol(optionsData)
+	if err != nil {
+		return nil, err
+	}
+
+	return newListener(factory.id, factory.ctrl, options, factory.tcfg, services, socks5, acceptProxyProtocol), nil
+}
+
+func (factory *factory) CreateDialer(xgress.OptionsData) (xgress.Dialer, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+type factory struct {
+	id   *identity.TokenId
+	ctrl xgress.CtrlChannel
+	tcfg transport.Configuration
+}
@@ -0,0 +1,218 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_proxy_connect
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"github.com/pkg/errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+const (
+	socks5Version = 0x05
+
+	socks5MethodNoAuth       = 0x00
+	socks5MethodNoAcceptable = 0xFF
+
+	socks5CmdConnect = 0x01
+
+	socks5AddrIPv4   = 0x01
+	socks5AddrDomain = 0x03
+	socks5AddrIPv6   = 0x04
+
+	socks5ReplySuccess             = 0x00
+	socks5ReplyGeneralFailure      = 0x01
+	socks5ReplyNotAllowed          = 0x02
+	socks5ReplyHostUnreachable     = 0x04
+	socks5ReplyCmdNotSupported     = 0x07
+	socks5ReplyAddrTypeUnsupported = 0x08
+)
+
+// handshake is the client facing side of a proxy protocol. It reads the requested target and reports back whether a
+// circuit could be established to it
+type handshake interface {
+	readTarget() (host string, port string, err error)
+	succeeded() error
+	failed(reason failureReason) error
+}
+
+type failureReason int
+
+const (
+	failureBadRequest failureReason = iota
+	failureNotAllowed
+	failureUnreachable
+)
+
+type httpConnectHandshake struct {
+	reader *bufio.Reader
+	writer io.Writer
+}
+
+func (self *httpConnectHandshake) readTarget() (string, string, error) {
+	req, err := http.ReadRequest(self.reader)
+	if err != nil {
+		return "", "", errors.Wrap(err, "unable to read http request")
+	}
+
+	if req.Method != http.MethodConnect {
+		_ = self.writeStatus(http.StatusMethodNotAllowed)
+		return "", "", errors.Errorf("unsupported http method %v, only CONNECT is supported", req.Method)
+	}
+
+	host, port, err := net.SplitHostPort(req.Host)
+	if err != nil {
+		_ = self.writeStatus(http.StatusBadRequest)
+		return "", "", errors.Wrapf(err, "invalid CONNECT target %v", req.Host)
+	}
+
+	return host, port, nil
+}
+
+func (self *httpConnectHandshake) succeeded() error {
+	_, err := io.WriteString(self.writer, "HTTP/1.1 200 Connection established\r\n\r\n")
+	return err
+}
+
+func (self *httpConnectHandshake) failed(reason failureReason) error {
+	switch reason {
+	case failureNotAllowed:
+		return self.writeStatus(http.StatusForbidden)
+	case failureUnreachable:
+		return self.writeStatus(http.StatusBadGateway)
+	default:
+		return self.writeStatus(http.StatusBadRequest)
+	}
+}
+
+func (self *httpConnectHandshake) writeStatus(status int) error {
+	_, err := fmt.Fprintf(self.writer, "HTTP/1.1 %d %s\r\nContent-Length: 0\r\nConnection: close\r\n\r\n", status, http.StatusText(status))
+	return err
+}
+
+type socks5Handshake struct {
+	reader *bufio.Reader
+	writer io.Writer
+}
+
+func (self *socks5Handshake) readTarget() (string, string, error) {
+	if err := self.negotiateMethod(); err != nil {
+		return "", "", err
+	}
+
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(self.reader, header); err != nil {
+		return "", "", errors.Wrap(err, "unable to read socks5 request")
+	}
+
+	if header[0] != socks5Version {
+		return "", "", errors.Errorf("unsupported socks version %v", header[0])
+	}
+
+	if header[1] != socks5CmdConnect {
+		_ = self.reply(socks5ReplyCmdNotSupported)
+		return "", "", errors.Errorf("unsupported socks5 command %v, only CONNECT is supported", header[1])
+	}
+
+	var host string
+	switch header[3] {
+	case socks5AddrIPv4, socks5AddrIPv6:
+		addrLen := net.IPv4len
+		if header[3] == socks5AddrIPv6 {
+			addrLen = net.IPv6len
+		}
+		addr := make([]byte, addrLen)
+		if _, err := io.ReadFull(self.reader, addr); err != nil {
+			return "", "", errors.Wrap(err, "unable to read socks5 address")
+		}
+		host = net.IP(addr).String()
+	case socks5AddrDomain:
+		addrLen, err := self.reader.ReadByte()
+		if err != nil {
+			return "", "", errors.Wrap(err, "unable to read socks5 domain length")
+		}
+		addr := make([]byte, addrLen)
+		if _, err = io.ReadFull(self.reader, addr); err != nil {
+			return "", "", errors.Wrap(err, "unable to read socks5 domain")
+		}
+		host = string(addr)
+	default:
+		_ = self.reply(socks5ReplyAddrTypeUnsupported)
+		return "", "", errors.Errorf("unsupported socks5 address type %v", header[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(self.reader, port); err != nil {
+		return "", "", errors.Wrap(err, "unable to read socks5 port")
+	}
+
+	return host, strconv.Itoa(int(binary.BigEndian.Uint16(port))), nil
+}
+
+// negotiateMethod handles the socks5 greeting. Only unauthenticated access is supported, as the fabric service
+// policies apply to the router, not to the proxy clients
+func (self *socks5Handshake) negotiateMethod() error {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(self.reader, header); err != nil {
+		return errors.Wrap(err, "unable to read socks5 greeting")
+	}
+
+	if header[0] != socks5Version {
+		return errors.Errorf("unsupported socks version %v", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(self.reader, methods); err != nil {
+		return errors.Wrap(err, "unable to read socks5 authentication methods")
+	}
+
+	for _, method := range methods {
+		if method == socks5MethodNoAuth {
+			_, err := self.writer.Write([]byte{socks5Version, socks5MethodNoAuth})
+			return err
+		}
+	}
+
+	_, _ = self.writer.Write([]byte{socks5Version, socks5MethodNoAcceptable})
+	return errors.New("socks5 client does not support unauthenticated access")
+}
+
+func (self *socks5Handshake) succeeded() error {
+	return self.reply(socks5ReplySuccess)
+}
+
+func (self *socks5Handshake) failed(reason failureReason) error {
+	switch reason {
+	case failureNotAllowed:
+		return self.reply(socks5ReplyNotAllowed)
+	case failureUnreachable:
+		return self.reply(socks5ReplyHostUnreachable)
+	default:
+		return self.reply(socks5ReplyGeneralFailure)
+	}
+}
+
+func (self *socks5Handshake) reply(code byte) error {
+	// the bound address isn't meaningful for a fabric circuit, so always report 0.0.0.0:0
+	_, err := self.writer.Write([]byte{socks5Version, code, 0x00, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
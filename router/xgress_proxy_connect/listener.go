@@ -0,0 +1,176 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_proxy_connect
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/identity"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// handshakeTimeout bounds how long a client may take to send its proxy request
+const handshakeTimeout = 10 * time.Second
+
+type listener struct {
+	id                  *identity.TokenId
+	ctrl                xgress.CtrlChannel
+	options             *xgress.Options
+	tcfg                transport.Configuration
+	services            *serviceMap
+	socks5              bool
+	acceptProxyProtocol bool
+	closeHelper         *xgress.CloseHelper
+}
+
+func newListener(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Options, tcfg transport.Configuration, services *serviceMap, socks5 bool, acceptProxyProtocol bool) xgress.Listener {
+	return &listener{
+		id:                  id,
+		ctrl:                ctrl,
+		options:             options,
+		tcfg:                tcfg,
+		services:            services,
+		socks5:              socks5,
+		acceptProxyProtocol: acceptProxyProtocol,
+		closeHelper:         &xgress.CloseHelper{},
+	}
+}
+
+func (listener *listener) Listen(address string, bindHandler xgress.BindHandler) error {
+	if address == "" {
+		return errors.New("address must be specified for proxy_connect listeners")
+	}
+	txAddress, err := transport.ParseAddress(address)
+	if err != nil {
+		return fmt.Errorf("cannot listen on invalid address [%s] (%s)", address, err)
+	}
+	if listener.acceptProxyProtocol && txAddress.Type() != "tcp" {
+		return fmt.Errorf("proxy protocol can only be accepted on tcp addresses, not [%s]", address)
+	}
+
+	acceptF := func(peer transport.Conn) {
+		go listener.handleConnect(peer, bindHandler)
+	}
+	go listener.closeHelper.Init(txAddress.MustListen("tcp", listener.id, acceptF, listener.tcfg))
+
+	return nil
+}
+
+func (listener *listener) Close() error {
+	return listener.closeHelper.Close()
+}
+
+func (listener *listener) handleConnect(peer transport.Conn, bindHandler xgress.BindHandler) {
+	if listener.acceptProxyProtocol {
+		proxied, err := xgress.AcceptProxyHeader(peer)
+		if err != nil {
+			pfxlog.ContextLogger(peer.Detail().String()).WithError(err).Error("invalid proxy protocol header")
+			_ = peer.Close()
+			return
+		}
+		peer = proxied
+	}
+
+	conn := &proxyConnectXgressConnection{Conn: peer, reader: bufio.NewReader(peer)}
+	log := pfxlog.ContextLogger(conn.LogContext()).Entry
+
+	closePeer := func() {
+		if err := peer.Close(); err != nil {
+			log.WithError(err).Debug("error closing proxy connection")
+		}
+	}
+
+	if err := peer.SetReadDeadline(time.Now().Add(handshakeTimeout)); err != nil {
+		log.WithError(err).Error("unable to set handshake deadline")
+		closePeer()
+		return
+	}
+
+	handshake, err := listener.newHandshake(conn)
+	if err != nil {
+		log.WithError(err).Error("unable to start proxy handshake")
+		closePeer()
+		return
+	}
+
+	host, port, err := handshake.readTarget()
+	if err != nil {
+		log.WithError(err).Error("invalid proxy request")
+		closePeer()
+		return
+	}
+
+	log = log.WithField("target", host+":"+port)
+
+	service, found := listener.services.lookup(host, port)
+	if !found {
+		log.Error("no service mapped for proxy target")
+		_ = handshake.failed(failureNotAllowed)
+		closePeer()
+		return
+	}
+
+	log = log.WithField("serviceId", service)
+
+	if err = peer.SetReadDeadline(time.Time{}); err != nil {
+		log.WithError(err).Error("unable to clear handshake deadline")
+		closePeer()
+		return
+	}
+
+	peerData := listener.options.AddCompressionOffer(service, xgress.ClientAddrPeerData(peer))
+	circuitInfo, err := xgress.GetCircuit(listener.ctrl, "", service, listener.options.GetCircuitTimeout, peerData)
+	if err != nil {
+		log.WithError(err).Error("error creating circuit")
+		_ = handshake.failed(failureUnreachable)
+		closePeer()
+		return
+	}
+
+	// the client may only start sending once it has been told the tunnel is up, so reply before starting the xgress.
+	// If the reply fails, the xgress is still started so that it tears down the circuit when it fails to read
+	if err = handshake.succeeded(); err != nil {
+		log.WithError(err).Error("unable to send proxy response")
+		closePeer()
+	}
+
+	x := xgress.NewXgress(circuitInfo.CircuitId, circuitInfo.Address, conn, xgress.Initiator, listener.options)
+	bindHandler.HandleXgressBind(x)
+	x.Start()
+}
+
+// newHandshake picks the proxy protocol from the first byte the client sends. SOCKS5 greetings always start with the
+// protocol version, which can't be the first byte of an HTTP request line
+func (listener *listener) newHandshake(conn *proxyConnectXgressConnection) (handshake, error) {
+	first, err := conn.reader.Peek(1)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read proxy request")
+	}
+
+	if first[0] == socks5Version {
+		if !listener.socks5 {
+			return nil, errors.New("socks5 request received, but socks5 is not enabled for this listener")
+		}
+		return &socks5Handshake{reader: conn.reader, writer: conn}, nil
+	}
+
+	return &httpConnectHandshake{reader: conn.reader, writer: conn}, nil
+}
@@ -0,0 +1,161 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_proxy_connect
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestServiceMapLookup(t *testing.T) {
+	req := require.New(t)
+
+	services, err := loadServiceMap(xgress.OptionsData{
+		"services": map[interface{}]interface{}{
+			"db.internal:5432":         "postgres",
+			"wiki.internal":            "wiki",
+			"*.example.com:443":        "example-tls",
+			"*.example.com":            "example",
+			"*.secure.example.com:443": "secure",
+			"[fd00::1]:22":             "ssh",
+		},
+	})
+	req.NoError(err)
+
+	lookup := func(host, port string) string {
+		service, _ := services.lookup(host, port)
+		return service
+	}
+
+	req.Equal("postgres", lookup("db.internal", "5432"))
+	req.Equal("postgres", lookup("DB.Internal", "5432"))
+	req.Equal("", lookup("db.internal", "5433"))
+	req.Equal("wiki", lookup("wiki.internal", "80"))
+	req.Equal("example-tls", lookup("www.example.com", "443"))
+	req.Equal("example", lookup("www.example.com", "80"))
+	req.Equal("secure", lookup("api.secure.example.com", "443"))
+	req.Equal("", lookup("example.com", "443"))
+	req.Equal("ssh", lookup("fd00::1", "22"))
+
+	services.defaultService = "fallback"
+	req.Equal("fallback", lookup("db.internal", "5433"))
+}
+
+func TestLoadServiceMapErrors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := loadServiceMap(xgress.OptionsData{})
+	req.Error(err)
+
+	_, err = loadServiceMap(xgress.OptionsData{"services": map[interface{}]interface{}{"host:1:2": "svc"}})
+	req.Error(err)
+
+	_, err = loadServiceMap(xgress.OptionsData{"services": map[interface{}]interface{}{"host:80": 10}})
+	req.Error(err)
+
+	services, err := loadServiceMap(xgress.OptionsData{"defaultService": "fallback"})
+	req.NoError(err)
+	service, found := services.lookup("anything", "1")
+	req.True(found)
+	req.Equal("fallback", service)
+}
+
+func TestHttpConnectHandshake(t *testing.T) {
+	req := require.New(t)
+
+	in := bytes.NewBufferString("CONNECT db.internal:5432 HTTP/1.1\r\nHost: db.internal:5432\r\n\r\nearly data")
+	out := &bytes.Buffer{}
+	reader := bufio.NewReader(in)
+	handshake := &httpConnectHandshake{reader: reader, writer: out}
+
+	host, port, err := handshake.readTarget()
+	req.NoError(err)
+	req.Equal("db.internal", host)
+	req.Equal("5432", port)
+
+	req.NoError(handshake.succeeded())
+	req.Equal("HTTP/1.1 200 Connection established\r\n\r\n", out.String())
+
+	// data sent ahead of the response must not be lost
+	rest := make([]byte, 64)
+	n, _ := reader.Read(rest)
+	req.Equal("early data", string(rest[:n]))
+}
+
+func TestHttpConnectHandshakeRejectsOtherMethods(t *testing.T) {
+	req := require.New(t)
+
+	in := bytes.NewBufferString("GET http://db.internal/ HTTP/1.1\r\nHost: db.internal\r\n\r\n")
+	out := &bytes.Buffer{}
+	handshake := &httpConnectHandshake{reader: bufio.NewReader(in), writer: out}
+
+	_, _, err := handshake.readTarget()
+	req.Error(err)
+	req.Contains(out.String(), "HTTP/1.1 405")
+}
+
+func TestSocks5Handshake(t *testing.T) {
+	req := require.New(t)
+
+	in := &bytes.Buffer{}
+	in.Write([]byte{socks5Version, 2, 0x02, socks5MethodNoAuth})
+	in.Write([]byte{socks5Version, socks5CmdConnect, 0, socks5AddrDomain, 11})
+	in.WriteString("db.internal")
+	in.Write([]byte{0x15, 0x38})
+
+	out := &bytes.Buffer{}
+	handshake := &socks5Handshake{reader: bufio.NewReader(in), writer: out}
+
+	host, port, err := handshake.readTarget()
+	req.NoError(err)
+	req.Equal("db.internal", host)
+	req.Equal("5432", port)
+	req.Equal([]byte{socks5Version, socks5MethodNoAuth}, out.Bytes())
+
+	out.Reset()
+	req.NoError(handshake.failed(failureNotAllowed))
+	req.Equal([]byte{socks5Version, socks5ReplyNotAllowed, 0, socks5AddrIPv4, 0, 0, 0, 0, 0, 0}, out.Bytes())
+}
+
+func TestSocks5HandshakeIPv4(t *testing.T) {
+	req := require.New(t)
+
+	in := &bytes.Buffer{}
+	in.Write([]byte{socks5Version, 1, socks5MethodNoAuth})
+	in.Write([]byte{socks5Version, socks5CmdConnect, 0, socks5AddrIPv4, 10, 0, 0, 1, 0, 22})
+
+	handshake := &socks5Handshake{reader: bufio.NewReader(in), writer: &bytes.Buffer{}}
+	host, port, err := handshake.readTarget()
+	req.NoError(err)
+	req.Equal("10.0.0.1", host)
+	req.Equal("22", port)
+}
+
+func TestSocks5HandshakeRequiresNoAuth(t *testing.T) {
+	req := require.New(t)
+
+	in := bytes.NewBuffer([]byte{socks5Version, 1, 0x02})
+	out := &bytes.Buffer{}
+	handshake := &socks5Handshake{reader: bufio.NewReader(in), writer: out}
+
+	_, _, err := handshake.readTarget()
+	req.Error(err)
+	req.Equal([]byte{socks5Version, socks5MethodNoAcceptable}, out.Bytes())
+}
@@ -0,0 +1,140 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_proxy_connect
+
+import (
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/pkg/errors"
+	"net"
+	"sort"
+	"strings"
+)
+
+// serviceMap resolves requested host:port targets to fabric services. Targets are matched in this order: exact
+// host:port, exact host on any port, the longest matching wildcard domain (*.example.com) with the port, the longest
+// matching wildcard domain on any port and finally the default service, if one is configured
+type serviceMap struct {
+	exact          map[string]string
+	wildcards      []wildcardTarget
+	defaultService string
+}
+
+type wildcardTarget struct {
+	suffix  string
+	port    string
+	service string
+}
+
+func loadServiceMap(optionsData xgress.OptionsData) (*serviceMap, error) {
+	result := &serviceMap{
+		exact: map[string]string{},
+	}
+
+	if value, found := optionsData["defaultService"]; found {
+		defaultService, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid 'defaultService' configuration option, expected string, got %T", value)
+		}
+		result.defaultService = defaultService
+	}
+
+	value, found := optionsData["services"]
+	if !found {
+		if result.defaultService == "" {
+			return nil, errors.New("missing 'services' configuration option")
+		}
+		return result, nil
+	}
+
+	services, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.Errorf("invalid 'services' configuration option, expected map of target to service, got %T", value)
+	}
+
+	for k, v := range services {
+		target, ok := k.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid 'services' target %v, expected string", k)
+		}
+		service, ok := v.(string)
+		if !ok || service == "" {
+			return nil, errors.Errorf("invalid service for 'services' target %v, expected string", target)
+		}
+		if err := result.add(target, service); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (self *serviceMap) add(target, service string) error {
+	host, port := target, ""
+	if strings.Contains(target, ":") {
+		var err error
+		if host, port, err = net.SplitHostPort(target); err != nil {
+			return errors.Wrapf(err, "invalid 'services' target %v", target)
+		}
+	}
+	host = strings.ToLower(host)
+
+	if host == "" {
+		return errors.Errorf("invalid 'services' target %v, host is required", target)
+	}
+
+	if strings.HasPrefix(host, "*.") {
+		self.wildcards = append(self.wildcards, wildcardTarget{
+			suffix:  host[1:],
+			port:    port,
+			service: service,
+		})
+		// longest suffix first, so the most specific wildcard wins
+		sort.SliceStable(self.wildcards, func(i, j int) bool {
+			return len(self.wildcards[i].suffix) > len(self.wildcards[j].suffix)
+		})
+		return nil
+	}
+
+	self.exact[net.JoinHostPort(host, port)] = service
+	return nil
+}
+
+func (self *serviceMap) lookup(host, port string) (string, bool) {
+	host = strings.ToLower(host)
+
+	if service, found := self.exact[net.JoinHostPort(host, port)]; found {
+		return service, true
+	}
+
+	if service, found := self.exact[net.JoinHostPort(host, "")]; found {
+		return service, true
+	}
+
+	for _, withPort := range []bool{true, false} {
+		for _, wildcard := range self.wildcards {
+			if (wildcard.port != "") == withPort && (!withPort || wildcard.port == port) && strings.HasSuffix(host, wildcard.suffix) {
+				return wildcard.service, true
+			}
+		}
+	}
+
+	if self.defaultService != "" {
+		return self.defaultService, true
+	}
+
+	return "", false
+}
@@ -17,6 +17,7 @@
 package xgress_transport
 
 import (
+	"strings"
 	"time"
 
 	"github.com/michaelquigley/pfxlog"
@@ -29,23 +30,32 @@ import (
 )
 
 type dialer struct {
-	id      *identity.TokenId
-	ctrl    xgress.CtrlChannel
-	options *xgress.Options
-	tcfg    transport.Configuration
+	id            *identity.TokenId
+	ctrl          xgress.CtrlChannel
+	options       *xgress.Options
+	dialerOptions *dialerOptions
+	tcfg          transport.Configuration
+	pool          *connectionPool
 }
 
 func (txd *dialer) IsTerminatorValid(string, string) bool {
 	return true
 }
 
-func newDialer(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Options, tcfg transport.Configuration) (xgress.Dialer, error) {
+func newDialer(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Options, dialerOptions *dialerOptions, tcfg transport.Configuration, closeNotify <-chan struct{}) (xgress.Dialer, error) {
 	txd := &dialer{
-		id:      id,
-		ctrl:    ctrl,
-		options: options,
-		tcfg:    tcfg,
+		id:            id,
+		ctrl:          ctrl,
+		options:       options,
+		dialerOptions: dialerOptions,
+		tcfg:          tcfg,
 	}
+
+	if dialerOptions.pool != nil {
+		txd.pool = newConnectionPool(dialerOptions.pool, txd.dialPooled)
+		go txd.pool.runExpiration(closeNotify)
+	}
+
 	return txd, nil
 }
 
@@ -59,16 +69,42 @@ func (txd *dialer) Dial(destination string, circuitId *identity.TokenId, address
 		return nil, xgress.MisconfiguredTerminatorError{InnerError: errors.Wrapf(err, "cannot dial on invalid address [%s]", destination)}
 	}
 
-	log.Debug("dialing")
+	if err = txd.validateDestination(txDestination); err != nil {
+		return nil, xgress.MisconfiguredTerminatorError{InnerError: err}
+	}
 
-	to := txd.options.ConnectTimeout
-	timeToDeadline := time.Until(deadline)
-	if timeToDeadline > 0 && timeToDeadline < to {
-		to = timeToDeadline
+	var proxyHeader []byte
+	if txd.dialerOptions.proxyProtocol != "" {
+		src, dst, _ := xgress.ClientAddrFromPeerData(circuitId.Data)
+		if proxyHeader, err = xgress.EncodeProxyHeader(txd.dialerOptions.proxyProtocol, src, dst); err != nil {
+			return nil, xgress.MisconfiguredTerminatorError{InnerError: err}
+		}
 	}
-	peer, err := txDestination.Dial("x/"+circuitId.Token, circuitId, to, txd.tcfg)
-	if err != nil {
-		return nil, err
+
+	var peer transport.Conn
+	if txd.pool != nil {
+		if peer = txd.pool.take(destination); peer != nil {
+			log.Debug("using pooled connection")
+			// the pooled connection may still fail on first use, in which case fall back to dialing a new one
+			if err = writeProxyHeader(peer, proxyHeader); err != nil {
+				log.WithError(err).Debug("pooled connection failed, dialing")
+				peer = nil
+			}
+		}
+	}
+
+	if peer == nil {
+		log.Debug("dialing")
+
+		to := txd.options.ConnectTimeout
+		timeToDeadline := time.Until(deadline)
+		if timeToDeadline > 0 && timeToDeadline < to {
+			to = timeToDeadline
+		}
+
+		if peer, err = txd.connect(txDestination, "x/"+circuitId.Token, circuitId, to, proxyHeader); err != nil {
+			return nil, err
+		}
 	}
 
 	log.Infof("successful connection to %v from %v", destination, peer.LocalAddr())
@@ -80,3 +116,60 @@ func (txd *dialer) Dial(destination string, circuitId *identity.TokenId, address
 
 	return nil, nil
 }
+
+func (txd *dialer) validateDestination(address transport.Address) error {
+	if txd.dialerOptions.tlsConfig != nil && address.Type() != "tcp" {
+		return errors.Errorf("tls origination requires a tcp address, got [%s]", address.String())
+	}
+	return nil
+}
+
+// connect dials the destination, sending the PROXY protocol header, if given, and originating TLS if configured
+func (txd *dialer) connect(address transport.Address, name string, id *identity.TokenId, timeout time.Duration, proxyHeader []byte) (transport.Conn, error) {
+	start := time.Now()
+	peer, err := address.Dial(name, id, timeout, txd.tcfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = writeProxyHeader(peer, proxyHeader); err != nil {
+		return nil, err
+	}
+
+	if txd.dialerOptions.tlsConfig == nil {
+		return peer, nil
+	}
+
+	if timeout > 0 {
+		if timeout -= time.Since(start); timeout <= 0 {
+			_ = peer.Close()
+			return nil, errors.Errorf("timed out connecting to [%s]", address.String())
+		}
+	}
+
+	tlsPeer, err := originateTls(peer, strings.TrimPrefix(address.String(), "tcp:"), txd.dialerOptions.tlsConfig, timeout)
+	if err != nil {
+		_ = peer.Close()
+		return nil, err
+	}
+	return tlsPeer, nil
+}
+
+func (txd *dialer) dialPooled(destination string) (transport.Conn, error) {
+	address, err := transport.ParseAddress(destination)
+	if err != nil {
+		return nil, err
+	}
+	return txd.connect(address, "x/pool", txd.id, txd.options.ConnectTimeout, nil)
+}
+
+func writeProxyHeader(peer transport.Conn, proxyHeader []byte) error {
+	if len(proxyHeader) == 0 {
+		return nil
+	}
+	if _, err := peer.Write(proxyHeader); err != nil {
+		_ = peer.Close()
+		return errors.Wrap(err, "unable to send proxy protocol header")
+	}
+	return nil
+}
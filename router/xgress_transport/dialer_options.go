@@ -0,0 +1,187 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/pkg/errors"
+	"os"
+	"time"
+)
+
+const (
+	DefaultPoolSize        = 2
+	DefaultPoolIdleTimeout = 15 * time.Second
+	MaxPoolSize            = 100
+)
+
+// dialerOptions holds the transport dialer specific configuration, found at the top level of the dialer
+// configuration, next to the common xgress 'options'. For example:
+//
+//	dialers:
+//	  - binding: transport
+//	    tls:
+//	      ca: /etc/ziti/backend-ca.pem
+//	      serverName: backend.internal
+//	      cert: /etc/ziti/backend-client.pem
+//	      key: /etc/ziti/backend-client.key
+//	    connectionPool:
+//	      size: 4
+//	      idleTimeout: 15s
+//	    proxyProtocol: v2
+type dialerOptions struct {
+	// tlsConfig, if set, causes the dialer to originate TLS to tcp terminator addresses
+	tlsConfig *tls.Config
+	pool      *poolOptions
+	// proxyProtocol, if set, is the version of the PROXY protocol header sent to the backend ahead of the circuit data
+	proxyProtocol string
+}
+
+type poolOptions struct {
+	// size is the number of warm connections kept ready for each destination
+	size int
+	// idleTimeout is how long a warm connection is kept before it's discarded, as backends commonly close idle
+	// connections. The default stays under the 20 second idle timeout used by some servers and load balancers
+	idleTimeout time.Duration
+}
+
+func loadDialerOptions(optionsData xgress.OptionsData) (*dialerOptions, error) {
+	result := &dialerOptions{}
+
+	if value, found := optionsData["tls"]; found {
+		tlsData, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid 'tls' configuration, expected map, got %T", value)
+		}
+		tlsConfig, err := loadTlsConfig(tlsData)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid 'tls' configuration")
+		}
+		result.tlsConfig = tlsConfig
+	}
+
+	if value, found := optionsData["connectionPool"]; found {
+		poolData, ok := value.(map[interface{}]interface{})
+		if !ok {
+			return nil, errors.Errorf("invalid 'connectionPool' configuration, expected map, got %T", value)
+		}
+		pool, err := loadPoolOptions(poolData)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid 'connectionPool' configuration")
+		}
+		result.pool = pool
+	}
+
+	if value, found := optionsData["proxyProtocol"]; found {
+		version, ok := value.(string)
+		if !ok || !xgress.IsValidProxyProtocolVersion(version) {
+			return nil, errors.Errorf("invalid 'proxyProtocol' configuration, expected %v or %v", xgress.ProxyProtocolV1, xgress.ProxyProtocolV2)
+		}
+		result.proxyProtocol = version
+	}
+
+	// pooled TLS connections are handshaked before the circuit is known, but the PROXY header has to precede the handshake
+	if result.proxyProtocol != "" && result.pool != nil && result.tlsConfig != nil {
+		return nil, errors.New("'proxyProtocol' can't be combined with 'connectionPool' when 'tls' is configured")
+	}
+
+	return result, nil
+}
+
+func loadTlsConfig(data map[interface{}]interface{}) (*tls.Config, error) {
+	result := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ClientSessionCache: tls.NewLRUClientSessionCache(0),
+	}
+
+	if value, found := data["ca"]; found {
+		caFile, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid value for 'ca', expected file path, got %T", value)
+		}
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read ca file %v", caFile)
+		}
+		result.RootCAs = x509.NewCertPool()
+		if !result.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.Errorf("no certificates found in ca file %v", caFile)
+		}
+	}
+
+	if value, found := data["serverName"]; found {
+		serverName, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid value for 'serverName', expected string, got %T", value)
+		}
+		result.ServerName = serverName
+	}
+
+	certValue, certFound := data["cert"]
+	keyValue, keyFound := data["key"]
+	if certFound != keyFound {
+		return nil, errors.New("'cert' and 'key' must be provided together")
+	}
+
+	if certFound {
+		certFile, ok := certValue.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid value for 'cert', expected file path, got %T", certValue)
+		}
+		keyFile, ok := keyValue.(string)
+		if !ok {
+			return nil, errors.Errorf("invalid value for 'key', expected file path, got %T", keyValue)
+		}
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to load client certificate")
+		}
+		result.Certificates = []tls.Certificate{cert}
+	}
+
+	return result, nil
+}
+
+func loadPoolOptions(data map[interface{}]interface{}) (*poolOptions, error) {
+	result := &poolOptions{
+		size:        DefaultPoolSize,
+		idleTimeout: DefaultPoolIdleTimeout,
+	}
+
+	if value, found := data["size"]; found {
+		size, ok := value.(int)
+		if !ok || size < 1 || size > MaxPoolSize {
+			return nil, errors.Errorf("invalid value for 'size', expected integer between 1 and %v", MaxPoolSize)
+		}
+		result.size = size
+	}
+
+	if value, found := data["idleTimeout"]; found {
+		idleTimeout, err := time.ParseDuration(value.(string))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid value for 'idleTimeout'")
+		}
+		if idleTimeout <= 0 {
+			return nil, errors.New("invalid value for 'idleTimeout', must be positive")
+		}
+		result.idleTimeout = idleTimeout
+	}
+
+	return result, nil
+}
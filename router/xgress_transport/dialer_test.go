@@ -0,0 +1,273 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_transport
+
+import (
+	"bufio"
+	"encoding/pem"
+	"github.com/openziti/fabric/router/xgress"
+	"github.com/openziti/transport/v2"
+	"github.com/openziti/transport/v2/tcp"
+	"github.com/openziti/transport/v2/tls"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func init() {
+	transport.AddAddressParser(tls.AddressParser{})
+	transport.AddAddressParser(tcp.AddressParser{})
+}
+
+func TestConnectionPool(t *testing.T) {
+	req := require.New(t)
+
+	var dials int32
+	servers := make(chan net.Conn, 10)
+	defer func() {
+		close(servers)
+		for server := range servers {
+			_ = server.Close()
+		}
+	}()
+
+	pool := newConnectionPool(&poolOptions{size: 2, idleTimeout: time.Minute}, func(destination string) (transport.Conn, error) {
+		atomic.AddInt32(&dials, 1)
+		client, server := net.Pipe()
+		servers <- server
+		return &tcp.Connection{Conn: client}, nil
+	})
+
+	now := time.Now()
+	pool.now = func() time.Time {
+		return now
+	}
+
+	// nothing is warm until the destination has been asked for once
+	req.Nil(pool.take("tcp:backend:80"))
+	req.Eventually(func() bool {
+		return pool.warmCount("tcp:backend:80") == 2
+	}, time.Second, time.Millisecond)
+
+	req.NotNil(pool.take("tcp:backend:80"))
+	req.Eventually(func() bool {
+		return pool.warmCount("tcp:backend:80") == 2
+	}, time.Second, time.Millisecond)
+	req.Equal(int32(3), atomic.LoadInt32(&dials))
+
+	// stale connections aren't handed out
+	now = now.Add(2 * time.Minute)
+	pool.lock.Lock()
+	pool.destinations["tcp:backend:80"].refilling = true // hold off the refill, so the check below is deterministic
+	pool.lock.Unlock()
+	req.Nil(pool.take("tcp:backend:80"))
+
+	// unused destinations are dropped
+	now = now.Add(2 * time.Minute)
+	pool.expire()
+	req.Equal(0, pool.warmCount("tcp:backend:80"))
+	pool.lock.Lock()
+	req.Empty(pool.destinations)
+	pool.lock.Unlock()
+}
+
+func TestConnectionPoolProbe(t *testing.T) {
+	req := require.New(t)
+
+	closeNotify := make(chan struct{})
+	var servers []net.Conn
+	pool := newConnectionPool(&poolOptions{size: 3, idleTimeout: time.Minute}, func(destination string) (transport.Conn, error) {
+		client, server := net.Pipe()
+		servers = append(servers, server)
+		return &tcp.Connection{Conn: client}, nil
+	})
+	go pool.runExpiration(closeNotify)
+
+	req.Nil(pool.take("tcp:backend:80"))
+	req.Eventually(func() bool {
+		return pool.warmCount("tcp:backend:80") == 3
+	}, time.Second, time.Millisecond)
+
+	pool.lock.Lock()
+	pool.destinations["tcp:backend:80"].refilling = true
+	pool.lock.Unlock()
+
+	// the first connection has been closed by the backend and the second has a server greeting waiting
+	_ = servers[0].Close()
+	go func() {
+		_, _ = servers[1].Write([]byte("220 ready\n"))
+	}()
+
+	conn := pool.take("tcp:backend:80")
+	req.NotNil(conn)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	req.NoError(err)
+	req.Equal("220 ready\n", line)
+	req.Equal(1, pool.warmCount("tcp:backend:80"))
+
+	// shutting down closes the remaining pooled connections
+	close(closeNotify)
+	req.Eventually(func() bool {
+		return pool.warmCount("tcp:backend:80") == 0
+	}, time.Second, time.Millisecond)
+	_ = servers[2].SetReadDeadline(time.Now().Add(time.Second))
+	_, err = servers[2].Read(make([]byte, 1))
+	req.Error(err)
+}
+
+func TestTlsOrigination(t *testing.T) {
+	req := require.New(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	req.NoError(os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	options, err := loadDialerOptions(map[interface{}]interface{}{
+		"tls": map[interface{}]interface{}{
+			"ca":         caFile,
+			"serverName": "example.com",
+		},
+		"connectionPool": map[interface{}]interface{}{
+			"size":        1,
+			"idleTimeout": "10s",
+		},
+	})
+	req.NoError(err)
+	req.Equal(1, options.pool.size)
+	req.Equal(10*time.Second, options.pool.idleTimeout)
+
+	txd := &dialer{dialerOptions: options}
+	address, err := transport.ParseAddress("tcp:" + strings.TrimPrefix(server.URL, "https://"))
+	req.NoError(err)
+
+	peer, err := txd.connect(address, "test", nil, time.Second, nil)
+	req.NoError(err)
+	defer func() { _ = peer.Close() }()
+
+	req.NotEmpty(peer.PeerCertificates())
+	req.True(strings.HasPrefix(peer.Detail().Address, "tls:"))
+
+	_, err = peer.Write([]byte("GET / HTTP/1.1\r\nHost: example.com\r\nConnection: close\r\n\r\n"))
+	req.NoError(err)
+	resp, err := http.ReadResponse(bufio.NewReader(peer), nil)
+	req.NoError(err)
+	req.Equal(http.StatusOK, resp.StatusCode)
+
+	// verification against the wrong name must fail
+	options.tlsConfig.ServerName = "backend.invalid"
+	_, err = txd.connect(address, "test", nil, time.Second, nil)
+	req.Error(err)
+}
+
+func TestTlsOriginationRequiresTcp(t *testing.T) {
+	req := require.New(t)
+
+	options, err := loadDialerOptions(map[interface{}]interface{}{
+		"tls": map[interface{}]interface{}{},
+	})
+	req.NoError(err)
+
+	txd := &dialer{dialerOptions: options}
+	address, err := transport.ParseAddress("tls:127.0.0.1:443")
+	req.NoError(err)
+	req.Error(txd.validateDestination(address))
+}
+
+func TestProxyProtocolHeaderSent(t *testing.T) {
+	req := require.New(t)
+
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	req.NoError(err)
+	defer func() { _ = backend.Close() }()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := backend.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		received <- line
+	}()
+
+	options, err := loadDialerOptions(map[interface{}]interface{}{"proxyProtocol": "v1"})
+	req.NoError(err)
+
+	src, dst, found := xgress.ClientAddrFromPeerData(map[uint32][]byte{
+		xgress.PeerDataSourceAddrHeader: []byte("192.168.1.10:50123"),
+		xgress.PeerDataDestAddrHeader:   []byte("10.0.0.1:443"),
+	})
+	req.True(found)
+	header, err := xgress.EncodeProxyHeader(options.proxyProtocol, src, dst)
+	req.NoError(err)
+
+	txd := &dialer{dialerOptions: options}
+	address, err := transport.ParseAddress("tcp:" + backend.Addr().String())
+	req.NoError(err)
+
+	peer, err := txd.connect(address, "test", nil, time.Second, header)
+	req.NoError(err)
+	defer func() { _ = peer.Close() }()
+
+	select {
+	case line := <-received:
+		req.Equal("PROXY TCP4 192.168.1.10 10.0.0.1 50123 443\r\n", line)
+	case <-time.After(time.Second):
+		req.Fail("timed out waiting for proxy header")
+	}
+}
+
+func TestLoadDialerOptionsErrors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := loadDialerOptions(map[interface{}]interface{}{
+		"tls": map[interface{}]interface{}{"cert": "client.pem"},
+	})
+	req.Error(err)
+
+	_, err = loadDialerOptions(map[interface{}]interface{}{
+		"connectionPool": map[interface{}]interface{}{"size": 0},
+	})
+	req.Error(err)
+
+	_, err = loadDialerOptions(map[interface{}]interface{}{"proxyProtocol": "v3"})
+	req.Error(err)
+
+	_, err = loadDialerOptions(map[interface{}]interface{}{
+		"proxyProtocol":  "v2",
+		"tls":            map[interface{}]interface{}{},
+		"connectionPool": map[interface{}]interface{}{},
+	})
+	req.Error(err)
+
+	options, err := loadDialerOptions(map[interface{}]interface{}{})
+	req.NoError(err)
+	req.Nil(options.tlsConfig)
+	req.Nil(options.pool)
+}
@@ -21,18 +21,25 @@ import (
 	"github.com/openziti/identity"
 	"github.com/openziti/transport/v2"
 	"github.com/pkg/errors"
+	"sync"
 )
 
 type factory struct {
-	id      *identity.TokenId
-	ctrl    xgress.CtrlChannel
-	options *xgress.Options
-	tcfg    transport.Configuration
+	id          *identity.TokenId
+	ctrl        xgress.CtrlChannel
+	options     *xgress.Options
+	tcfg        transport.Configuration
+	closeNotify <-chan struct{}
+
+	// dialer is created once and then shared, as a dialer is requested for every route and the connection pool
+	// has to outlive individual routes to be of any use. The dialer configuration is fixed for the life of the router
+	dialerLock sync.Mutex
+	dialer     xgress.Dialer
 }
 
-// NewFactory returns a new Transport Xgress factory
-func NewFactory(id *identity.TokenId, ctrl xgress.CtrlChannel, tcfg transport.Configuration) xgress.Factory {
-	return &factory{id: id, ctrl: ctrl, tcfg: tcfg}
+// NewFactory returns a new Transport Xgress factory. Pooled dialer connections are closed once closeNotify is closed
+func NewFactory(id *identity.TokenId, ctrl xgress.CtrlChannel, tcfg transport.Configuration, closeNotify <-chan struct{}) xgress.Factory {
+	return &factory{id: id, ctrl: ctrl, tcfg: tcfg, closeNotify: closeNotify}
 }
 
 func (factory *factory) CreateListener(optionsData xgress.OptionsData) (xgress.Listener, error) {
@@ -40,13 +47,33 @@ func (factory *factory) CreateListener(optionsData xgress.OptionsData) (xgress.L
 	if err != nil {
 		return nil, errors.Wrap(err, "error loading options")
 	}
-	return newListener(factory.id, factory.ctrl, options, factory.tcfg), nil
+	acceptProxyProtocol, err := xgress.LoadAcceptProxyProtocol(optionsData)
+	if err != nil {
+		return nil, err
+	}
+	return newListener(factory.id, factory.ctrl, options, factory.tcfg, acceptProxyProtocol), nil
 }
 
 func (factory *factory) CreateDialer(optionsData xgress.OptionsData) (xgress.Dialer, error) {
+	factory.dialerLock.Lock()
+	defer factory.dialerLock.Unlock()
+
+	if factory.dialer != nil {
+		return factory.dialer, nil
+	}
+
 	options, err := xgress.LoadOptions(optionsData)
 	if err != nil {
 		return nil, errors.Wrap(err, "error loading options")
 	}
-	return newDialer(factory.id, factory.ctrl, options, factory.tcfg)
+	dialerOptions, err := loadDialerOptions(optionsData)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading dialer options")
+	}
+	dialer, err := newDialer(factory.id, factory.ctrl, options, dialerOptions, factory.tcfg, factory.closeNotify)
+	if err != nil {
+		return nil, err
+	}
+	factory.dialer = dialer
+	return dialer, nil
 }
@@ -26,20 +26,22 @@ import (
 )
 
 type listener struct {
-	id          *identity.TokenId
-	ctrl        xgress.CtrlChannel
-	options     *xgress.Options
-	tcfg        transport.Configuration
-	closeHelper *xgress.CloseHelper
+	id                  *identity.TokenId
+	ctrl                xgress.CtrlChannel
+	options             *xgress.Options
+	tcfg                transport.Configuration
+	acceptProxyProtocol bool
+	closeHelper         *xgress.CloseHelper
 }
 
-func newListener(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Options, tcfg transport.Configuration) xgress.Listener {
+func newListener(id *identity.TokenId, ctrl xgress.CtrlChannel, options *xgress.Options, tcfg transport.Configuration, acceptProxyProtocol bool) xgress.Listener {
 	return &listener{
-		id:          id,
-		ctrl:        ctrl,
-		options:     options,
-		tcfg:        tcfg,
-		closeHelper: &xgress.CloseHelper{},
+		id:                  id,
+		ctrl:                ctrl,
+		options:             options,
+		tcfg:                tcfg,
+		acceptProxyProtocol: acceptProxyProtocol,
+		closeHelper:         &xgress.CloseHelper{},
 	}
 }
 
@@ -51,6 +53,9 @@ func (listener *listener) Listen(address string, bindHandler xgress.BindHandler)
 	if err != nil {
 		return fmt.Errorf("cannot listen on invalid address [%s] (%s)", address, err)
 	}
+	if listener.acceptProxyProtocol && txAddress.Type() != "tcp" {
+		return fmt.Errorf("proxy protocol can only be accepted on tcp addresses, not [%s]", address)
+	}
 
 	acceptF := func(peer transport.Conn) {
 		go listener.handleConnect(peer, bindHandler)
@@ -65,12 +70,24 @@ func (listener *listener) Close() error {
 }
 
 func (listener *listener) handleConnect(peer transport.Conn, bindHandler xgress.BindHandler) {
+	if listener.acceptProxyProtocol {
+		proxied, err := xgress.AcceptProxyHeader(peer)
+		if err != nil {
+			pfxlog.ContextLogger(peer.Detail().String()).WithError(err).Error("invalid proxy protocol header")
+			if err := peer.Close(); err != nil {
+				pfxlog.ContextLogger(peer.Detail().String()).Errorf("error closing transport connection (%s)", err)
+			}
+			return
+		}
+		peer = proxied
+	}
+
 	conn := &transportXgressConn{Conn: peer}
 	log := pfxlog.ContextLogger(conn.LogContext())
 
 	request, err := xgress.ReceiveRequest(peer)
 	if err == nil {
-		response := xgress.CreateCircuit(listener.ctrl, conn, request, bindHandler, listener.options)
+		response := xgress.CreateCircuitWithPeerData(listener.ctrl, conn, request, xgress.ClientAddrPeerData(peer), bindHandler, listener.options)
 		err = xgress.SendResponse(response, peer)
 		if err != nil {
 			log.Errorf("error sending response (%s)", err)
@@ -0,0 +1,254 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_transport
+
+import (
+	"github.com/michaelquigley/pfxlog"
+	"github.com/openziti/transport/v2"
+	"net"
+	"sync"
+	"time"
+)
+
+// connectionPool keeps warm connections to the destinations the dialer has recently been asked for, so circuits
+// don't wait for the TCP (and, when originating TLS, the TLS) handshake. Connections are never returned to the pool
+// once a circuit has used them, as the state of the backend protocol on them is unknown. Instead, taking a connection
+// starts a refill in the background.
+type connectionPool struct {
+	options *poolOptions
+	dial    func(destination string) (transport.Conn, error)
+	probe   func(conn transport.Conn) (transport.Conn, bool)
+	now     func() time.Time
+
+	lock         sync.Mutex
+	destinations map[string]*destinationPool
+}
+
+type destinationPool struct {
+	conns     []*pooledConn
+	refilling bool
+	lastUsed  time.Time
+}
+
+type pooledConn struct {
+	transport.Conn
+	created time.Time
+}
+
+func newConnectionPool(options *poolOptions, dial func(destination string) (transport.Conn, error)) *connectionPool {
+	return &connectionPool{
+		options:      options,
+		dial:         dial,
+		probe:        probeConn,
+		now:          time.Now,
+		destinations: map[string]*destinationPool{},
+	}
+}
+
+// take returns a warm connection to the destination, or nil if there isn't one. Connections which the backend has
+// closed while they sat in the pool are discarded. Either way, the pool for the destination is topped up in the
+// background
+func (self *connectionPool) take(destination string) transport.Conn {
+	for {
+		conn := self.next(destination)
+		if conn == nil {
+			return nil
+		}
+
+		// probing is done outside the lock, as it waits briefly on the connection
+		if result, alive := self.probe(conn.Conn); alive {
+			return result
+		}
+
+		pfxlog.Logger().WithField("destination", destination).Debug("discarding closed pooled connection")
+		closePooledConn(conn)
+	}
+}
+
+// next removes and returns the oldest connection to the destination which hasn't been idle too long, if there is one
+func (self *connectionPool) next(destination string) *pooledConn {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	pool, found := self.destinations[destination]
+	if !found {
+		pool = &destinationPool{}
+		self.destinations[destination] = pool
+	}
+
+	now := self.now()
+	pool.lastUsed = now
+
+	var result *pooledConn
+	for result == nil && len(pool.conns) > 0 {
+		conn := pool.conns[0]
+		pool.conns = pool.conns[1:]
+		if now.Sub(conn.created) < self.options.idleTimeout {
+			result = conn
+		} else {
+			closePooledConn(conn)
+		}
+	}
+
+	if !pool.refilling && len(pool.conns) < self.options.size {
+		pool.refilling = true
+		go self.refill(destination, pool)
+	}
+
+	return result
+}
+
+func (self *connectionPool) refill(destination string, pool *destinationPool) {
+	log := pfxlog.Logger().WithField("destination", destination)
+
+	for {
+		self.lock.Lock()
+		if len(pool.conns) >= self.options.size || self.destinations[destination] != pool {
+			pool.refilling = false
+			self.lock.Unlock()
+			return
+		}
+		self.lock.Unlock()
+
+		conn, err := self.dial(destination)
+
+		self.lock.Lock()
+		if err != nil {
+			pool.refilling = false
+			self.lock.Unlock()
+			log.WithError(err).Debug("unable to dial pooled connection")
+			return
+		}
+		if self.destinations[destination] != pool {
+			self.lock.Unlock()
+			closePooledConn(&pooledConn{Conn: conn})
+			continue
+		}
+		pool.conns = append(pool.conns, &pooledConn{Conn: conn, created: self.now()})
+		self.lock.Unlock()
+	}
+}
+
+func (self *connectionPool) warmCount(destination string) int {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if pool, found := self.destinations[destination]; found {
+		return len(pool.conns)
+	}
+	return 0
+}
+
+// expire closes connections which have been idle too long and drops destinations which haven't been dialed within
+// the idle timeout, so the pool doesn't keep connections to terminators which are no longer used
+func (self *connectionPool) expire() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := self.now()
+	for destination, pool := range self.destinations {
+		var live []*pooledConn
+		for _, conn := range pool.conns {
+			if now.Sub(conn.created) < self.options.idleTimeout {
+				live = append(live, conn)
+			} else {
+				closePooledConn(conn)
+			}
+		}
+		pool.conns = live
+
+		if now.Sub(pool.lastUsed) >= self.options.idleTimeout {
+			for _, conn := range pool.conns {
+				closePooledConn(conn)
+			}
+			delete(self.destinations, destination)
+		}
+	}
+}
+
+// runExpiration periodically expires idle connections until the router shuts down, at which point all pooled
+// connections are closed
+func (self *connectionPool) runExpiration(closeNotify <-chan struct{}) {
+	ticker := time.NewTicker(self.options.idleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			self.expire()
+		case <-closeNotify:
+			self.close()
+			return
+		}
+	}
+}
+
+func (self *connectionPool) close() {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for destination, pool := range self.destinations {
+		for _, conn := range pool.conns {
+			closePooledConn(conn)
+		}
+		delete(self.destinations, destination)
+	}
+}
+
+// probeConn checks whether a pooled connection is still open. A read with a short deadline times out on a live,
+// quiet connection and fails immediately on one the backend has closed. Some protocols have the server speak first,
+// so if data is read, the connection is live and is returned wrapped, so the data is still delivered to the circuit
+func probeConn(conn transport.Conn) (transport.Conn, bool) {
+	if err := conn.SetReadDeadline(time.Now().Add(time.Millisecond)); err != nil {
+		return nil, false
+	}
+
+	buf := make([]byte, 1)
+	n, err := conn.Read(buf)
+
+	if n > 0 {
+		conn = &peekedConn{Conn: conn, peeked: buf[:n]}
+	} else if netErr, ok := err.(net.Error); !ok || !netErr.Timeout() {
+		return nil, false
+	}
+
+	if err = conn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, false
+	}
+	return conn, true
+}
+
+// peekedConn returns the data read while probing a pooled connection ahead of the rest of the connection's data
+type peekedConn struct {
+	transport.Conn
+	peeked []byte
+}
+
+func (self *peekedConn) Read(b []byte) (int, error) {
+	if len(self.peeked) > 0 {
+		n := copy(b, self.peeked)
+		self.peeked = self.peeked[n:]
+		return n, nil
+	}
+	return self.Conn.Read(b)
+}
+
+func closePooledConn(conn *pooledConn) {
+	if err := conn.Close(); err != nil {
+		pfxlog.Logger().WithError(err).Debug("error closing pooled connection")
+	}
+}
@@ -0,0 +1,75 @@
+/*
+	Copyright NetFoundry Inc.
+
+	Licensed under the Apache License, Version 2.0 (the "License");
+	you may not use this file except in compliance with the License.
+	You may obtain a copy of the License at
+
+	https://www.apache.org/licenses/LICENSE-2.0
+
+	Unless required by applicable law or agreed to in writing, software
+	distributed under the License is distributed on an "AS IS" BASIS,
+	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+	See the License for the specific language governing permissions and
+	limitations under the License.
+*/
+
+package xgress_transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/openziti/transport/v2"
+	"github.com/pkg/errors"
+	"net"
+	"time"
+)
+
+// tlsOriginatedConn is a connection to a backend over which the router has originated TLS
+type tlsOriginatedConn struct {
+	*tls.Conn
+	detail *transport.ConnectionDetail
+}
+
+func (self *tlsOriginatedConn) Detail() *transport.ConnectionDetail {
+	return self.detail
+}
+
+func (self *tlsOriginatedConn) PeerCertificates() []*x509.Certificate {
+	return self.ConnectionState().PeerCertificates
+}
+
+// originateTls runs a client TLS handshake over the given connection. If the configuration doesn't specify a server
+// name, the host of the destination is used, so the backend certificate is verified against the terminator address
+func originateTls(peer transport.Conn, destination string, config *tls.Config, timeout time.Duration) (transport.Conn, error) {
+	if config.ServerName == "" {
+		host, _, err := net.SplitHostPort(destination)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to determine server name for [%s]", destination)
+		}
+		config = config.Clone()
+		config.ServerName = host
+	}
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	tlsConn := tls.Client(peer, config)
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, errors.Wrapf(err, "tls handshake with [%s] failed", destination)
+	}
+
+	return &tlsOriginatedConn{
+		Conn: tlsConn,
+		detail: &transport.ConnectionDetail{
+			Address: "tls:" + destination,
+			InBound: false,
+			Name:    peer.Detail().Name,
+		},
+	}, nil
+}
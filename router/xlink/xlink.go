@@ -17,7 +17,7 @@
 package xlink
 
 import (
-	"github.com/openziti/fabric/controller/xctrl"
+	"github.com/openziti/channel"
 	"github.com/openziti/fabric/inspect"
 	"github.com/openziti/fabric/pb/ctrl_pb"
 	"github.com/openziti/fabric/router/xgress"
@@ -27,7 +27,9 @@ import (
 
 // Registry contains known link instances and manages link de-duplication
 type Registry interface {
-	xctrl.Xctrl
+	// NotifyOfReconnect lets the registry know that the given controller channel reconnected, so it can resend
+	// the router's links
+	NotifyOfReconnect(ch channel.Channel)
 
 	// GetLink returns the link to the given router, of the given type, if one exists
 	GetLink(routerId, linkType string) (Xlink, bool)
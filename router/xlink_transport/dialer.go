@@ -19,6 +19,7 @@ package xlink_transport
 import (
 	"github.com/google/uuid"
 	"github.com/openziti/channel"
+	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xlink"
 	"github.com/openziti/identity"
 	"github.com/openziti/transport/v2"
@@ -78,6 +79,7 @@ func (self *dialer) dialSplit(linkId *identity.TokenId, address transport.Addres
 		link: &splitImpl{id: linkId,
 			routerId:      dial.GetRouterId(),
 			routerVersion: dial.GetRouterVersion(),
+			ackRanges:     xgress.SupportsAckRanges(dial.GetRouterVersion()),
 			linkProtocol:  dial.GetLinkProtocol(),
 		},
 	}
@@ -121,6 +123,7 @@ func (self *dialer) dialSingle(linkId *identity.TokenId, address transport.Addre
 			routerId:      dial.GetRouterId(),
 			linkProtocol:  dial.GetLinkProtocol(),
 			routerVersion: dial.GetRouterVersion(),
+			ackRanges:     xgress.SupportsAckRanges(dial.GetRouterVersion()),
 		},
 	}
 
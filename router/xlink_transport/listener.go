@@ -21,6 +21,7 @@ import (
 	"github.com/michaelquigley/pfxlog"
 	"github.com/openziti/channel"
 	fabricMetrics "github.com/openziti/fabric/metrics"
+	"github.com/openziti/fabric/router/xgress"
 	"github.com/openziti/fabric/router/xlink"
 	"github.com/openziti/identity"
 	"github.com/openziti/metrics"
@@ -180,6 +181,7 @@ func (self *listener) getOrCreateSplitLink(id, routerId, routerVersion string, b
 				id:            binding.GetChannel().Id(),
 				routerId:      routerId,
 				routerVersion: routerVersion,
+				ackRanges:     xgress.SupportsAckRanges(routerVersion),
 				linkProtocol:  self.GetLinkProtocol(),
 			},
 			eventTime: time.Now(),
@@ -209,10 +211,12 @@ func (self *listener) getOrCreateSplitLink(id, routerId, routerVersion string, b
 
 func (self *listener) bindNonSplitChannel(binding channel.Binding, routerId, routerVersion string, log *logrus.Entry) error {
 	xli := &impl{
-		id:           binding.GetChannel().Id(),
-		ch:           binding.GetChannel(),
-		routerId:     routerId,
-		linkProtocol: self.GetLinkProtocol(),
+		id:            binding.GetChannel().Id(),
+		ch:            binding.GetChannel(),
+		routerId:      routerId,
+		routerVersion: routerVersion,
+		ackRanges:     xgress.SupportsAckRanges(routerVersion),
+		linkProtocol:  self.GetLinkProtocol(),
 	}
 
 	bindHandler := self.bindHandlerFactory.NewBindHandler(xli, true, true)
@@ -30,6 +30,7 @@ type impl struct {
 	ch            channel.Channel
 	routerId      string
 	routerVersion string
+	ackRanges     bool
 	linkProtocol  string
 	dialAddress   string
 	closeNotified concurrenz.AtomicBoolean
@@ -44,6 +45,9 @@ func (self *impl) SendPayload(payload *xgress.Payload) error {
 }
 
 func (self *impl) SendAcknowledgement(acknowledgement *xgress.Acknowledgement) error {
+	if !self.ackRanges {
+		return self.ch.Send(acknowledgement.MarshallWithoutRanges())
+	}
 	return self.ch.Send(acknowledgement.Marshall())
 }
 
@@ -32,6 +32,7 @@ type splitImpl struct {
 	ackCh         channel.Channel
 	routerId      string
 	routerVersion string
+	ackRanges     bool
 	linkProtocol  string
 	dialAddress   string
 	closeNotified concurrenz.AtomicBoolean
@@ -50,6 +51,9 @@ func (self *splitImpl) SendControl(control *xgress.Control) error {
 }
 
 func (self *splitImpl) SendAcknowledgement(acknowledgement *xgress.Acknowledgement) error {
+	if !self.ackRanges {
+		return self.ackCh.Send(acknowledgement.MarshallWithoutRanges())
+	}
 	return self.ackCh.Send(acknowledgement.Marshall())
 }
 